@@ -42,6 +42,7 @@ func (eac *evidenceApplicationCommand) CreateEvidence(ctx *components.Context, s
 		eac.ctx.GetStringFlagValue(flags.AttachLocal),
 		eac.ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath),
 		eac.ctx.GetStringFlagValue(flags.AttachArtifactoryPath),
+		utils.CreateEvidenceOptions(eac.ctx)...,
 	)
 	return eac.execute(createCmd)
 }
@@ -46,6 +46,7 @@ func (ecc *evidenceCustomCommand) CreateEvidence(_ *components.Context, serverDe
 		ecc.ctx.GetStringFlagValue(flags.AttachLocal),
 		ecc.ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath),
 		ecc.ctx.GetStringFlagValue(flags.AttachArtifactoryPath),
+		utils.CreateEvidenceOptions(ecc.ctx)...,
 	)
 	return ecc.execute(createCmd)
 }
@@ -57,6 +58,7 @@ func (ecc *evidenceCustomCommand) GetEvidence(_ *components.Context, serverDetai
 		ecc.ctx.GetStringFlagValue(flags.Format),
 		ecc.ctx.GetStringFlagValue(flags.Output),
 		ecc.ctx.GetBoolFlagValue(flags.IncludePredicate),
+		utils.GetEvidenceOptions(ecc.ctx)...,
 	)
 
 	return ecc.execute(getCmd)
@@ -69,6 +71,7 @@ func (ecc *evidenceCustomCommand) VerifyEvidence(_ *components.Context, serverDe
 		ecc.ctx.GetStringFlagValue(flags.Format),
 		ecc.ctx.GetStringsArrFlagValue(flags.PublicKeys),
 		ecc.ctx.GetBoolFlagValue(flags.UseArtifactoryKeys),
+		utils.VerifyEvidenceOptions(ecc.ctx)...,
 	)
 	return ecc.execute(verifyCmd)
 }
@@ -44,6 +44,7 @@ func (ebc *evidenceBuildCommand) CreateEvidence(ctx *components.Context, serverD
 		ebc.ctx.GetStringFlagValue(flags.AttachLocal),
 		ebc.ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath),
 		ebc.ctx.GetStringFlagValue(flags.AttachArtifactoryPath),
+		utils.CreateEvidenceOptions(ebc.ctx)...,
 	)
 	return ebc.execute(createCmd)
 }
@@ -66,6 +67,7 @@ func (ebc *evidenceBuildCommand) VerifyEvidence(ctx *components.Context, serverD
 		ebc.ctx.GetStringFlagValue(flags.Format),
 		ebc.ctx.GetStringsArrFlagValue(flags.PublicKeys),
 		ebc.ctx.GetBoolFlagValue(flags.UseArtifactoryKeys),
+		utils.VerifyEvidenceOptions(ebc.ctx)...,
 	)
 	return ebc.execute(verifyCmd)
 }
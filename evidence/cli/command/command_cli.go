@@ -7,7 +7,9 @@ import (
 	"io"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
@@ -21,7 +23,11 @@ import (
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/releasebundle"
 	commandUtils "github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/utils"
 	evdConfig "github.com/jfrog/jfrog-cli-evidence/evidence/config"
+	evdCreate "github.com/jfrog/jfrog-cli-evidence/evidence/create"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/foureyes"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sbom"
 
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
@@ -34,7 +40,6 @@ import (
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/docs/generate"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/docs/get"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/docs/verify"
-	sonarhelper "github.com/jfrog/jfrog-cli-evidence/evidence/sonar"
 	evidenceUtils "github.com/jfrog/jfrog-cli-evidence/evidence/utils"
 	"github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -192,6 +197,22 @@ func validateGetEvidenceCommonContext(ctx *components.Context) error {
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	return validateDownloadAttachmentsFlags(ctx)
+}
+
+// validateDownloadAttachmentsFlags checks that the download flags come with a download directory.
+func validateDownloadAttachmentsFlags(ctx *components.Context) error {
+	if ctx.GetStringFlagValue(flags.DownloadAttachments) == "" {
+		for _, flag := range []string{flags.DownloadEnvelopes, flags.DownloadThreads} {
+			if ctx.IsFlagSet(flag) {
+				return errorutils.CheckErrorf("--%s requires --%s", flag, flags.DownloadAttachments)
+			}
+		}
+		return nil
+	}
+	if ctx.IsFlagSet(flags.DownloadThreads) && !evidenceUtils.IsFlagPositiveNumber(ctx.GetStringFlagValue(flags.DownloadThreads)) {
+		return errorutils.CheckErrorf("--%s must be a positive number", flags.DownloadThreads)
+	}
 	return nil
 }
 
@@ -237,6 +258,14 @@ func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	if err := validateKeylessFlags(ctx); err != nil {
+		return err
+	}
+
+	if err := validateValidityFlags(ctx); err != nil {
+		return err
+	}
+
 	if commandUtils.AssertValueProvided(ctx, flags.SigstoreBundle) == nil {
 		if err := validateSigstoreBundleArgsConflicts(ctx); err != nil {
 			return err
@@ -247,44 +276,40 @@ func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 		return nil
 	}
 
-	if commandUtils.AssertValueProvided(ctx, flags.Integration) == nil {
-		if err := evidenceUtils.ValidateIntegration(ctx.GetStringFlagValue(flags.Integration)); err != nil {
-			return err
-		}
+	integration := ctx.GetStringFlagValue(flags.Integration)
+	if err := integrations.ValidateFlags(integration, ctx); err != nil {
+		return err
 	}
+	_, generatesPredicate := integrations.Get(integration)
 
-	if commandUtils.AssertValueProvided(ctx, flags.Predicate) != nil && !ctx.IsFlagSet(flags.TypeFlag) {
-		if !evidenceUtils.IsSonarIntegration(ctx.GetStringFlagValue(flags.Integration)) {
-			return errorutils.CheckErrorf("'Predicate' is a mandatory field for creating evidence: --%s", flags.Predicate)
-		}
+	if commandUtils.AssertValueProvided(ctx, flags.Predicate) != nil && !ctx.IsFlagSet(flags.TypeFlag) && !generatesPredicate {
+		return errorutils.CheckErrorf("'Predicate' is a mandatory field for creating evidence: --%s", flags.Predicate)
 	}
 
-	if commandUtils.AssertValueProvided(ctx, flags.PredicateType) != nil && !ctx.IsFlagSet(flags.TypeFlag) {
-		if !evidenceUtils.IsSonarIntegration(ctx.GetStringFlagValue(flags.Integration)) {
-			return errorutils.CheckErrorf("'Predicate-type' is a mandatory field for creating evidence: --%s", flags.PredicateType)
-		}
+	if commandUtils.AssertValueProvided(ctx, flags.PredicateType) != nil && !ctx.IsFlagSet(flags.TypeFlag) && !generatesPredicate {
+		return errorutils.CheckErrorf("'Predicate-type' is a mandatory field for creating evidence: --%s", flags.PredicateType)
 	}
 
-	// Validate SonarQube requirements when sonar integration is set
-	if evidenceUtils.IsSonarIntegration(ctx.GetStringFlagValue(flags.Integration)) {
-		if err := validateSonarQubeRequirements(); err != nil {
+	if !ctx.GetBoolFlagValue(flags.Keyless) {
+		if err := resolveAndNormalizeKey(ctx, flags.Key); err != nil {
 			return err
 		}
-		// Conflicting flags with sonar evidence type
-		if ctx.IsFlagSet(flags.Predicate) && ctx.GetStringFlagValue(flags.Predicate) != "" {
-			return errorutils.CheckErrorf("--%s cannot be used together with --%s %s", flags.Predicate, flags.Integration, evidenceUtils.SonarIntegration)
-		}
-		if ctx.IsFlagSet(flags.PredicateType) && ctx.GetStringFlagValue(flags.PredicateType) != "" {
-			return errorutils.CheckErrorf("--%s cannot be used together with --%s %s", flags.PredicateType, flags.Integration, evidenceUtils.SonarIntegration)
+
+		if !ctx.IsFlagSet(flags.KeyAlias) {
+			setKeyAliasIfProvided(ctx, flags.KeyAlias)
 		}
 	}
-
-	if err := resolveAndNormalizeKey(ctx, flags.Key); err != nil {
+	if err := validateSbomFlags(ctx); err != nil {
 		return err
 	}
-
-	if !ctx.IsFlagSet(flags.KeyAlias) {
-		setKeyAliasIfProvided(ctx, flags.KeyAlias)
+	if err := validateGitRangeFlags(ctx); err != nil {
+		return err
+	}
+	if err := validateFourEyesFlags(ctx); err != nil {
+		return err
+	}
+	if err := validateMarkdownTemplateFlags(ctx); err != nil {
+		return err
 	}
 	if err := validateAttachmentFlags(ctx); err != nil {
 		return err
@@ -294,14 +319,17 @@ func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 
 func validateAttachmentFlags(ctx *components.Context) error {
 	attachLocal := ctx.GetStringFlagValue(flags.AttachLocal)
-	attachArtifactoryPath := ctx.GetStringFlagValue(flags.AttachArtifactoryPath)
 	attachArtifactoryTempPath := ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath)
 
-	if attachLocal != "" && attachArtifactoryPath != "" {
-		return errorutils.CheckErrorf("exactly one of --%s or --%s can be used", flags.AttachLocal, flags.AttachArtifactoryPath)
+	if err := validateAttachDirectoryMode(ctx, attachLocal); err != nil {
+		return err
 	}
 
-	if attachArtifactoryTempPath != "" && attachLocal == "" {
+	// Attachments generated by the CLI, such as SBOMs or integration reports, are uploaded like --attach-local.
+	generatedAttachment := ctx.GetBoolFlagValue(flags.SbomAsAttachment) ||
+		integrations.AttachmentRequested(ctx.GetStringFlagValue(flags.Integration), ctx)
+
+	if attachArtifactoryTempPath != "" && attachLocal == "" && !generatedAttachment {
 		return errorutils.CheckErrorf("--%s can be used only with --%s", flags.AttachArtifactoryTempPath, flags.AttachLocal)
 	}
 
@@ -313,7 +341,7 @@ func validateAttachmentFlags(ctx *components.Context) error {
 		ctx.AddStringFlag(flags.AttachArtifactoryTempPath, defaultTarget)
 	}
 
-	if attachLocal != "" && ctx.IsFlagSet(flags.AttachArtifactoryTempPath) {
+	if (attachLocal != "" || generatedAttachment) && ctx.IsFlagSet(flags.AttachArtifactoryTempPath) {
 		if err := evdConfig.PersistAttachmentArtifactoryTempPath(ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath)); err != nil {
 			log.Warn("error persisting attachment artifactory temp path:", err)
 			return nil
@@ -322,6 +350,134 @@ func validateAttachmentFlags(ctx *components.Context) error {
 	return nil
 }
 
+// validateAttachDirectoryMode checks the directory mode of the --attach-local directories.
+func validateAttachDirectoryMode(ctx *components.Context, attachLocal string) error {
+	mode := ctx.GetStringFlagValue(flags.AttachDirectoryMode)
+	if mode == "" {
+		return nil
+	}
+	if attachLocal == "" {
+		return errorutils.CheckErrorf("--%s can be used only with --%s", flags.AttachDirectoryMode, flags.AttachLocal)
+	}
+	if !strings.EqualFold(mode, evdCreate.AttachDirectoryArchive) && !strings.EqualFold(mode, evdCreate.AttachDirectoryExpand) {
+		return errorutils.CheckErrorf("invalid --%s value '%s': supported values are '%s' and '%s'", flags.AttachDirectoryMode, mode, evdCreate.AttachDirectoryArchive, evdCreate.AttachDirectoryExpand)
+	}
+	return nil
+}
+
+// validateSbomFlags checks that --sbom-as-attachment is used with an SBOM predicate and can be uploaded.
+func validateSbomFlags(ctx *components.Context) error {
+	if !ctx.GetBoolFlagValue(flags.SbomAsAttachment) {
+		return nil
+	}
+	if !strings.EqualFold(ctx.GetStringFlagValue(flags.PredicateType), sbom.PredicateTypeAuto) {
+		return errorutils.CheckErrorf("--%s can be used only with --%s %s", flags.SbomAsAttachment, flags.PredicateType, sbom.PredicateTypeAuto)
+	}
+	if ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath) == "" && evdConfig.ResolveAttachmentArtifactoryTempPath() == "" {
+		return errorutils.CheckErrorf("--%s is required with --%s (or set %s / %s)", flags.AttachArtifactoryTempPath, flags.SbomAsAttachment, evdConfig.EnvAttachmentArtifactoryTempPath, evdConfig.KeyAttachmentArtifactoryTempPath)
+	}
+	return nil
+}
+
+// validateGitRangeFlags checks that the local clone flags are used only with --type git-committer.
+func validateGitRangeFlags(ctx *components.Context) error {
+	if ctx.GetStringFlagValue(flags.TypeFlag) != string(evdCreate.FlagTypeGitCommitter) {
+		for _, flag := range []string{flags.GitRepoPath, flags.GitFromRef, flags.GitToRef} {
+			if ctx.GetStringFlagValue(flag) != "" {
+				return errorutils.CheckErrorf("--%s can be used only with --%s %s", flag, flags.TypeFlag, evdCreate.FlagTypeGitCommitter)
+			}
+		}
+		return nil
+	}
+	if ctx.GetStringFlagValue(flags.GitToRef) != "" && ctx.GetStringFlagValue(flags.GitFromRef) == "" {
+		return errorutils.CheckErrorf("--%s requires --%s", flags.GitToRef, flags.GitFromRef)
+	}
+	return nil
+}
+
+// validateFourEyesFlags checks that the four-eyes policy is a positive number of approvals on evidence
+// that records pull request reviews.
+func validateFourEyesFlags(ctx *components.Context) error {
+	approvals := ctx.GetStringFlagValue(flags.FourEyesApprovals)
+	if approvals == "" {
+		if ctx.GetStringFlagValue(flags.FourEyesApprovers) != "" {
+			return errorutils.CheckErrorf("--%s requires --%s", flags.FourEyesApprovers, flags.FourEyesApprovals)
+		}
+		return nil
+	}
+	switch evdCreate.FlagType(ctx.GetStringFlagValue(flags.TypeFlag)) {
+	case evdCreate.FlagTypeCommitterReviewer, evdCreate.FlagTypeGitLabCommitterReviewer:
+	default:
+		return errorutils.CheckErrorf("--%s can be used only with --%s %s or %s", flags.FourEyesApprovals, flags.TypeFlag, evdCreate.FlagTypeCommitterReviewer, evdCreate.FlagTypeGitLabCommitterReviewer)
+	}
+	required, err := strconv.Atoi(approvals)
+	if err != nil {
+		return errorutils.CheckErrorf("invalid --%s value '%s': must be a number", flags.FourEyesApprovals, approvals)
+	}
+	return errorutils.CheckError(foureyes.Policy{RequiredApprovals: required}.Validate())
+}
+
+// validateMarkdownTemplateFlags checks that the markdown is either read from --markdown or rendered from a template.
+func validateMarkdownTemplateFlags(ctx *components.Context) error {
+	if ctx.GetStringFlagValue(flags.MarkdownTemplate) != "" && ctx.GetStringFlagValue(flags.Markdown) != "" {
+		return errorutils.CheckErrorf("only one of --%s or --%s can be used", flags.Markdown, flags.MarkdownTemplate)
+	}
+	return nil
+}
+
+// validateKeylessFlags ensures --keyless is not combined with key based signing and that
+// the Sigstore specific flags are only used together with --keyless.
+func validateKeylessFlags(ctx *components.Context) error {
+	if !ctx.GetBoolFlagValue(flags.Keyless) {
+		for _, flag := range []string{flags.IdentityToken, flags.FulcioUrl, flags.RekorUrl} {
+			if ctx.IsFlagSet(flag) && ctx.GetStringFlagValue(flag) != "" {
+				return errorutils.CheckErrorf("--%s can only be used together with --%s", flag, flags.Keyless)
+			}
+		}
+		return nil
+	}
+
+	var conflictingParams []string
+	for _, flag := range []string{flags.Key, flags.KeyAlias, flags.SigstoreBundle} {
+		if ctx.IsFlagSet(flag) && ctx.GetStringFlagValue(flag) != "" {
+			conflictingParams = append(conflictingParams, "--"+flag)
+		}
+	}
+	if len(conflictingParams) > 0 {
+		return errorutils.CheckErrorf("The following parameters cannot be used with --%s: %s", flags.Keyless, strings.Join(conflictingParams, ", "))
+	}
+	return nil
+}
+
+// validateValidityFlags ensures at most one way of setting the evidence expiry is used and that it can be parsed.
+func validateValidityFlags(ctx *components.Context) error {
+	validFor := ctx.GetStringFlagValue(flags.ValidFor)
+	expiresAt := ctx.GetStringFlagValue(flags.ExpiresAt)
+	if validFor == "" && expiresAt == "" {
+		return nil
+	}
+	if validFor != "" && expiresAt != "" {
+		return errorutils.CheckErrorf("exactly one of --%s or --%s can be used", flags.ValidFor, flags.ExpiresAt)
+	}
+	if commandUtils.AssertValueProvided(ctx, flags.SigstoreBundle) == nil {
+		return errorutils.CheckErrorf("--%s and --%s cannot be used with --%s; the statement is already signed", flags.ValidFor, flags.ExpiresAt, flags.SigstoreBundle)
+	}
+	if validFor != "" {
+		if duration, err := evidenceUtils.ParseDuration(validFor); err != nil || duration <= 0 {
+			return errorutils.CheckErrorf("invalid --%s value '%s': expected a positive duration such as 90d, 2w or 36h", flags.ValidFor, validFor)
+		}
+		return nil
+	}
+	parsed, err := evidenceUtils.ParseTimestamp(expiresAt)
+	if err != nil {
+		return errorutils.CheckErrorf("invalid --%s value '%s': expected an RFC 3339 time or a YYYY-MM-DD date", flags.ExpiresAt, expiresAt)
+	}
+	if !parsed.After(time.Now()) {
+		return errorutils.CheckErrorf("--%s must be in the future", flags.ExpiresAt)
+	}
+	return nil
+}
+
 func validateSigstoreBundleArgsConflicts(ctx *components.Context) error {
 	var conflictingParams []string
 
@@ -337,6 +493,12 @@ func validateSigstoreBundleArgsConflicts(ctx *components.Context) error {
 	if ctx.IsFlagSet(flags.PredicateType) && ctx.GetStringFlagValue(flags.PredicateType) != "" {
 		conflictingParams = append(conflictingParams, "--"+flags.PredicateType)
 	}
+	if ctx.IsFlagSet(flags.MarkdownTemplate) && ctx.GetStringFlagValue(flags.MarkdownTemplate) != "" {
+		conflictingParams = append(conflictingParams, "--"+flags.MarkdownTemplate)
+	}
+	if ctx.IsFlagSet(flags.PredicateSchema) && ctx.GetStringFlagValue(flags.PredicateSchema) != "" {
+		conflictingParams = append(conflictingParams, "--"+flags.PredicateSchema)
+	}
 	if ctx.IsFlagSet(flags.AttachLocal) && ctx.GetStringFlagValue(flags.AttachLocal) != "" {
 		conflictingParams = append(conflictingParams, "--"+flags.AttachLocal)
 	}
@@ -346,6 +508,9 @@ func validateSigstoreBundleArgsConflicts(ctx *components.Context) error {
 	if ctx.IsFlagSet(flags.AttachArtifactoryPath) && ctx.GetStringFlagValue(flags.AttachArtifactoryPath) != "" {
 		conflictingParams = append(conflictingParams, "--"+flags.AttachArtifactoryPath)
 	}
+	if ctx.IsFlagSet(flags.AttachDirectoryMode) && ctx.GetStringFlagValue(flags.AttachDirectoryMode) != "" {
+		conflictingParams = append(conflictingParams, "--"+flags.AttachDirectoryMode)
+	}
 
 	if len(conflictingParams) > 0 {
 		return errorutils.CheckErrorf("The following parameters cannot be used with --%s: %s. These values are extracted from the bundle itself:", flags.SigstoreBundle, strings.Join(conflictingParams, ", "))
@@ -489,22 +654,6 @@ func platformToEvidenceUrls(rtDetails *config.ServerDetails) {
 	rtDetails.ApptrustUrl = utils.AddTrailingSlashIfNeeded(rtDetails.Url) + "apptrust/"
 }
 
-func validateSonarQubeRequirements() error {
-	// Check if SonarQube token is present
-	if os.Getenv("SONAR_TOKEN") == "" && os.Getenv("SONARQUBE_TOKEN") == "" {
-		return errorutils.CheckErrorf("SonarQube token is required when using --%s %s. Please set SONAR_TOKEN or SONARQUBE_TOKEN environment variable", flags.Integration, evidenceUtils.SonarIntegration)
-	}
-
-	// Check if report-task.txt exists using the detector or config
-	reportPath := sonarhelper.GetReportTaskPath()
-	if reportPath == "" {
-		return errorutils.CheckErrorf("SonarQube report-task.txt file not found. Please ensure SonarQube analysis has been completed or configure a custom path in evidence config")
-	}
-	log.Info("Found SonarQube task report:", reportPath)
-
-	return nil
-}
-
 func generateKeyPair(ctx *components.Context) error {
 	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
 		return err
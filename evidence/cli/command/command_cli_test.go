@@ -757,101 +757,61 @@ func TestResolveAndNormalizeKey_TrimsWhitespace(t *testing.T) {
 	}
 }
 
-func TestValidateSonarQubeRequirements(t *testing.T) {
-	// Save original environment variables
-	originalSonarToken := os.Getenv("SONAR_TOKEN")
-	originalSonarQubeToken := os.Getenv("SONARQUBE_TOKEN")
-	defer func() {
-		err := os.Setenv("SONAR_TOKEN", originalSonarToken)
-		if err != nil {
-			assert.FailNow(t, err.Error())
-		}
-		err = os.Setenv("SONARQUBE_TOKEN", originalSonarQubeToken)
-		if err != nil {
-			assert.FailNow(t, err.Error())
-		}
-	}()
-
-	tests := []struct {
-		name           string
-		sonarToken     string
-		sonarQubeToken string
-		expectError    bool
-		errorContains  string
-	}{
-		{
-			name:           "Valid_With_SONAR_TOKEN",
-			sonarToken:     "test-token",
-			sonarQubeToken: "",
-			expectError:    false,
-		},
-		{
-			name:           "Valid_With_SONARQUBE_TOKEN",
-			sonarToken:     "",
-			sonarQubeToken: "test-token",
-			expectError:    false,
-		},
-		{
-			name:           "Valid_With_Both_Tokens",
-			sonarToken:     "test-token-1",
-			sonarQubeToken: "test-token-2",
-			expectError:    false,
-		},
-		{
-			name:           "Invalid_No_Token",
-			sonarToken:     "",
-			sonarQubeToken: "",
-			expectError:    true,
-			errorContains:  "SonarQube token is required",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			// Set environment variables for test
-			err := os.Setenv("SONAR_TOKEN", tt.sonarToken)
-			if err != nil {
-				assert.FailNow(t, err.Error())
-			}
-			err = os.Setenv("SONARQUBE_TOKEN", tt.sonarQubeToken)
-			if err != nil {
-				assert.FailNow(t, err.Error())
-			}
-
-			err = validateSonarQubeRequirements()
-
-			if tt.expectError {
-				assert.Error(t, err)
-				if tt.errorContains != "" {
-					assert.Contains(t, err.Error(), tt.errorContains)
-				}
-			} else if err != nil {
-				// Note: This test will fail if report-task.txt doesn't exist
-				// In a real test environment, you might want to mock the file system
-				// or create a temporary file for testing.
-				// If an error occurs, it's expected to be about the missing report-task.txt file.
-				assert.Contains(t, err.Error(), "report-task.txt file not found")
-			}
-		})
-	}
-}
-
 func TestValidateCreateEvidenceCommonContext_Attachments(t *testing.T) {
 	app := cli.NewApp()
 	app.Commands = []cli.Command{{Name: "create"}}
 	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
 
-	t.Run("local and artifactory conflict", func(t *testing.T) {
+	t.Run("local and artifactory combined", func(t *testing.T) {
 		c, err := components.ConvertContext(ctx,
 			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
 			test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
 			test.SetDefaultValue(flags.PredicateType, "ptype"),
 			test.SetDefaultValue(flags.Key, "k"),
-			test.SetDefaultValue(flags.AttachLocal, "/tmp/a.txt"),
-			test.SetDefaultValue(flags.AttachArtifactoryPath, "repo/other/a.txt"),
+			test.SetDefaultValue(flags.AttachLocal, "/tmp/a.txt;/tmp/reports"),
+			test.SetDefaultValue(flags.AttachArtifactoryTempPath, "repo/tmp/"),
+			test.SetDefaultValue(flags.AttachArtifactoryPath, "repo/other/a.txt;repo/other/b.txt"),
 		)
 		assert.NoError(t, err)
-		assert.Error(t, validateCreateEvidenceCommonContext(c))
+		assert.NoError(t, validateCreateEvidenceCommonContext(c))
+	})
+
+	t.Run("directory mode", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			attachLocal string
+			mode        string
+			wantErr     string
+		}{
+			{name: "expand", attachLocal: "/tmp/reports", mode: "expand"},
+			{name: "archive", attachLocal: "/tmp/reports", mode: "archive"},
+			{name: "unknown mode", attachLocal: "/tmp/reports", mode: "flatten", wantErr: "--attach-directory-mode"},
+			{name: "without local", mode: "expand", wantErr: "--attach-directory-mode can be used only with --attach-local"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				values := []components.Flag{
+					test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+					test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
+					test.SetDefaultValue(flags.PredicateType, "ptype"),
+					test.SetDefaultValue(flags.Key, "k"),
+					test.SetDefaultValue(flags.AttachDirectoryMode, tt.mode),
+				}
+				if tt.attachLocal != "" {
+					values = append(values,
+						test.SetDefaultValue(flags.AttachLocal, tt.attachLocal),
+						test.SetDefaultValue(flags.AttachArtifactoryTempPath, "repo/tmp/"))
+				}
+				c, err := components.ConvertContext(ctx, values...)
+				assert.NoError(t, err)
+				err = validateCreateEvidenceCommonContext(c)
+				if tt.wantErr == "" {
+					assert.NoError(t, err)
+					return
+				}
+				assert.ErrorContains(t, err, tt.wantErr)
+			})
+		}
 	})
 
 	t.Run("temp target from env", func(t *testing.T) {
@@ -1134,3 +1094,431 @@ func TestPrintCreateEvidenceResponse_Table_NoAttachmentRowsWhenAbsent(t *testing
 	assert.NotContains(t, out, "attachment.")
 	assert.NotContains(t, out, "attachments[")
 }
+
+func TestValidateCreateEvidenceCommonContext_Keyless(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+
+	t.Run("keyless without key", func(t *testing.T) {
+		t.Setenv(coreUtils.SigningKey, "")
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
+			test.SetDefaultValue(flags.PredicateType, "ptype"),
+			test.SetDefaultValue(flags.RekorUrl, "https://rekor.example.com"),
+		)
+		assert.NoError(t, err)
+		c.AddBoolFlag(flags.Keyless, true)
+		assert.NoError(t, validateCreateEvidenceCommonContext(c))
+		assert.Empty(t, c.GetStringFlagValue(flags.Key))
+	})
+
+	t.Run("keyless with key conflicts", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
+			test.SetDefaultValue(flags.PredicateType, "ptype"),
+			test.SetDefaultValue(flags.Key, "k"),
+			test.SetDefaultValue(flags.KeyAlias, "alias"),
+		)
+		assert.NoError(t, err)
+		c.AddBoolFlag(flags.Keyless, true)
+		err = validateCreateEvidenceCommonContext(c)
+		assert.ErrorContains(t, err, "cannot be used with --keyless: --key, --key-alias")
+	})
+
+	t.Run("keyless with sigstore bundle conflicts", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.SigstoreBundle, "/tmp/bundle.json"),
+		)
+		assert.NoError(t, err)
+		c.AddBoolFlag(flags.Keyless, true)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--sigstore-bundle")
+	})
+
+	t.Run("sigstore endpoint without keyless", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
+			test.SetDefaultValue(flags.PredicateType, "ptype"),
+			test.SetDefaultValue(flags.Key, "k"),
+			test.SetDefaultValue(flags.FulcioUrl, "https://fulcio.example.com"),
+		)
+		assert.NoError(t, err)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--fulcio-url can only be used together with --keyless")
+	})
+}
+
+func TestValidateCreateEvidenceCommonContext_Validity(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	newContext := func(t *testing.T, extra ...func(*components.Context)) *components.Context {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
+			test.SetDefaultValue(flags.PredicateType, "ptype"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		for _, apply := range extra {
+			apply(c)
+		}
+		return c
+	}
+
+	t.Run("valid-for", func(t *testing.T) {
+		c := newContext(t, func(c *components.Context) { c.AddStringFlag(flags.ValidFor, "90d") })
+		assert.NoError(t, validateValidityFlags(c))
+	})
+
+	t.Run("invalid valid-for", func(t *testing.T) {
+		c := newContext(t, func(c *components.Context) { c.AddStringFlag(flags.ValidFor, "ninety days") })
+		assert.ErrorContains(t, validateValidityFlags(c), "invalid --valid-for value")
+	})
+
+	t.Run("expires-at in the past", func(t *testing.T) {
+		c := newContext(t, func(c *components.Context) { c.AddStringFlag(flags.ExpiresAt, "2020-01-01") })
+		assert.ErrorContains(t, validateValidityFlags(c), "--expires-at must be in the future")
+	})
+
+	t.Run("both set", func(t *testing.T) {
+		c := newContext(t, func(c *components.Context) {
+			c.AddStringFlag(flags.ValidFor, "90d")
+			c.AddStringFlag(flags.ExpiresAt, "2099-01-01")
+		})
+		assert.ErrorContains(t, validateValidityFlags(c), "exactly one of --valid-for or --expires-at")
+	})
+
+	t.Run("sigstore bundle conflicts", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.SigstoreBundle, "/tmp/bundle.json"),
+			test.SetDefaultValue(flags.ExpiresAt, "2099-01-01"),
+		)
+		assert.NoError(t, err)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "cannot be used with --sigstore-bundle")
+	})
+}
+
+func TestValidateCreateEvidenceCommonContext_SlsaProvenance(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	newContext := func(t *testing.T, extra ...func(*components.Context)) *components.Context {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.BuildName, "my-build"),
+			test.SetDefaultValue(flags.BuildNumber, "42"),
+			test.SetDefaultValue(flags.Integration, "slsa-provenance"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		for _, apply := range extra {
+			apply(c)
+		}
+		return c
+	}
+
+	t.Run("no predicate required", func(t *testing.T) {
+		assert.NoError(t, validateCreateEvidenceCommonContext(newContext(t)))
+	})
+
+	t.Run("artifacts target", func(t *testing.T) {
+		c := newContext(t, func(c *components.Context) { c.AddStringFlag(flags.ProvenanceTarget, "artifacts") })
+		assert.NoError(t, validateCreateEvidenceCommonContext(c))
+	})
+
+	t.Run("unknown target", func(t *testing.T) {
+		c := newContext(t, func(c *components.Context) { c.AddStringFlag(flags.ProvenanceTarget, "modules") })
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "provenance target modules is not supported")
+	})
+
+	t.Run("predicate conflicts", func(t *testing.T) {
+		c := newContext(t, func(c *components.Context) { c.AddStringFlag(flags.Predicate, "/tmp/p.json") })
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--predicate cannot be used together with --integration slsa-provenance")
+	})
+
+	t.Run("requires build subject", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Integration, "slsa-provenance"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "requires a build subject")
+	})
+
+	t.Run("target without integration", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
+			test.SetDefaultValue(flags.PredicateType, "ptype"),
+			test.SetDefaultValue(flags.ProvenanceTarget, "artifacts"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--provenance-target can be used only with --integration slsa-provenance")
+	})
+}
+
+func TestValidateCreateEvidenceCommonContext_JUnit(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+
+	t.Run("reports required", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Integration, "junit"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--junit-reports is required with --integration junit")
+	})
+
+	t.Run("no predicate required", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Integration, "junit"),
+			test.SetDefaultValue(flags.JUnitReports, "build/test-results/**/*.xml"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, validateCreateEvidenceCommonContext(c))
+	})
+
+	t.Run("reports without integration", func(t *testing.T) {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "/tmp/p.json"),
+			test.SetDefaultValue(flags.PredicateType, "ptype"),
+			test.SetDefaultValue(flags.JUnitReports, "build/test-results/**/*.xml"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--junit-reports can be used only with --integration junit")
+	})
+}
+
+func TestValidateCreateEvidenceCommonContext_Sbom(t *testing.T) {
+	// Keep evidence.yml lookups away from any configured attachment temp path.
+	t.Chdir(t.TempDir())
+	t.Setenv("JFROG_CLI_HOME_DIR", t.TempDir())
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	newContext := func(t *testing.T, predicateType string, extra ...func(*components.Context)) *components.Context {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "/tmp/bom.json"),
+			test.SetDefaultValue(flags.PredicateType, predicateType),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		for _, apply := range extra {
+			apply(c)
+		}
+		return c
+	}
+	asAttachment := func(c *components.Context) { c.AddBoolFlag(flags.SbomAsAttachment, true) }
+
+	t.Run("auto predicate type", func(t *testing.T) {
+		assert.NoError(t, validateCreateEvidenceCommonContext(newContext(t, "auto")))
+	})
+
+	t.Run("attachment requires auto", func(t *testing.T) {
+		c := newContext(t, "https://cyclonedx.org/bom/v1.5", asAttachment)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--sbom-as-attachment can be used only with --predicate-type auto")
+	})
+
+	t.Run("attachment requires temp path", func(t *testing.T) {
+		c := newContext(t, "auto", asAttachment)
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--attach-artifactory-temp-path is required with --sbom-as-attachment")
+	})
+
+	t.Run("attachment with temp path", func(t *testing.T) {
+		c := newContext(t, "auto", asAttachment, func(c *components.Context) { c.AddStringFlag(flags.AttachArtifactoryTempPath, "repo/tmp/") })
+		assert.NoError(t, validateCreateEvidenceCommonContext(c))
+	})
+
+	t.Run("attachment with user attachments", func(t *testing.T) {
+		c := newContext(t, "auto", asAttachment, func(c *components.Context) {
+			c.AddStringFlag(flags.AttachLocal, "/tmp/a.txt")
+			c.AddStringFlag(flags.AttachArtifactoryPath, "repo/reports/b.html")
+			c.AddStringFlag(flags.AttachArtifactoryTempPath, "repo/tmp/")
+		})
+		assert.NoError(t, validateCreateEvidenceCommonContext(c))
+	})
+}
+
+func TestValidateCreateEvidenceCommonContext_GitRange(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	newContext := func(t *testing.T, typeFlag string, gitFlags map[string]string) *components.Context {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.BuildName, "b"),
+			test.SetDefaultValue(flags.BuildNumber, "1"),
+			test.SetDefaultValue(flags.TypeFlag, typeFlag),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		for name, value := range gitFlags {
+			c.AddStringFlag(name, value)
+		}
+		return c
+	}
+
+	t.Run("range", func(t *testing.T) {
+		c := newContext(t, "git-committer", map[string]string{flags.GitRepoPath: ".", flags.GitFromRef: "v1.0.0", flags.GitToRef: "v1.1.0"})
+		assert.NoError(t, validateCreateEvidenceCommonContext(c))
+	})
+
+	t.Run("since previous build", func(t *testing.T) {
+		assert.NoError(t, validateCreateEvidenceCommonContext(newContext(t, "git-committer", nil)))
+	})
+
+	t.Run("to ref requires from ref", func(t *testing.T) {
+		c := newContext(t, "git-committer", map[string]string{flags.GitToRef: "HEAD"})
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--git-to-ref requires --git-from-ref")
+	})
+
+	t.Run("other type", func(t *testing.T) {
+		c := newContext(t, "gh-commiter", map[string]string{flags.GitFromRef: "v1.0.0"})
+		assert.ErrorContains(t, validateCreateEvidenceCommonContext(c), "--git-from-ref can be used only with --type git-committer")
+	})
+}
+
+func TestValidateCreateEvidenceCommonContext_FourEyes(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	newContext := func(t *testing.T, typeFlag, approvals, approvers string) *components.Context {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.BuildName, "b"),
+			test.SetDefaultValue(flags.BuildNumber, "1"),
+			test.SetDefaultValue(flags.TypeFlag, typeFlag),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		c.AddStringFlag(flags.FourEyesApprovals, approvals)
+		c.AddStringFlag(flags.FourEyesApprovers, approvers)
+		return c
+	}
+
+	tests := []struct {
+		name                string
+		typeFlag            string
+		approvals           string
+		approvers           string
+		expectErrorContains string
+	}{
+		{"github", "gh-commiter", "2", "alice,bob", ""},
+		{"gitlab", "gl-committer", "1", "", ""},
+		{"approvers without approvals", "gh-commiter", "", "alice", "--four-eyes-approvers requires --four-eyes-approvals"},
+		{"local clone", "git-committer", "1", "", "--four-eyes-approvals can be used only with --type gh-commiter or gl-committer"},
+		{"not a number", "gh-commiter", "two", "", "invalid --four-eyes-approvals value 'two'"},
+		{"zero", "gh-commiter", "0", "", "requires at least 1 approval"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCreateEvidenceCommonContext(newContext(t, tt.typeFlag, tt.approvals, tt.approvers))
+			if tt.expectErrorContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectErrorContains)
+		})
+	}
+}
+
+func TestValidateCreateEvidenceCommonContext_MarkdownTemplate(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	newContext := func(t *testing.T, markdown string) *components.Context {
+		c, err := components.ConvertContext(ctx,
+			test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+			test.SetDefaultValue(flags.Predicate, "predicate.json"),
+			test.SetDefaultValue(flags.PredicateType, "https://example.com/scan/v1"),
+			test.SetDefaultValue(flags.MarkdownTemplate, "summary.md.tmpl"),
+			test.SetDefaultValue(flags.Key, "k"),
+		)
+		assert.NoError(t, err)
+		c.AddStringFlag(flags.Markdown, markdown)
+		return c
+	}
+
+	assert.NoError(t, validateCreateEvidenceCommonContext(newContext(t, "")))
+	assert.ErrorContains(t, validateCreateEvidenceCommonContext(newContext(t, "summary.md")), "only one of --markdown or --markdown-template can be used")
+}
+
+func TestValidateCreateEvidenceCommonContext_IntegrationAttachmentTempPath(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("JFROG_CLI_HOME_DIR", t.TempDir())
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "create"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	c, err := components.ConvertContext(ctx,
+		test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"),
+		test.SetDefaultValue(flags.Integration, "junit"),
+		test.SetDefaultValue(flags.JUnitReports, "build/test-results/**/*.xml"),
+		test.SetDefaultValue(flags.AttachArtifactoryTempPath, "repo/tmp/"),
+		test.SetDefaultValue(flags.Key, "k"),
+	)
+	assert.NoError(t, err)
+	c.AddBoolFlag(flags.JUnitAttachReports, true)
+	assert.NoError(t, validateCreateEvidenceCommonContext(c))
+
+	// The generated attachment is uploaded next to the user attachments.
+	c.AddStringFlag(flags.AttachLocal, "coverage.html")
+	c.AddStringFlag(flags.AttachArtifactoryPath, "repo/reports/scan.sarif")
+	assert.NoError(t, validateCreateEvidenceCommonContext(c))
+}
+
+func TestValidateGetEvidenceCommonContext_DownloadAttachments(t *testing.T) {
+	app := cli.NewApp()
+	app.Commands = []cli.Command{{Name: "get"}}
+	ctx := cli.NewContext(app, &flag.FlagSet{}, nil)
+	newContext := func(t *testing.T, downloadDir, threads string, envelopes bool) *components.Context {
+		c, err := components.ConvertContext(ctx, test.SetDefaultValue(flags.SubjectRepoPath, "repo/path/file"))
+		assert.NoError(t, err)
+		if downloadDir != "" {
+			c.AddStringFlag(flags.DownloadAttachments, downloadDir)
+		}
+		if threads != "" {
+			c.AddStringFlag(flags.DownloadThreads, threads)
+		}
+		if envelopes {
+			c.AddBoolFlag(flags.DownloadEnvelopes, true)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name                string
+		downloadDir         string
+		threads             string
+		envelopes           bool
+		expectErrorContains string
+	}{
+		{"no download", "", "", false, ""},
+		{"download", "out", "8", true, ""},
+		{"envelopes without directory", "", "", true, "--download-envelopes requires --download-attachments"},
+		{"threads without directory", "", "4", false, "--download-threads requires --download-attachments"},
+		{"zero threads", "out", "0", false, "--download-threads must be a positive number"},
+		{"threads not a number", "out", "many", false, "--download-threads must be a positive number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateGetEvidenceCommonContext(newContext(t, tt.downloadDir, tt.threads, tt.envelopes))
+			if tt.expectErrorContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectErrorContains)
+		})
+	}
+}
@@ -36,6 +36,7 @@ const (
 	PredicateType             = "predicate-type"
 	IncludePredicate          = "include-predicate"
 	Markdown                  = "markdown"
+	MarkdownTemplate          = "markdown-template"
 	SubjectRepoPath           = "subject-repo-path"
 	SubjectSha256             = "subject-sha256"
 	Key                       = "key"
@@ -48,10 +49,45 @@ const (
 	AttachLocal               = "attach-local"
 	AttachArtifactoryTempPath = "attach-artifactory-temp-path"
 	AttachArtifactoryPath     = "attach-artifactory-path"
+	AttachDirectoryMode       = "attach-directory-mode"
 	ArtifactsLimit            = "artifacts-limit"
 	UploadPublicKey           = "upload-public-key"
 	KeyFilePath               = "key-file-path"
 	KeyFileName               = "key-file-name"
+	Keyless                   = "keyless"
+	IdentityToken             = "identity-token"
+	FulcioUrl                 = "fulcio-url"
+	RekorUrl                  = "rekor-url"
+	Policy                    = "policy"
+	Assertions                = "assertions"
+	TsaUrl                    = "tsa-url"
+	TsaCertChain              = "tsa-cert-chain"
+	ValidFor                  = "valid-for"
+	ExpiresAt                 = "expires-at"
+	ProvenanceTarget          = "provenance-target"
+	JUnitReports              = "junit-reports"
+	JUnitAttachReports        = "junit-attach-reports"
+	SarifFile                 = "sarif-file"
+	SarifThresholds           = "sarif-thresholds"
+	SarifAttach               = "sarif-attach"
+	ScanReport                = "scan-report"
+	SbomAsAttachment          = "sbom-as-attachment"
+	GitRepoPath               = "git-repo-path"
+	GitFromRef                = "git-from-ref"
+	GitToRef                  = "git-to-ref"
+	FourEyesApprovals         = "four-eyes-approvals"
+	FourEyesApprovers         = "four-eyes-approvers"
+	JiraUrl                   = "jira-url"
+	JiraTicketPattern         = "jira-ticket-pattern"
+	JiraApproverField         = "jira-approver-field"
+	AsOf                      = "as-of"
+	Revocations               = "revocations"
+	PredicateSchema           = "predicate-schema"
+	ValidateSchemas           = "validate-schemas"
+	Deep                      = "deep"
+	DownloadAttachments       = "download-attachments"
+	DownloadEnvelopes         = "download-envelopes"
+	DownloadThreads           = "download-threads"
 )
 
 // Flag keys mapped to their corresponding components.Flag definition.
@@ -62,7 +98,7 @@ var flagsMap = map[string]components.Flag{
 	User:        components.NewStringFlag(User, "JFrog username.", func(f *components.StringFlag) { f.Mandatory = false }),
 	AccessToken: components.NewStringFlag(AccessToken, "JFrog access token.", func(f *components.StringFlag) { f.Mandatory = false }),
 	Project:     components.NewStringFlag(Project, "Project key associated with the created evidence.", func(f *components.StringFlag) { f.Mandatory = false }),
-	Format:      components.NewStringFlag(Format, "Output Format. Supported formats: 'json'. For 'jf evd get' command you can additionally choose 'jsonl' Format", func(f *components.StringFlag) { f.Mandatory = false }),
+	Format:      components.NewStringFlag(Format, "Output Format. Supported formats: 'json'. For 'jf evd get' command you can additionally choose 'jsonl' or 'html' Format. For 'jf evd verify' command you can additionally choose 'markdown', 'junit' or 'html' Format", func(f *components.StringFlag) { f.Mandatory = false }),
 	Output:      components.NewStringFlag(Output, "Output file path, should be in the Format of 'path/to/file.json'. If not provided, Output will be printed to the console.", func(f *components.StringFlag) { f.Mandatory = false }),
 
 	ReleaseBundle:        components.NewStringFlag(ReleaseBundle, "Release Bundle name.", func(f *components.StringFlag) { f.Mandatory = false }),
@@ -72,15 +108,16 @@ var flagsMap = map[string]components.Flag{
 	PackageName:          components.NewStringFlag(PackageName, "Package name.", func(f *components.StringFlag) { f.Mandatory = false }),
 	PackageVersion:       components.NewStringFlag(PackageVersion, "Package version.", func(f *components.StringFlag) { f.Mandatory = false }),
 	PackageRepoName:      components.NewStringFlag(PackageRepoName, "Package repository Name.", func(f *components.StringFlag) { f.Mandatory = false }),
-	TypeFlag:             components.NewStringFlag(TypeFlag, "Type can contain 'gh-commiter' value.", func(f *components.StringFlag) { f.Mandatory = false }),
+	TypeFlag:             components.NewStringFlag(TypeFlag, "Type can contain 'gh-commiter' (GitHub Actions), 'gl-committer' (GitLab CI) or 'git-committer' (local git clone, any CI) value.", func(f *components.StringFlag) { f.Mandatory = false }),
 	ApplicationKey:       components.NewStringFlag(ApplicationKey, "Application key.", func(f *components.StringFlag) { f.Mandatory = false }),
 	ApplicationVersion:   components.NewStringFlag(ApplicationVersion, "Application version.", func(f *components.StringFlag) { f.Mandatory = false }),
 
 	Predicate:        components.NewStringFlag(Predicate, "Path to the Predicate, arbitrary JSON. Mandatory unless --"+SigstoreBundle+" is used", func(f *components.StringFlag) { f.Mandatory = false }),
-	PredicateType:    components.NewStringFlag(PredicateType, "Type of the Predicate. Mandatory unless --"+SigstoreBundle+" is used", func(f *components.StringFlag) { f.Mandatory = false }),
+	PredicateType:    components.NewStringFlag(PredicateType, "Type of the Predicate. Mandatory unless --"+SigstoreBundle+" is used. Use 'auto' for a CycloneDX or SPDX JSON predicate to detect the SBOM format and version and set the matching type.", func(f *components.StringFlag) { f.Mandatory = false }),
 	IncludePredicate: components.NewBoolFlag(IncludePredicate, "Include the Predicate data in the get evidence Output.", components.WithBoolDefaultValueFalse()),
 	Markdown:         components.NewStringFlag(Markdown, "Markdown of the Predicate.", func(f *components.StringFlag) { f.Mandatory = false }),
-	SubjectRepoPath:  components.NewStringFlag(SubjectRepoPath, "Full path to some subject location.", func(f *components.StringFlag) { f.Mandatory = false }),
+	MarkdownTemplate: components.NewStringFlag(MarkdownTemplate, "Go text/template file rendered into the evidence markdown against the predicate JSON (.Predicate) and the subject metadata (.Subject.Path, .Subject.Sha256, .PredicateType, .Stage, .CreatedBy, .CreatedAt). Without --"+Markdown+" or --"+MarkdownTemplate+", a built-in template is used for SonarQube, committer and SLSA provenance predicates.", func(f *components.StringFlag) { f.Mandatory = false }),
+	SubjectRepoPath:  components.NewStringFlag(SubjectRepoPath, "Full path to some subject location, or a Package URL such as pkg:npm/lodash@4.17.21, which is resolved to the lead file of the package version.", func(f *components.StringFlag) { f.Mandatory = false }),
 	SubjectSha256:    components.NewStringFlag(SubjectSha256, "Subject checksum sha256.", func(f *components.StringFlag) { f.Mandatory = false }),
 	Key:              components.NewStringFlag(Key, "Path to a private key that will sign the DSSE. Supported keys: 'ecdsa','rsa' and 'ed25519'.", func(f *components.StringFlag) { f.Mandatory = false }),
 	KeyAlias:         components.NewStringFlag(KeyAlias, "Key alias", func(f *components.StringFlag) { f.Mandatory = false }),
@@ -88,15 +125,50 @@ var flagsMap = map[string]components.Flag{
 	ProviderId:                components.NewStringFlag(ProviderId, "Provider ID for the evidence.", func(f *components.StringFlag) { f.Mandatory = false }),
 	PublicKeys:                components.NewStringFlag(PublicKeys, "Array of paths to public keys for signatures verification with \";\" separator. Supported keys: 'ecdsa','rsa' and 'ed25519'.", func(f *components.StringFlag) { f.Mandatory = false }),
 	SigstoreBundle:            components.NewStringFlag(SigstoreBundle, "Path to a Sigstore bundle file with a pre-signed DSSE envelope. Incompatible with --"+Key+", --"+KeyAlias+", --"+Predicate+", --"+PredicateType+" and --"+SubjectSha256+".", func(f *components.StringFlag) { f.Mandatory = false }),
-	AttachLocal:               components.NewStringFlag(AttachLocal, "Path to a local file to attach to created evidence. Incompatible with --"+AttachArtifactoryPath+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	AttachLocal:               components.NewStringFlag(AttachLocal, "Paths to local files or directories to attach to created evidence, with \";\" separator. Directories are attached according to --"+AttachDirectoryMode+".", func(f *components.StringFlag) { f.Mandatory = false }),
 	AttachArtifactoryTempPath: components.NewStringFlag(AttachArtifactoryTempPath, "Temporary Artifactory upload path for --"+AttachLocal+" in format <repo/path[/name]>. Use trailing slash for directory targets. Can also be set via env var EVIDENCE_ATTACHMENT_ARTIFACTORY_TEMP_PATH or config key attachment.artifactoryTempPath. Once provided, the value is persisted for subsequent runs.", func(f *components.StringFlag) { f.Mandatory = false }),
-	AttachArtifactoryPath:     components.NewStringFlag(AttachArtifactoryPath, "Existing Artifactory file path to attach in format <repo/path>.", func(f *components.StringFlag) { f.Mandatory = false }),
+	AttachArtifactoryPath:     components.NewStringFlag(AttachArtifactoryPath, "Existing Artifactory file paths to attach in format <repo/path>, with \";\" separator.", func(f *components.StringFlag) { f.Mandatory = false }),
+	AttachDirectoryMode:       components.NewStringFlag(AttachDirectoryMode, "How a directory given in --"+AttachLocal+" is attached: 'archive' uploads it as a single zip archive, 'expand' uploads every file as its own attachment under --"+AttachArtifactoryTempPath+". The default value is 'archive'", func(f *components.StringFlag) { f.Mandatory = false }),
 	UseArtifactoryKeys:        components.NewBoolFlag(UseArtifactoryKeys, "Use Artifactory keys for verification. When enabled, the verify command retrieves keys from Artifactory.", components.WithBoolDefaultValueFalse()),
 	ArtifactsLimit:            components.NewStringFlag(ArtifactsLimit, "The number of artifacts in a release bundle to be included in the evidences file. The default value is 1000 artifacts", func(f *components.StringFlag) { f.Mandatory = false }),
-	Integration:               components.NewStringFlag(Integration, "Specify an integration to automatically generate the Predicate. Supported: 'sonar'. When using 'sonar', the 'SONAR_TOKEN' or 'SONARQUBE_TOKEN' environment variable must be set.", func(f *components.StringFlag) { f.Mandatory = false }),
+	Integration:               components.NewStringFlag(Integration, "Specify an integration to automatically generate the Predicate. Supported: 'sonar', 'slsa-provenance', 'junit', 'sarif', 'vuln-scan', 'ci-context', 'jira'. When using 'sonar', the 'SONAR_TOKEN' or 'SONARQUBE_TOKEN' environment variable must be set. 'slsa-provenance' generates SLSA v1 provenance from the published build-info and requires --build-name and --build-number. 'junit' aggregates the JUnit/xUnit XML reports matching --junit-reports into an in-toto test-result predicate. 'sarif' summarises the SARIF file given in --sarif-file by tool, rule and level. 'vuln-scan' normalises the Trivy or Grype JSON report given in --scan-report into an in-toto vulns predicate. 'ci-context' records the CI run (platform, run ID and URL, actor, trigger event, ref, commit and runner) from the environment of GitHub Actions, GitLab CI, Jenkins, Azure Pipelines or Bitbucket Pipelines. 'jira' links the commits since the previous build to the Jira tickets their messages reference.", func(f *components.StringFlag) { f.Mandatory = false }),
 	UploadPublicKey:           components.NewBoolFlag(UploadPublicKey, "Upload the generated public key to JFrog platform trusted keys. Requires server connection.", components.WithBoolDefaultValueTrue()),
 	KeyFilePath:               components.NewStringFlag(KeyFilePath, "Directory path for key files. Creates the directory if it doesn't exist. Defaults to current directory.", func(f *components.StringFlag) { f.Mandatory = false }),
 	KeyFileName:               components.NewStringFlag(KeyFileName, "Base name for key files (without extension). Private key will be saved as <name>.key and public key as <name>.pub. Defaults to 'evidence'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	Keyless:                   components.NewBoolFlag(Keyless, "Sign the evidence keylessly using Sigstore: an ephemeral key is certified by Fulcio for the OIDC identity and the signature is logged in Rekor. Incompatible with --"+Key+", --"+KeyAlias+" and --"+SigstoreBundle+".", components.WithBoolDefaultValueFalse()),
+	IdentityToken:             components.NewStringFlag(IdentityToken, "OIDC identity token used with --"+Keyless+". If not provided, the token is requested from the GitHub Actions OIDC endpoint.", func(f *components.StringFlag) { f.Mandatory = false }),
+	FulcioUrl:                 components.NewStringFlag(FulcioUrl, "Fulcio URL used with --"+Keyless+". Can also be set via env var EVIDENCE_SIGSTORE_FULCIO_URL or config key sigstore.fulcioUrl. Defaults to https://fulcio.sigstore.dev.", func(f *components.StringFlag) { f.Mandatory = false }),
+	Policy:                    components.NewStringFlag(Policy, "Path to a verification policy file (YAML) declaring required predicate types per subject type, trusted keys, key aliases or Sigstore identities per predicate type, minimum counts, maximum evidence age and mandatory attachments. When provided, the overall verification status is derived from the policy.", func(f *components.StringFlag) { f.Mandatory = false }),
+	Assertions:                components.NewStringFlag(Assertions, "Path to a YAML file mapping predicate types to lists of CEL expressions, for example 'predicate.gates.exists(g, g.status == \"OK\")'. Expressions are evaluated against the decoded in-toto statement and may use the statement, predicate, predicateType and subject variables. A failed assertion fails the verification.", func(f *components.StringFlag) { f.Mandatory = false }),
+	TsaUrl:                    components.NewStringFlag(TsaUrl, "RFC 3161 timestamp authority URL. When set, a timestamp token over each signature is requested and stored next to the signature in the envelope. Can also be set via env var EVIDENCE_TSA_URL or config key tsa.url.", func(f *components.StringFlag) { f.Mandatory = false }),
+	TsaCertChain:              components.NewStringFlag(TsaCertChain, "Path to a PEM file with the trusted timestamp authority certificate chain (root and intermediate certificates). Timestamped signatures are validated against it and signing keys are checked at the timestamped time. Can also be set via env var EVIDENCE_TSA_CERT_CHAIN or config key tsa.certChain.", func(f *components.StringFlag) { f.Mandatory = false }),
+	ProvenanceTarget:          components.NewStringFlag(ProvenanceTarget, "Subject of the provenance generated by --"+Integration+" slsa-provenance: 'build' (default) attaches it to the build-info, 'artifacts' attaches it to every artifact deployed by the build.", func(f *components.StringFlag) { f.Mandatory = false }),
+	JUnitReports:              components.NewStringFlag(JUnitReports, "File pattern of the JUnit/xUnit XML reports used by --"+Integration+" junit, for example 'build/test-results/**/*.xml'. '**' matches any number of directories.", func(f *components.StringFlag) { f.Mandatory = false }),
+	JUnitAttachReports:        components.NewBoolFlag(JUnitAttachReports, "Attach the raw reports matched by --"+JUnitReports+" to the evidence as a zip archive. Uploaded through --"+AttachArtifactoryTempPath+" like --"+AttachLocal+".", components.WithBoolDefaultValueFalse()),
+	SarifFile:                 components.NewStringFlag(SarifFile, "Path to the SARIF file summarised by --"+Integration+" sarif, as produced by Semgrep, CodeQL, gosec or any other static-analysis tool.", func(f *components.StringFlag) { f.Mandatory = false }),
+	SarifThresholds:           components.NewStringFlag(SarifThresholds, "Maximum number of results allowed per SARIF level, recorded in the predicate, for example 'error=0,warning=10'. The predicate result is FAILED when a threshold is exceeded.", func(f *components.StringFlag) { f.Mandatory = false }),
+	SarifAttach:               components.NewBoolFlag(SarifAttach, "Attach the original --"+SarifFile+" to the evidence. Uploaded through --"+AttachArtifactoryTempPath+" like --"+AttachLocal+".", components.WithBoolDefaultValueFalse()),
+	ScanReport:                components.NewStringFlag(ScanReport, "Path to the Trivy or Grype JSON report used by --"+Integration+" vuln-scan. The format is detected automatically.", func(f *components.StringFlag) { f.Mandatory = false }),
+	JiraUrl:                   components.NewStringFlag(JiraUrl, "Base URL of the Jira-compatible tracker queried by --"+Integration+" jira. Defaults to the JIRA_URL environment variable. Authenticate with JIRA_TOKEN, and JIRA_USER for Jira Cloud API tokens.", func(f *components.StringFlag) { f.Mandatory = false }),
+	JiraTicketPattern:         components.NewStringFlag(JiraTicketPattern, "Regular expression matching ticket keys in commit messages for --"+Integration+" jira. When it has a capture group, the first group is the key. Defaults to Jira issue keys such as PROJ-123.", func(f *components.StringFlag) { f.Mandatory = false }),
+	JiraApproverField:         components.NewStringFlag(JiraApproverField, "Jira issue field holding the ticket approver for --"+Integration+" jira, for example 'customfield_10050'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	SbomAsAttachment:          components.NewBoolFlag(SbomAsAttachment, "Store the SBOM given with --"+PredicateType+" auto as an attachment and record a digest reference to it as the predicate, instead of inlining it in the DSSE payload. Uploaded through --"+AttachArtifactoryTempPath+" like --"+AttachLocal+".", components.WithBoolDefaultValueFalse()),
+	GitRepoPath:               components.NewStringFlag(GitRepoPath, "Path to the local git clone read by --"+TypeFlag+" git-committer. If not provided, the clone containing the current directory is used.", func(f *components.StringFlag) { f.Mandatory = false }),
+	GitFromRef:                components.NewStringFlag(GitFromRef, "Git ref the commit range read by --"+TypeFlag+" git-committer starts after. If not provided, the commits since the previous build's VCS revision are read.", func(f *components.StringFlag) { f.Mandatory = false }),
+	GitToRef:                  components.NewStringFlag(GitToRef, "Git ref the commit range read by --"+TypeFlag+" git-committer ends at. Requires --"+GitFromRef+". Default: HEAD.", func(f *components.StringFlag) { f.Mandatory = false }),
+	FourEyesApprovals:         components.NewStringFlag(FourEyesApprovals, "Four-eyes policy for --"+TypeFlag+" gh-commiter or gl-committer: every commit must come from a pull request approved by at least this many reviewers other than its author. Violating commits are listed in the predicate and markdown, and the command fails after the evidence is created.", func(f *components.StringFlag) { f.Mandatory = false }),
+	FourEyesApprovers:         components.NewStringFlag(FourEyesApprovers, "Comma separated list of reviewer logins whose approvals count toward --"+FourEyesApprovals+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	ValidFor:                  components.NewStringFlag(ValidFor, "Validity period of the evidence, relative to its creation time, for example '90d', '2w' or '36h'. The resulting expiry is recorded in the statement as expiresAt. Incompatible with --"+ExpiresAt+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	ExpiresAt:                 components.NewStringFlag(ExpiresAt, "Time after which the evidence is no longer valid, as an RFC 3339 time or a YYYY-MM-DD date (midnight UTC). Recorded in the statement as expiresAt. Incompatible with --"+ValidFor+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	AsOf:                      components.NewStringFlag(AsOf, "Evaluate evidence expiry and policy age rules at this point in time instead of now, as an RFC 3339 time or a YYYY-MM-DD date. Useful for audits.", func(f *components.StringFlag) { f.Mandatory = false }),
+	Revocations:               components.NewStringFlag(Revocations, "Path to a YAML file listing revoked signing keys by fingerprint or key ID, with an optional revokedAfter time and a reason. Evidence signed by a revoked key after its revocation time fails verification. Can also be set via env var EVIDENCE_VERIFY_REVOCATIONS or config key verify.revocations.", func(f *components.StringFlag) { f.Mandatory = false }),
+	PredicateSchema:           components.NewStringFlag(PredicateSchema, "Path to a JSON Schema file the predicate is validated against before it is signed; violations are reported by JSON pointer. Without it, the schema registered for the predicate type under predicateSchemas in evidence.yml is used, if any.", func(f *components.StringFlag) { f.Mandatory = false }),
+	Deep:                      components.NewBoolFlag(Deep, "Download the subject and every attachment and compare the sha256 computed from their content, instead of trusting the checksums reported by Artifactory. Files are streamed, so large subjects are not held in memory.", components.WithBoolDefaultValueFalse()),
+	ValidateSchemas:           components.NewBoolFlag(ValidateSchemas, "Re-validate each evidence predicate against the JSON Schema registered for its predicate type under predicateSchemas in evidence.yml. A predicate that does not match fails the verification.", components.WithBoolDefaultValueFalse()),
+	DownloadAttachments:       components.NewStringFlag(DownloadAttachments, "Local directory to download the attachments of every evidence found into. Each attachment is checked against its sha256, and an index.json mapping each evidence to its files is written.", func(f *components.StringFlag) { f.Mandatory = false }),
+	DownloadEnvelopes:         components.NewBoolFlag(DownloadEnvelopes, "With --"+DownloadAttachments+", also download the DSSE envelope of every evidence.", components.WithBoolDefaultValueFalse()),
+	DownloadThreads:           components.NewStringFlag(DownloadThreads, "Number of parallel downloads for --"+DownloadAttachments+". The default value is 3", func(f *components.StringFlag) { f.Mandatory = false }),
+	RekorUrl:                  components.NewStringFlag(RekorUrl, "Rekor URL used with --"+Keyless+". Can also be set via env var EVIDENCE_SIGSTORE_REKOR_URL or config key sigstore.rekorUrl. Defaults to https://rekor.sigstore.dev.", func(f *components.StringFlag) { f.Mandatory = false }),
 }
 
 var commandFlags = map[string][]string{
@@ -119,6 +191,8 @@ var commandFlags = map[string][]string{
 		Predicate,
 		PredicateType,
 		Markdown,
+		MarkdownTemplate,
+		PredicateSchema,
 		SubjectRepoPath,
 		SubjectSha256,
 		Key,
@@ -129,6 +203,30 @@ var commandFlags = map[string][]string{
 		AttachLocal,
 		AttachArtifactoryTempPath,
 		AttachArtifactoryPath,
+		AttachDirectoryMode,
+		Keyless,
+		IdentityToken,
+		FulcioUrl,
+		RekorUrl,
+		TsaUrl,
+		ValidFor,
+		ExpiresAt,
+		ProvenanceTarget,
+		JUnitReports,
+		JUnitAttachReports,
+		SarifFile,
+		SarifThresholds,
+		SarifAttach,
+		ScanReport,
+		SbomAsAttachment,
+		GitRepoPath,
+		GitFromRef,
+		GitToRef,
+		FourEyesApprovals,
+		FourEyesApprovers,
+		JiraUrl,
+		JiraTicketPattern,
+		JiraApproverField,
 	},
 	VerifyEvidence: {
 		Url,
@@ -147,6 +245,13 @@ var commandFlags = map[string][]string{
 		PackageVersion,
 		PackageRepoName,
 		UseArtifactoryKeys,
+		Policy,
+		Assertions,
+		TsaCertChain,
+		AsOf,
+		Revocations,
+		ValidateSchemas,
+		Deep,
 	},
 	GetEvidence: {
 		Url,
@@ -161,6 +266,9 @@ var commandFlags = map[string][]string{
 		SubjectRepoPath,
 		IncludePredicate,
 		ArtifactsLimit,
+		DownloadAttachments,
+		DownloadEnvelopes,
+		DownloadThreads,
 	},
 	GenerateKeyPair: {
 		Url,
@@ -7,7 +7,6 @@ import (
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/interface"
 	utils2 "github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/utils"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/create"
-	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
@@ -47,6 +46,7 @@ func (ebc *evidenceGitHubCommand) CreateEvidence(ctx *components.Context, server
 		ebc.ctx.GetStringFlagValue(flags.AttachLocal),
 		ebc.ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath),
 		ebc.ctx.GetStringFlagValue(flags.AttachArtifactoryPath),
+		utils2.CreateEvidenceOptions(ebc.ctx)...,
 	)
 	return ebc.execute(createCmd)
 }
@@ -58,8 +58,8 @@ func (ebc *evidenceGitHubCommand) VerifyEvidence(_ *components.Context, _ *confi
 
 func (ebc *evidenceGitHubCommand) validateEvidenceGithubContext(ctx *components.Context) error {
 	// buildName is not validated since it is required for the evd context
-	if utils.IsSonarIntegration(ctx.GetStringFlagValue(flags.Integration)) {
-		return errorutils.CheckErrorf("--%s %s is not supported for GitHub evidence.", flags.Integration, utils.SonarIntegration)
+	if integration := ctx.GetStringFlagValue(flags.Integration); integration != "" {
+		return errorutils.CheckErrorf("--%s %s is not supported for GitHub evidence.", flags.Integration, integration)
 	}
 	if ebc.ctx.GetStringFlagValue(flags.SigstoreBundle) != "" {
 		return errorutils.CheckErrorf("--%s is not supported for GitHub evidence.", flags.SigstoreBundle)
@@ -44,6 +44,7 @@ func (epc *evidencePackageCommand) CreateEvidence(ctx *components.Context, serve
 		epc.ctx.GetStringFlagValue(flags.AttachLocal),
 		epc.ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath),
 		epc.ctx.GetStringFlagValue(flags.AttachArtifactoryPath),
+		utils.CreateEvidenceOptions(epc.ctx)...,
 	)
 	return epc.execute(createCmd)
 }
@@ -66,6 +67,7 @@ func (epc *evidencePackageCommand) VerifyEvidence(ctx *components.Context, serve
 		epc.ctx.GetStringFlagValue(flags.PackageRepoName),
 		epc.ctx.GetStringsArrFlagValue(flags.PublicKeys),
 		epc.ctx.GetBoolFlagValue(flags.UseArtifactoryKeys),
+		utils.VerifyEvidenceOptions(epc.ctx)...,
 	)
 	return epc.execute(verifyCmd)
 }
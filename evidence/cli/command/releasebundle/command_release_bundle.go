@@ -46,6 +46,7 @@ func (erc *evidenceReleaseBundleCommand) CreateEvidence(ctx *components.Context,
 		erc.ctx.GetStringFlagValue(flags.AttachLocal),
 		erc.ctx.GetStringFlagValue(flags.AttachArtifactoryTempPath),
 		erc.ctx.GetStringFlagValue(flags.AttachArtifactoryPath),
+		utils2.CreateEvidenceOptions(erc.ctx)...,
 	)
 	return erc.execute(createCmd)
 }
@@ -65,6 +66,7 @@ func (erc *evidenceReleaseBundleCommand) GetEvidence(ctx *components.Context, se
 		erc.ctx.GetStringFlagValue(flags.Output),
 		erc.ctx.GetStringFlagValue(flags.ArtifactsLimit),
 		erc.ctx.GetBoolFlagValue(flags.IncludePredicate),
+		utils2.GetEvidenceOptions(erc.ctx)...,
 	)
 	return erc.execute(getCmd)
 }
@@ -83,6 +85,7 @@ func (erc *evidenceReleaseBundleCommand) VerifyEvidence(ctx *components.Context,
 		erc.ctx.GetStringFlagValue(flags.ReleaseBundleVersion),
 		erc.ctx.GetStringsArrFlagValue(flags.PublicKeys),
 		erc.ctx.GetBoolFlagValue(flags.UseArtifactoryKeys),
+		utils2.VerifyEvidenceOptions(erc.ctx)...,
 	)
 	return erc.execute(verifyCmd)
 }
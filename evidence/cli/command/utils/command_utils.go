@@ -1,9 +1,15 @@
 package utils
 
 import (
+	"strconv"
+
 	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/create"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/foureyes"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/get"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
@@ -28,3 +34,93 @@ func AssertValueProvided(c *components.Context, fieldName string) error {
 	}
 	return nil
 }
+
+// CreateEvidenceOptions maps the optional create-evidence flags shared by all subject types to create options.
+func CreateEvidenceOptions(c *components.Context) []create.EvidenceOption {
+	var opts []create.EvidenceOption
+	if c.GetBoolFlagValue(flags.Keyless) {
+		opts = append(opts, create.WithKeyless(
+			c.GetStringFlagValue(flags.IdentityToken),
+			c.GetStringFlagValue(flags.FulcioUrl),
+			c.GetStringFlagValue(flags.RekorUrl),
+		))
+	}
+	if tsaURL := c.GetStringFlagValue(flags.TsaUrl); tsaURL != "" {
+		opts = append(opts, create.WithTimestampAuthority(tsaURL))
+	}
+	validFor, expiresAt := c.GetStringFlagValue(flags.ValidFor), c.GetStringFlagValue(flags.ExpiresAt)
+	if validFor != "" || expiresAt != "" {
+		opts = append(opts, create.WithValidity(validFor, expiresAt))
+	}
+	if c.GetStringFlagValue(flags.Integration) != "" {
+		opts = append(opts, create.WithIntegrationFlags(c))
+	}
+	if c.GetBoolFlagValue(flags.SbomAsAttachment) {
+		opts = append(opts, create.WithSbomAsAttachment())
+	}
+	gitRepoPath, gitFromRef, gitToRef := c.GetStringFlagValue(flags.GitRepoPath), c.GetStringFlagValue(flags.GitFromRef), c.GetStringFlagValue(flags.GitToRef)
+	if gitRepoPath != "" || gitFromRef != "" || gitToRef != "" {
+		opts = append(opts, create.WithGitRange(gitRepoPath, gitFromRef, gitToRef))
+	}
+	// --four-eyes-approvals is validated with the other create flags.
+	if approvals, err := strconv.Atoi(c.GetStringFlagValue(flags.FourEyesApprovals)); err == nil {
+		opts = append(opts, create.WithFourEyesPolicy(foureyes.Policy{
+			RequiredApprovals: approvals,
+			Approvers:         foureyes.ParseApprovers(c.GetStringFlagValue(flags.FourEyesApprovers)),
+		}))
+	}
+	if directoryMode := c.GetStringFlagValue(flags.AttachDirectoryMode); directoryMode != "" {
+		opts = append(opts, create.WithAttachDirectoryMode(directoryMode))
+	}
+	if predicateSchema := c.GetStringFlagValue(flags.PredicateSchema); predicateSchema != "" {
+		opts = append(opts, create.WithPredicateSchema(predicateSchema))
+	}
+	if markdownTemplate := c.GetStringFlagValue(flags.MarkdownTemplate); markdownTemplate != "" {
+		opts = append(opts, create.WithMarkdownTemplate(markdownTemplate))
+	}
+	if provenanceTarget := c.GetStringFlagValue(flags.ProvenanceTarget); provenanceTarget != "" {
+		opts = append(opts, create.WithProvenanceTarget(provenanceTarget))
+	}
+	return opts
+}
+
+// VerifyEvidenceOptions maps the optional verify-evidence flags shared by all subject types to verify options.
+func VerifyEvidenceOptions(c *components.Context) []verify.VerifyOption {
+	var opts []verify.VerifyOption
+	if policyPath := c.GetStringFlagValue(flags.Policy); policyPath != "" {
+		opts = append(opts, verify.WithPolicy(policyPath))
+	}
+	if assertionsPath := c.GetStringFlagValue(flags.Assertions); assertionsPath != "" {
+		opts = append(opts, verify.WithAssertions(assertionsPath))
+	}
+	if certChainPath := c.GetStringFlagValue(flags.TsaCertChain); certChainPath != "" {
+		opts = append(opts, verify.WithTimestampCertChain(certChainPath))
+	}
+	if asOf := c.GetStringFlagValue(flags.AsOf); asOf != "" {
+		opts = append(opts, verify.WithAsOf(asOf))
+	}
+	if revocationsPath := c.GetStringFlagValue(flags.Revocations); revocationsPath != "" {
+		opts = append(opts, verify.WithRevocations(revocationsPath))
+	}
+	if c.GetBoolFlagValue(flags.ValidateSchemas) {
+		opts = append(opts, verify.WithSchemaValidation())
+	}
+	if c.GetBoolFlagValue(flags.Deep) {
+		opts = append(opts, verify.WithDeepVerification())
+	}
+	return opts
+}
+
+// GetEvidenceOptions maps the optional get flags to get options.
+func GetEvidenceOptions(c *components.Context) []get.GetOption {
+	var opts []get.GetOption
+	if downloadDir := c.GetStringFlagValue(flags.DownloadAttachments); downloadDir != "" {
+		// --download-threads is validated with the other get flags.
+		threads, err := strconv.Atoi(c.GetStringFlagValue(flags.DownloadThreads))
+		if err != nil {
+			threads = get.DefaultDownloadThreads
+		}
+		opts = append(opts, get.WithAttachmentDownload(downloadDir, c.GetBoolFlagValue(flags.DownloadEnvelopes), threads))
+	}
+	return opts
+}
@@ -13,10 +13,26 @@ When to use:
 - Attach signed provenance or scan results to an artifact, build, package, application or release bundle.
 - Re-upload a pre-signed Sigstore bundle via --sigstore-bundle.
 - Generate a predicate automatically from a SonarQube scan via --integration sonar.
+- Generate SLSA v1 provenance (builder, build type, VCS and parameters, resolved dependencies, run metadata) from a published build-info via --integration slsa-provenance.
+- Record test results (per suite passed/failed/skipped counts and failing test names) from JUnit/xUnit XML reports via --integration junit.
+- Summarise the results of any SARIF-producing scanner (Semgrep, CodeQL, gosec) by tool, rule and level via --integration sarif.
+- Record Trivy or Grype vulnerability scan results as an in-toto vulns predicate via --integration vuln-scan, including on docker:// image subjects.
+- Record where and how a build was produced (CI platform, run ID and URL, actor, trigger event, ref, commit, runner) via --integration ci-context, detected from GitHub Actions, GitLab CI, Jenkins, Azure Pipelines or Bitbucket Pipelines environment variables.
+- Trace every commit since the previous build to a ticket via --integration jira: ticket keys matched in commit messages by --jira-ticket-pattern are looked up in a Jira-compatible tracker (--jira-url or JIRA_URL, JIRA_TOKEN and optionally JIRA_USER) for their status, type and approver (--jira-approver-field). Commits without a ticket and unknown tickets are flagged.
+- Ingest a CycloneDX or SPDX JSON SBOM with --predicate-type auto: the format and version are detected, the document is validated and a markdown summary (components, licenses, top-level dependencies) is generated.
+- Record the committers and merge request approvers of a build's git range from GitLab CI via --type gl-committer (GitHub Actions: --type gh-commiter); needs JF_GIT_TOKEN.
+- Enforce a four-eyes policy on gh-commiter/gl-committer evidence via --four-eyes-approvals N (optionally --four-eyes-approvers alice,bob): commits not approved by N reviewers other than their author are marked in the predicate and listed in the markdown, and the command fails once the evidence is uploaded. At verify time, a fourEyes rule in the --policy file re-evaluates the recorded reviews.
+- Record the commits of a build's git range, with their GPG/SSH signature status and key, from a local clone on any CI (Jenkins, on-prem) via --type git-committer; --git-from-ref/--git-to-ref select the range, otherwise the commits since the previous build's revision are read.
+- Render the evidence markdown from a Go text/template via --markdown-template summary.md.tmpl, against the predicate JSON (.Predicate) and the subject metadata (.Subject.Path, .Subject.Sha256, .PredicateType, .Stage, .CreatedBy, .CreatedAt); helpers include get, default, join, cell, shortSha, date, toJson and toPrettyJson. SonarQube, committer (gh-commiter, gl-committer, git-committer) and SLSA provenance evidence get a built-in summary when no markdown is given.
+- Reject malformed predicates before signing with --predicate-schema scan.schema.json, or register a JSON Schema per predicate type under predicateSchemas in evidence.yml. Violations are reported with the JSON pointer of each offending field.
+- Sign without managing keys via --keyless (Sigstore Fulcio certificate + Rekor transparency log).
+- Record a validity period for time-bound evidence (pen-test reports, compliance scans) via --valid-for 90d or --expires-at 2026-01-31; verify fails once the evidence has expired.
+- Prove when the evidence was signed via --tsa-url: an RFC 3161 timestamp token over each signature is stored next to it in the envelope, so verification keeps working after the signing key expires.
 
 Prerequisites:
 - A configured JFrog Platform server (jf c add or jf login). Basic auth is rejected; use --access-token or a configured server-id.
-- A private signing key supplied via --key (path or PEM body) or the JFROG_CLI_SIGNING_KEY env variable. Supported: ecdsa, rsa, ed25519.
+- A private signing key supplied via --key (path or PEM body) or the JFROG_CLI_SIGNING_KEY env variable. Supported: ecdsa, rsa, ed25519. Not needed with --keyless.
+- For --keyless: an OIDC token via --identity-token, or a GitHub Actions job with 'id-token: write' permission. Fulcio/Rekor default to the public-good instance; override with --fulcio-url/--rekor-url, EVIDENCE_SIGSTORE_FULCIO_URL/EVIDENCE_SIGSTORE_REKOR_URL or sigstore.fulcioUrl/sigstore.rekorUrl in evidence.yml.
 - Exactly one subject: --subject-repo-path, --build-name/--build-number (or JFROG_CLI_BUILD_NAME/_NUMBER), --package-name/--package-version/--package-repo-name, --release-bundle/--release-bundle-version, or --application-key/--application-version.
 - For attachments: --attach-artifactory-temp-path (or EVIDENCE_ATTACHMENT_ARTIFACTORY_TEMP_PATH config) when using --attach-local.
 - For sonar integration: SONAR_TOKEN or SONARQUBE_TOKEN env var plus a report-task.txt from a completed scan.
@@ -28,13 +44,42 @@ Common patterns:
   $ jf evd create --release-bundle my-rb --release-bundle-version 1.0.0 --predicate ./attest.json --predicate-type https://example.com/attest/v1
   $ jf evd create --subject-repo-path generic-local/app.tgz --sigstore-bundle ./app.sigstore.json
   $ jf evd create --build-name my-build --build-number 42 --integration sonar
+  $ jf evd create --build-name my-build --build-number 42 --integration slsa-provenance --key ./evidence.key
+  $ jf evd create --build-name my-build --build-number 42 --integration slsa-provenance --provenance-target artifacts --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --integration junit --junit-reports 'build/test-results/**/*.xml' --junit-attach-reports --attach-artifactory-temp-path generic-local/evidence-attachments/ --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --integration sarif --sarif-file results.sarif --sarif-thresholds error=0,warning=10 --key ./evidence.key
+  $ jf evd create --subject-repo-path docker://myrepo.jfrog.io/docker-local/app:1.0 --integration vuln-scan --scan-report trivy.json --key ./evidence.key
+  $ jf evd create --subject-repo-path 'pkg:maven/org.acme/app@1.2.0?repository_url=https://acme.jfrog.io/artifactory/api/maven/maven-local' --predicate ./scan.json --predicate-type https://example.com/scan/v1 --key ./evidence.key
+  $ jf evd create --build-name my-build --build-number 42 --integration ci-context --key ./evidence.key
+  $ jf evd create --build-name my-build --build-number 42 --integration jira --jira-url https://acme.atlassian.net --jira-approver-field customfield_10050 --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./bom.cdx.json --predicate-type auto --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./scan.json --predicate-type https://example.com/scan/v1 --markdown-template ./scan.md.tmpl --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./scan.json --predicate-type https://example.com/scan/v1 --predicate-schema ./scan.schema.json --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./bom.spdx.json --predicate-type auto --sbom-as-attachment --attach-artifactory-temp-path generic-local/evidence-attachments/ --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./scan.json --predicate-type https://example.com/scan/v1 --attach-local './report.html;./test-results' --attach-directory-mode expand --attach-artifactory-temp-path generic-local/evidence-attachments/run-42/ --key ./evidence.key
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./provenance.json --predicate-type https://slsa.dev/provenance/v1 --keyless
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./provenance.json --predicate-type https://slsa.dev/provenance/v1 --key ./evidence.key --tsa-url https://freetsa.org/tsr
+  $ jf evd create --subject-repo-path generic-local/app.tgz --predicate ./pentest.json --predicate-type https://example.com/pentest/v1 --key ./evidence.key --valid-for 90d
 
 Gotchas:
 - --sigstore-bundle is mutually exclusive with --key, --key-alias, --predicate, --predicate-type, --subject-sha256 and all --attach-* flags (values are extracted from the bundle).
 - Specifying multiple subjects in one invocation is an error, except the documented --type + --build-name (gh-committer) combination.
-- --attach-local uploads the file to --attach-artifactory-temp-path first; once set, the temp path is persisted in the evidence config for subsequent runs.
+- --attach-local uploads the files to --attach-artifactory-temp-path first; once set, the temp path is persisted in the evidence config for subsequent runs.
+- --attach-local and --attach-artifactory-path take several paths separated by ';' and can be combined. With more than one uploaded file, --attach-artifactory-temp-path must be a directory ending with '/'. If any upload fails, the files already uploaded are deleted again.
+- An --attach-local directory is uploaded as one zip archive by default; --attach-directory-mode expand uploads each file instead, keeping its path relative to the directory.
+- --keyless is mutually exclusive with --key, --key-alias and --sigstore-bundle; the uploaded evidence is a Sigstore bundle and is verified with the Sigstore trust root.
+- --tsa-url (or EVIDENCE_TSA_URL / tsa.url in evidence.yml) makes the TSA a hard dependency: creation fails if the timestamp cannot be obtained. With --keyless the timestamp is embedded in the Sigstore bundle instead.
+- --valid-for and --expires-at are mutually exclusive and cannot be used with --sigstore-bundle. The expiry is stored in the signed statement as expiresAt, next to createdAt; --expires-at dates without a time mean midnight UTC.
 - Evidence services reject basic authentication; only access tokens work.
 - When --integration sonar is used, --predicate and --predicate-type must be omitted; the predicate is generated from the SonarQube report.
+- --integration slsa-provenance works only with --build-name/--build-number and the build-info must already be published (jf rt bp). --provenance-target artifacts creates one evidence per artifact deployed by the build; the provenance is generated once and is identical for all of them.
+- Quote the --junit-reports pattern so the shell does not expand it; '**' matches any number of directories. The markdown summary is generated unless --markdown is given, and --junit-attach-reports uploads the raw reports as a zip archive.
+- --sarif-thresholds only records the allowed counts and the PASSED/FAILED result in the predicate; evidence is created either way, so enforce the result with a verify policy.
+- A docker:// or oci:// --subject-repo-path is resolved to the image manifest using --subject-sha256 or, with --integration vuln-scan, the image digest recorded in the report. Filesystem scans carry no digest, so use a repository path or pass --subject-sha256.
+- A pkg: Package URL --subject-repo-path is resolved to the lead file of the package version and needs no --subject-sha256. The repository_url qualifier selects the repository; without it every local repository of the package type is searched, and the command fails when more than one holds the version. Quote PURLs with qualifiers so the shell does not split them at '&'.
+- --predicate-type auto accepts CycloneDX 1.2-1.6 and SPDX 2.2/2.3 JSON only. With --sbom-as-attachment the predicate type is https://jfrog.com/evidence/sbom-reference/v1 and the predicate holds the SBOM predicate type, digest, size and summary; fetch the attachment to read the full document.
+- --markdown and --markdown-template are mutually exclusive. A template replaces any generated markdown; a template error fails the command. Use get and default for optional predicate fields, since missing map keys render as <no value>.
+- --predicate-schema wins over the predicateSchemas registered in evidence.yml and also applies to predicates generated by integrations. Relative schema paths in evidence.yml are resolved against the evidence.yml directory, and relative $ref inside a schema against the schema file.
 - Output formatting (--format json|table) only renders after a successful create call.
 
 Related: jf evd verify, jf evd get, jf evd gen-keys`
@@ -16,6 +16,8 @@ When to use:
 - List all evidence attached to a single artifact (--subject-repo-path).
 - Enumerate evidence across every build and artifact of a release bundle (--release-bundle / --release-bundle-version).
 - Pipe machine-readable output into downstream tooling via --format json or --format jsonl.
+- Hand auditors a single self-contained report with --format html.
+- Collect the evidence files for an audit or offline verification with --download-attachments ./evidence-files: every attachment (and with --download-envelopes every DSSE envelope) is downloaded, checked against its sha256 and listed in index.json.
 
 Prerequisites:
 - A configured JFrog Platform server (jf c add or jf login) with access-token auth; basic auth is rejected.
@@ -27,6 +29,8 @@ Common patterns:
   $ jf evd get --subject-repo-path generic-local/app.tgz --include-predicate --format json
   $ jf evd get --release-bundle my-rb --release-bundle-version 1.0.0 --format jsonl --output ./rb-evidence.jsonl
   $ jf evd get --release-bundle my-rb --release-bundle-version 1.0.0 --artifacts-limit 5000
+  $ jf evd get --subject-repo-path generic-local/app.tgz --include-predicate --format html --output ./evidence.html
+  $ jf evd get --release-bundle my-rb --release-bundle-version 1.0.0 --download-attachments ./evidence-files --download-envelopes --download-threads 8
 
 Gotchas:
 - --include-predicate is off by default; without it the predicate body is omitted from results.
@@ -34,6 +38,9 @@ Gotchas:
 - Only --subject-repo-path and --release-bundle subjects are accepted; passing --build-name, --package-name or --application-key returns "unsupported subject".
 - --output writes to a file; without it results go to stdout.
 - jsonl is only useful with --format; the default human format ignores --output formatting for streaming.
+- The html report shows predicates only with --include-predicate. Predicate markdown is not returned by get; use jf evd verify --format html to render it.
+- --download-attachments lays the files out as <dir>/<evidence repository path>/envelope.json and <dir>/<evidence repository path>/attachments/<name>; index.json maps each evidence to these paths. A checksum mismatch or a failed download fails the command, the file is removed and no index is written.
+- --download-threads (default 3) bounds the number of parallel downloads. --download-envelopes and --download-threads require --download-attachments.
 
 Related: jf evd create, jf evd verify`
 }
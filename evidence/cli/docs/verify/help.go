@@ -14,6 +14,13 @@ When to use:
 - Gate a release on signed provenance/SBOM/scan evidence being present and valid.
 - Re-verify evidence after a key rotation by re-running with the new --public-keys.
 - Validate evidence with trust roots managed in Artifactory via --use-artifactory-keys.
+- Enforce an organisational policy with --policy: required predicate types per subject type, trusted signers, minimum counts, maximum age and required attachments. Each rule is reported separately and the overall result is derived from the rules.
+- Check predicate content, not only signatures, with --assertions: a YAML file mapping predicate types to CEL expressions evaluated against the decoded in-toto statement (variables: statement, predicate, predicateType, subject).
+- Re-validate predicates against the JSON Schemas registered under predicateSchemas in evidence.yml with --validate-schemas; violations are reported per evidence.
+- Re-hash the subject and every attachment from their downloaded content with --deep, instead of trusting the sha256 Artifactory reports.
+- Fail on expired evidence: statements carrying an expiresAt (create --valid-for/--expires-at) are reported with an expiry status. Use --as-of to evaluate expiry and policy maxAge rules at a past point in time for audits.
+- Validate RFC 3161 signature timestamps with --tsa-cert-chain and check signing keys at the timestamped time instead of now.
+- Reject evidence signed by compromised or retired keys with a revocation file (--revocations).
 
 Prerequisites:
 - A configured JFrog Platform server (jf c add or jf login) using access-token auth.
@@ -24,9 +31,56 @@ Prerequisites:
 Common patterns:
   $ jf evd verify --subject-repo-path generic-local/app.tgz --public-keys ./evidence.pub
   $ jf evd verify --subject-repo-path generic-local/app.tgz --use-artifactory-keys --format json
+  $ jf evd verify --subject-repo-path pkg:npm/%40acme/web@2.4.1 --use-artifactory-keys
+  $ jf evd verify --subject-repo-path generic-local/app.tgz --use-artifactory-keys --format junit > evidence-verification.xml
+  $ jf evd verify --subject-repo-path generic-local/app.tgz --use-artifactory-keys --format html > evidence-verification.html
   $ jf evd verify --build-name my-build --build-number 42 --public-keys ./key1.pub;./key2.pub
   $ jf evd verify --release-bundle my-rb --release-bundle-version 1.0.0 --public-keys ./evidence.pub
   $ jf evd verify --package-name my-npm-pkg --package-version 1.2.3 --package-repo-name npm-local --use-artifactory-keys
+  $ jf evd verify --build-name my-build --build-number 42 --use-artifactory-keys --policy ./evidence-policy.yml
+  $ jf evd verify --subject-repo-path generic-local/app.tgz --use-artifactory-keys --assertions ./assertions.yml
+  $ jf evd verify --subject-repo-path generic-local/app.tgz --use-artifactory-keys --validate-schemas
+  $ jf evd verify --subject-repo-path generic-local/app.tgz --use-artifactory-keys --deep --format json
+  $ jf evd verify --subject-repo-path generic-local/app.tgz --public-keys ./signer.crt --tsa-cert-chain ./tsa-chain.pem
+  $ jf evd verify --build-name my-build --build-number 42 --use-artifactory-keys --policy ./evidence-policy.yml --as-of 2025-06-30
+  $ jf evd verify --subject-repo-path generic-local/app.tgz --use-artifactory-keys --revocations ./revocations.yml
+
+Policy file example:
+  subjects:
+    build:
+      requiredPredicateTypes: [https://slsa.dev/provenance/v1]
+  predicates:
+    https://slsa.dev/provenance/v1:
+      keyAliases: [ci-signer]
+      trustedKeys: ["SHA256:<base64 key fingerprint>"]
+      sigstoreIdentities:
+        - issuer: https://token.actions.githubusercontent.com
+          subjectRegex: https://github.com/my-org/.*
+      minCount: 1
+      maxAge: 30d
+      requireAttachments: false
+    https://jfrog.com/evidence/gh-commiter/v1:
+      fourEyes:
+        requiredApprovals: 2
+        approvers: [alice, bob]
+  failOnInvalidEvidence: true
+
+Assertions file example:
+  https://jfrog.com/evidence/sonar/v1:
+    - predicate.gates.exists(g, g.status == "OK")
+
+Revocations file example:
+  revocations:
+    - fingerprint: "SHA256:<base64 key fingerprint>"
+      revokedAfter: 2025-03-01T00:00:00Z
+      reason: key leaked in CI logs
+    - keyId: 4f1c2a9b
+      reason: signer retired
+
+evidence.yml predicate schemas example:
+  predicateSchemas:
+    - predicateType: https://example.com/scan/v1
+      path: schemas/scan.schema.json
 
 Gotchas:
 - JFROG_CLI_SIGNING_KEY is appended to whatever is passed via --public-keys; ensure the env var is unset if you only want explicit keys.
@@ -35,6 +89,14 @@ Gotchas:
 - Failures from the verifier are wrapped as "evidence verification failed: ..."; check the wrapped cause for the specific signature, key or attachment mismatch.
 - --use-artifactory-keys still requires platform credentials with read access to the trusted-keys store.
 - Attachments referenced by evidence are also verified; mismatched or missing attachment files cause the whole verify to fail.
+- With --policy, evidence that fails verification still fails the run, as without a policy, even when no rule covers its predicate type; set failOnInvalidEvidence: false to only fail on the policy rules. Evidence not signed by a trusted signer does not count towards the policy.
+- --public-keys also accepts PEM X.509 certificates; their validity period is checked at the signing time, which is the verified timestamp when --tsa-cert-chain (or EVIDENCE_TSA_CERT_CHAIN / tsa.certChain) is set and the current time otherwise. Timestamps are not checked without a trusted chain.
+- Revocation entries match a key by fingerprint (SHA256:...) or key ID. A signature made after revokedAfter fails; without revokedAfter the key is always rejected. The signing time is the verified RFC 3161 timestamp when available and the evidence upload time otherwise, so timestamp evidence to keep it valid after a key is revoked.
+- --as-of only changes the time expiry and policy age rules are evaluated at; signatures, keys and attachments are verified as they are now.
+- A failed or erroring assertion always fails the verification, even when the signature is valid. Accessing a missing field is an evaluation error; guard optional fields with has(predicate.field).
+- --validate-schemas fails when evidence.yml registers no predicateSchemas. Evidence whose predicate type has no registered schema is not checked.
+- A pkg: Package URL --subject-repo-path is resolved to the lead file of the package version. When several local repositories hold the version, add a repository_url qualifier to select one.
+- --deep downloads the subject and all attachments, so it costs as much as fetching them. Files are streamed through the hash, not held in memory. The report keeps the reported digest (sha256 / actualSha256) next to the computed one (computedSha256).
 
 Related: jf evd create, jf evd get, jf evd gen-keys`
 }
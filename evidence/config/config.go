@@ -22,6 +22,11 @@ const (
 	keyPollingMaxRetries             = "sonar.pollingMaxRetries"
 	keyPollingRetryIntervalMs        = "sonar.pollingRetryIntervalMs"
 	keyAttachmentArtifactoryTempPath = "attachment.artifactoryTempPath"
+	keySigstoreFulcioURL             = "sigstore.fulcioUrl"
+	keySigstoreRekorURL              = "sigstore.rekorUrl"
+	keyTSAURL                        = "tsa.url"
+	keyTSACertChain                  = "tsa.certChain"
+	keyVerifyRevocations             = "verify.revocations"
 
 	envReportTaskFile         = "SONAR_REPORT_TASK_FILE"
 	envSonarURL               = "SONAR_URL"
@@ -30,6 +35,14 @@ const (
 
 	EnvAttachmentArtifactoryTempPath = "EVIDENCE_ATTACHMENT_ARTIFACTORY_TEMP_PATH"
 	KeyAttachmentArtifactoryTempPath = keyAttachmentArtifactoryTempPath
+
+	EnvSigstoreFulcioURL = "EVIDENCE_SIGSTORE_FULCIO_URL"
+	EnvSigstoreRekorURL  = "EVIDENCE_SIGSTORE_REKOR_URL"
+
+	EnvTSAURL       = "EVIDENCE_TSA_URL"
+	EnvTSACertChain = "EVIDENCE_TSA_CERT_CHAIN"
+
+	EnvVerifyRevocations = "EVIDENCE_VERIFY_REVOCATIONS"
 )
 
 type SonarConfig struct {
@@ -42,12 +55,44 @@ type SonarConfig struct {
 type EvidenceConfig struct {
 	Sonar      *SonarConfig      `yaml:"sonar"`
 	Attachment *AttachmentConfig `yaml:"attachment"`
+	Sigstore   *SigstoreConfig   `yaml:"sigstore"`
+	TSA        *TSAConfig        `yaml:"tsa"`
+	Verify     *VerifyConfig     `yaml:"verify"`
+	// PredicateSchemas registers the JSON Schema predicates of a type are validated against.
+	PredicateSchemas []PredicateSchemaConfig `yaml:"predicateSchemas,omitempty"`
+	// dir is the directory of the configuration file, relative paths it sets are resolved against it.
+	dir string
 }
 
 type AttachmentConfig struct {
 	ArtifactoryTempPath string `yaml:"artifactoryTempPath"`
 }
 
+// SigstoreConfig holds the public-good (or private) Sigstore endpoints used for keyless signing.
+type SigstoreConfig struct {
+	FulcioURL string `yaml:"fulcioUrl"`
+	RekorURL  string `yaml:"rekorUrl"`
+}
+
+// TSAConfig holds the RFC 3161 timestamp authority used to timestamp signatures
+// and the PEM certificate chain trusted when verifying the timestamps.
+type TSAConfig struct {
+	URL       string `yaml:"url"`
+	CertChain string `yaml:"certChain"`
+}
+
+// PredicateSchemaConfig maps a predicate type to the JSON Schema file its predicates must match.
+type PredicateSchemaConfig struct {
+	PredicateType string `yaml:"predicateType"`
+	Path          string `yaml:"path"`
+}
+
+// VerifyConfig holds defaults applied when verifying evidence.
+type VerifyConfig struct {
+	// Revocations is the path of the signing key revocation file.
+	Revocations string `yaml:"revocations"`
+}
+
 func LoadEvidenceConfig() *EvidenceConfig {
 	// 1) Upstream .jfrog root
 	if root, exists, _ := fileutils.FindUpstream(jfrogDir, fileutils.Dir); exists {
@@ -85,6 +130,11 @@ func readConfigWithEnv(path string) *EvidenceConfig {
 	_ = v.BindEnv(keyPollingMaxRetries, envPollingMaxRetries)
 	_ = v.BindEnv(keyPollingRetryIntervalMs, envPollingRetryIntervalMs)
 	_ = v.BindEnv(keyAttachmentArtifactoryTempPath, EnvAttachmentArtifactoryTempPath)
+	_ = v.BindEnv(keySigstoreFulcioURL, EnvSigstoreFulcioURL)
+	_ = v.BindEnv(keySigstoreRekorURL, EnvSigstoreRekorURL)
+	_ = v.BindEnv(keyTSAURL, EnvTSAURL)
+	_ = v.BindEnv(keyTSACertChain, EnvTSACertChain)
+	_ = v.BindEnv(keyVerifyRevocations, EnvVerifyRevocations)
 	v.AutomaticEnv()
 
 	if path != "" {
@@ -98,9 +148,16 @@ func readConfigWithEnv(path string) *EvidenceConfig {
 		return nil
 	}
 	if (cfg.Sonar == nil || (*cfg.Sonar == (SonarConfig{}))) &&
-		(cfg.Attachment == nil || (*cfg.Attachment == (AttachmentConfig{}))) {
+		(cfg.Attachment == nil || (*cfg.Attachment == (AttachmentConfig{}))) &&
+		(cfg.Sigstore == nil || (*cfg.Sigstore == (SigstoreConfig{}))) &&
+		(cfg.TSA == nil || (*cfg.TSA == (TSAConfig{}))) &&
+		(cfg.Verify == nil || (*cfg.Verify == (VerifyConfig{}))) &&
+		len(cfg.PredicateSchemas) == 0 {
 		return nil
 	}
+	if path != "" {
+		cfg.dir = filepath.Dir(path)
+	}
 	return cfg
 }
 
@@ -115,6 +172,85 @@ func ResolveAttachmentArtifactoryTempPath() string {
 	return ""
 }
 
+// ResolveSigstoreEndpoints returns the Fulcio and Rekor URLs for keyless signing.
+// Explicit flag values win over evidence.yml / environment; empty results mean the public-good defaults.
+func ResolveSigstoreEndpoints(fulcioURL, rekorURL string) (string, string) {
+	if fulcioURL != "" && rekorURL != "" {
+		return fulcioURL, rekorURL
+	}
+	cfg := LoadEvidenceConfig()
+	if cfg != nil && cfg.Sigstore != nil {
+		if fulcioURL == "" {
+			fulcioURL = cfg.Sigstore.FulcioURL
+		}
+		if rekorURL == "" {
+			rekorURL = cfg.Sigstore.RekorURL
+		}
+	}
+	return fulcioURL, rekorURL
+}
+
+// ResolveTSAURL returns the timestamp authority URL used when creating evidence.
+// An explicit flag value wins over evidence.yml / environment; an empty result means no timestamping.
+func ResolveTSAURL(tsaURL string) string {
+	if tsaURL != "" {
+		return tsaURL
+	}
+	if cfg := LoadEvidenceConfig(); cfg != nil && cfg.TSA != nil {
+		return cfg.TSA.URL
+	}
+	return ""
+}
+
+// ResolveTSACertChain returns the path of the trusted TSA certificate chain used during verification.
+// An explicit flag value wins over environment / evidence.yml; a relative evidence.yml path is resolved
+// against the directory of evidence.yml.
+func ResolveTSACertChain(certChain string) string {
+	if certChain != "" {
+		return certChain
+	}
+	if envValue := os.Getenv(EnvTSACertChain); envValue != "" {
+		return envValue
+	}
+	if cfg := LoadEvidenceConfig(); cfg != nil && cfg.TSA != nil {
+		return cfg.resolvePath(cfg.TSA.CertChain)
+	}
+	return ""
+}
+
+// ResolveRevocationsPath returns the path of the key revocation file used during verification.
+// An explicit flag value wins over environment / evidence.yml; a relative evidence.yml path is resolved
+// against the directory of evidence.yml.
+func ResolveRevocationsPath(revocationsPath string) string {
+	if revocationsPath != "" {
+		return revocationsPath
+	}
+	if envValue := os.Getenv(EnvVerifyRevocations); envValue != "" {
+		return envValue
+	}
+	if cfg := LoadEvidenceConfig(); cfg != nil && cfg.Verify != nil {
+		return cfg.resolvePath(cfg.Verify.Revocations)
+	}
+	return ""
+}
+
+// ResolvePredicateSchemas returns the schema file registered in evidence.yml for each predicate type.
+// Relative paths are resolved against the directory of evidence.yml.
+func ResolvePredicateSchemas() map[string]string {
+	cfg := LoadEvidenceConfig()
+	if cfg == nil || len(cfg.PredicateSchemas) == 0 {
+		return nil
+	}
+	schemas := make(map[string]string, len(cfg.PredicateSchemas))
+	for _, schema := range cfg.PredicateSchemas {
+		if schema.PredicateType == "" || schema.Path == "" {
+			continue
+		}
+		schemas[schema.PredicateType] = cfg.resolvePath(schema.Path)
+	}
+	return schemas
+}
+
 func PersistAttachmentArtifactoryTempPath(artifactoryTempPath string) error {
 	path, err := resolveWritableConfigPath()
 	if err != nil {
@@ -151,3 +287,11 @@ func resolveWritableConfigPath() (string, error) {
 	}
 	return filepath.Join(home, evidenceDir, evidenceFileYml), nil
 }
+
+// resolvePath resolves a relative path set in the configuration file against the directory of that file.
+func (c *EvidenceConfig) resolvePath(path string) string {
+	if path == "" || filepath.IsAbs(path) || c.dir == "" {
+		return path
+	}
+	return filepath.Join(c.dir, path)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -109,3 +110,146 @@ func TestPersistAttachmentArtifactoryTempPath(t *testing.T) {
 		t.Fatalf("persisted artifactory temp path mismatch: %+v", cfg)
 	}
 }
+
+func TestResolveSigstoreEndpoints(t *testing.T) {
+	dir := t.TempDir()
+	jf := filepath.Join(dir, ".jfrog", "evidence")
+	if err := os.MkdirAll(jf, 0755); err != nil {
+		t.Fatal(err)
+	}
+	yml := filepath.Join(jf, "evidence.yml")
+	if err := os.WriteFile(yml, []byte("sigstore:\n  fulcioUrl: https://fulcio.internal\n  rekorUrl: https://rekor.internal\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	fulcio, rekor := ResolveSigstoreEndpoints("", "")
+	if fulcio != "https://fulcio.internal" || rekor != "https://rekor.internal" {
+		t.Fatalf("expected config endpoints, got %s %s", fulcio, rekor)
+	}
+
+	fulcio, rekor = ResolveSigstoreEndpoints("https://fulcio.flag", "")
+	if fulcio != "https://fulcio.flag" || rekor != "https://rekor.internal" {
+		t.Fatalf("expected flag to take precedence, got %s %s", fulcio, rekor)
+	}
+
+	t.Setenv(EnvSigstoreRekorURL, "https://rekor.env")
+	_, rekor = ResolveSigstoreEndpoints("", "")
+	if rekor != "https://rekor.env" {
+		t.Fatalf("expected env override, got %s", rekor)
+	}
+}
+
+func TestResolveTSASettings(t *testing.T) {
+	dir := t.TempDir()
+	jf := filepath.Join(dir, ".jfrog", "evidence")
+	if err := os.MkdirAll(jf, 0755); err != nil {
+		t.Fatal(err)
+	}
+	yml := filepath.Join(jf, "evidence.yml")
+	if err := os.WriteFile(yml, []byte("tsa:\n  url: https://tsa.internal\n  certChain: /etc/tsa/chain.pem\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	if url := ResolveTSAURL(""); url != "https://tsa.internal" {
+		t.Fatalf("expected config TSA URL, got %s", url)
+	}
+	if url := ResolveTSAURL("https://tsa.flag"); url != "https://tsa.flag" {
+		t.Fatalf("expected flag to take precedence, got %s", url)
+	}
+	if chain := ResolveTSACertChain(""); chain != "/etc/tsa/chain.pem" {
+		t.Fatalf("expected config cert chain, got %s", chain)
+	}
+
+	t.Setenv(EnvTSACertChain, "/env/chain.pem")
+	if chain := ResolveTSACertChain(""); chain != "/env/chain.pem" {
+		t.Fatalf("expected env override, got %s", chain)
+	}
+}
+
+func TestResolveTSACertChain_RelativeToConfig(t *testing.T) {
+	dir := t.TempDir()
+	jf := filepath.Join(dir, ".jfrog", "evidence")
+	if err := os.MkdirAll(jf, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(jf, "evidence.yml"), []byte("tsa:\n  certChain: tsa/chain.pem\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	if chain := ResolveTSACertChain(""); !strings.HasSuffix(chain, filepath.Join(".jfrog", "evidence", "tsa", "chain.pem")) {
+		t.Fatalf("expected relative path resolved against evidence.yml, got %s", chain)
+	}
+	t.Setenv(EnvTSACertChain, "env/chain.pem")
+	if chain := ResolveTSACertChain(""); chain != "env/chain.pem" {
+		t.Fatalf("expected env value kept as is, got %s", chain)
+	}
+}
+
+func TestResolveRevocationsPath(t *testing.T) {
+	dir := t.TempDir()
+	jf := filepath.Join(dir, ".jfrog", "evidence")
+	if err := os.MkdirAll(jf, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(jf, "evidence.yml"), []byte("verify:\n  revocations: /etc/evidence/revocations.yml\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	if path := ResolveRevocationsPath(""); path != "/etc/evidence/revocations.yml" {
+		t.Fatalf("expected config revocations path, got %s", path)
+	}
+	if path := ResolveRevocationsPath("./revocations.yml"); path != "./revocations.yml" {
+		t.Fatalf("expected flag to take precedence, got %s", path)
+	}
+	t.Setenv(EnvVerifyRevocations, "/env/revocations.yml")
+	if path := ResolveRevocationsPath(""); path != "/env/revocations.yml" {
+		t.Fatalf("expected env override, got %s", path)
+	}
+}
+
+func TestResolveRevocationsPath_RelativeToConfig(t *testing.T) {
+	dir := t.TempDir()
+	jf := filepath.Join(dir, ".jfrog", "evidence")
+	if err := os.MkdirAll(jf, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(jf, "evidence.yml"), []byte("verify:\n  revocations: revocations.yml\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	if path := ResolveRevocationsPath(""); !strings.HasSuffix(path, filepath.Join(".jfrog", "evidence", "revocations.yml")) {
+		t.Fatalf("expected relative path resolved against evidence.yml, got %s", path)
+	}
+}
+
+func TestResolvePredicateSchemas(t *testing.T) {
+	dir := t.TempDir()
+	jf := filepath.Join(dir, ".jfrog", "evidence")
+	if err := os.MkdirAll(jf, 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := "predicateSchemas:\n" +
+		"  - predicateType: https://example.com/scan/v1\n    path: schemas/scan.json\n" +
+		"  - predicateType: https://example.com/deploy/v1\n    path: /etc/evidence/deploy.json\n" +
+		"  - predicateType: https://example.com/incomplete/v1\n"
+	if err := os.WriteFile(filepath.Join(jf, "evidence.yml"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+
+	schemas := ResolvePredicateSchemas()
+	if len(schemas) != 2 {
+		t.Fatalf("expected 2 schemas, got %v", schemas)
+	}
+	if path := schemas["https://example.com/scan/v1"]; !strings.HasSuffix(path, filepath.Join(".jfrog", "evidence", "schemas", "scan.json")) {
+		t.Fatalf("expected relative path resolved against evidence.yml, got %s", path)
+	}
+	if path := schemas["https://example.com/deploy/v1"]; path != "/etc/evidence/deploy.json" {
+		t.Fatalf("expected absolute path kept, got %s", path)
+	}
+}
@@ -0,0 +1,152 @@
+package create
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// AttachDirectoryArchive attaches a directory as a single zip archive.
+	AttachDirectoryArchive = "archive"
+	// AttachDirectoryExpand attaches every file of a directory as its own attachment.
+	AttachDirectoryExpand = "expand"
+
+	// attachmentPathsSeparator separates the values of --attach-local and --attach-artifactory-path.
+	attachmentPathsSeparator = ";"
+)
+
+// localAttachment is a local file to upload as an attachment.
+type localAttachment struct {
+	localPath string
+	// name is the attachment name of a file of an expanded directory, relative to the temp path
+	// directory. Empty for plain files, which are named after their upload target.
+	name string
+}
+
+// splitAttachmentPaths splits a ';' separated attachment flag value, skipping empty entries.
+func splitAttachmentPaths(value string) []string {
+	var paths []string
+	for _, entry := range strings.Split(value, attachmentPathsSeparator) {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			paths = append(paths, entry)
+		}
+	}
+	return paths
+}
+
+// collectLocalAttachments lists the files to upload for --attach-local. Directories are archived or expanded
+// according to --attach-directory-mode; the returned cleanup removes the archives created.
+func (c *createEvidenceBase) collectLocalAttachments() ([]localAttachment, func(), error) {
+	localPaths := splitAttachmentPaths(c.attachLocalPath)
+	if len(localPaths) == 0 {
+		return nil, nil, nil
+	}
+	var files []localAttachment
+	var tempDirs []string
+	cleanup := func() {
+		for _, dir := range tempDirs {
+			if err := os.RemoveAll(dir); err != nil {
+				log.Warn("Failed to remove temporary attachment archive:", err)
+			}
+		}
+	}
+	for _, localPath := range localPaths {
+		info, err := os.Stat(localPath)
+		if err != nil {
+			return nil, cleanup, errorutils.CheckErrorf("failed to read --%s file '%s': %v", flags.AttachLocal, localPath, err)
+		}
+		if !info.IsDir() {
+			files = append(files, localAttachment{localPath: localPath})
+			continue
+		}
+		if strings.EqualFold(c.attachDirectoryMode, AttachDirectoryExpand) {
+			expanded, err := expandAttachmentDirectory(localPath)
+			if err != nil {
+				return nil, cleanup, err
+			}
+			files = append(files, expanded...)
+			continue
+		}
+		tempDir, err := os.MkdirTemp("", "evidence-attachment-")
+		if err != nil {
+			return nil, cleanup, errorutils.CheckError(err)
+		}
+		tempDirs = append(tempDirs, tempDir)
+		archivePath := filepath.Join(tempDir, directoryBaseName(localPath)+".zip")
+		if err = archiveAttachmentDirectory(localPath, archivePath); err != nil {
+			return nil, cleanup, err
+		}
+		files = append(files, localAttachment{localPath: archivePath})
+	}
+	return files, cleanup, nil
+}
+
+// expandAttachmentDirectory lists the regular files of dir, named by their path under the directory name.
+func expandAttachmentDirectory(dir string) ([]localAttachment, error) {
+	base := directoryBaseName(dir)
+	var files []localAttachment
+	err := walkAttachmentDirectory(dir, func(file, relativePath string) error {
+		files = append(files, localAttachment{localPath: file, name: path.Join(base, relativePath)})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, errorutils.CheckErrorf("--%s directory '%s' contains no files", flags.AttachLocal, dir)
+	}
+	return files, nil
+}
+
+// archiveAttachmentDirectory zips the regular files of dir, with entries relative to the directory.
+func archiveAttachmentDirectory(dir, archivePath string) error {
+	var entries []utils.ZipEntry
+	err := walkAttachmentDirectory(dir, func(file, relativePath string) error {
+		entries = append(entries, utils.ZipEntry{Path: file, Name: relativePath})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return errorutils.CheckErrorf("--%s directory '%s' contains no files", flags.AttachLocal, dir)
+	}
+	return utils.ZipFiles(archivePath, entries)
+}
+
+// walkAttachmentDirectory calls visit, in lexical order, for every regular file under dir with its
+// slash separated path relative to dir. Symbolic links and other special files are skipped.
+func walkAttachmentDirectory(dir string, visit func(file, relativePath string) error) error {
+	return filepath.WalkDir(dir, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		if !entry.Type().IsRegular() {
+			if !entry.IsDir() {
+				log.Debug("Skipping attachment directory entry that is not a regular file:", file)
+			}
+			return nil
+		}
+		relativePath, err := filepath.Rel(dir, file)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		return visit(file, filepath.ToSlash(relativePath))
+	})
+}
+
+// directoryBaseName names the archive or the expanded files after the directory, also for relative paths like ".".
+func directoryBaseName(dir string) string {
+	if absolute, err := filepath.Abs(dir); err == nil {
+		dir = absolute
+	}
+	return filepath.Base(dir)
+}
@@ -0,0 +1,215 @@
+package create
+
+import (
+	"archive/zip"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// uploadRecordingServicesManager records the attachment uploads and cleanup deletions.
+type uploadRecordingServicesManager struct {
+	SimpleMockServicesManager
+	uploads   map[string]string
+	deleted   []string
+	failAfter int
+}
+
+func newUploadRecordingServicesManager() *uploadRecordingServicesManager {
+	return &uploadRecordingServicesManager{uploads: map[string]string{}, failAfter: -1}
+}
+
+func (m *uploadRecordingServicesManager) UploadFiles(_ artifactory.UploadServiceOptions, params ...services.UploadParams) (int, int, error) {
+	if m.failAfter >= 0 && len(m.uploads) >= m.failAfter {
+		return 0, 1, errors.New("upload rejected")
+	}
+	for _, param := range params {
+		m.uploads[param.Target] = param.Pattern
+	}
+	return len(params), 0, nil
+}
+
+func (m *uploadRecordingServicesManager) GetPathsToDelete(params services.DeleteParams) (*content.ContentReader, error) {
+	m.deleted = append(m.deleted, params.Pattern)
+	return nil, nil
+}
+
+func writeAttachmentDirectory(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "reports")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "unit"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "summary.txt"), []byte("summary"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "unit", "TEST-a.xml"), []byte("<testsuite/>"), 0o600))
+	return dir
+}
+
+func attachmentNames(attachments []*statementAttachment) []string {
+	names := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		names = append(names, attachment.Name)
+	}
+	return names
+}
+
+func TestSplitAttachmentPaths(t *testing.T) {
+	assert.Nil(t, splitAttachmentPaths(""))
+	assert.Equal(t, []string{"a.txt", "dir/b.txt"}, splitAttachmentPaths(" a.txt ;; dir/b.txt;"))
+}
+
+func TestResolveAttachments_MultipleFiles(t *testing.T) {
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "first.txt"), filepath.Join(dir, "second.json")
+	require.NoError(t, os.WriteFile(first, []byte("1"), 0o600))
+	require.NoError(t, os.WriteFile(second, []byte("2"), 0o600))
+	mock := newUploadRecordingServicesManager()
+	c := &createEvidenceBase{
+		attachLocalPath:           first + ";" + second,
+		attachArtifactoryPath:     "repo/existing/report.html;repo/existing/scan.sarif",
+		attachArtifactoryTempPath: "tmp/evidence/",
+	}
+
+	attachments, cleanup, err := c.resolveAttachments(mock)
+	require.NoError(t, err)
+	require.NotNil(t, cleanup)
+	assert.Equal(t, []string{"report.html", "scan.sarif", "first.txt", "second.json"}, attachmentNames(attachments))
+	assert.Equal(t, map[string]string{"tmp/evidence/first.txt": first, "tmp/evidence/second.json": second}, mock.uploads)
+	assert.Equal(t, "existing/report.html", attachments[0].Path)
+	assert.Equal(t, "evidence/first.txt", attachments[2].Path)
+
+	cleanup()
+	assert.Equal(t, []string{"tmp/evidence/second.json", "tmp/evidence/first.txt"}, mock.deleted)
+}
+
+func TestResolveAttachments_MultipleFilesNeedDirectoryTarget(t *testing.T) {
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "first.txt"), filepath.Join(dir, "second.txt")
+	require.NoError(t, os.WriteFile(first, []byte("1"), 0o600))
+	require.NoError(t, os.WriteFile(second, []byte("2"), 0o600))
+	mock := newUploadRecordingServicesManager()
+	c := &createEvidenceBase{attachLocalPath: first + ";" + second, attachArtifactoryTempPath: "tmp/evidence/custom.txt"}
+
+	_, _, err := c.resolveAttachments(mock)
+	assert.ErrorContains(t, err, "must be a directory (ending with '/') to upload 2 attachments")
+	assert.Empty(t, mock.uploads)
+}
+
+func TestResolveAttachments_TargetClash(t *testing.T) {
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "a", "report.json"), filepath.Join(dir, "b", "report.json")
+	for _, file := range []string{first, second} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+		require.NoError(t, os.WriteFile(file, []byte(file), 0o600))
+	}
+	firstDir, secondDir := filepath.Join(dir, "x", "reports"), filepath.Join(dir, "y", "reports")
+	for _, reports := range []string{firstDir, secondDir} {
+		require.NoError(t, os.MkdirAll(reports, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(reports, "summary.txt"), []byte(reports), 0o600))
+	}
+
+	tests := []struct {
+		name      string
+		localPath string
+		target    string
+	}{
+		{"same file name", first + ";" + second, "tmp/report.json"},
+		{"same directory name", firstDir + ";" + secondDir, "tmp/reports.zip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newUploadRecordingServicesManager()
+			c := &createEvidenceBase{attachLocalPath: tt.localPath, attachArtifactoryTempPath: "tmp/"}
+
+			_, _, err := c.resolveAttachments(mock)
+			assert.ErrorContains(t, err, "would both be uploaded to '"+tt.target+"'")
+			assert.Empty(t, mock.uploads, "nothing should be uploaded when targets clash")
+		})
+	}
+}
+
+func TestResolveAttachments_PartialFailureCleansUp(t *testing.T) {
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "first.txt"), filepath.Join(dir, "second.txt")
+	require.NoError(t, os.WriteFile(first, []byte("1"), 0o600))
+	require.NoError(t, os.WriteFile(second, []byte("2"), 0o600))
+	mock := newUploadRecordingServicesManager()
+	mock.failAfter = 1
+	c := &createEvidenceBase{attachLocalPath: first + ";" + second, attachArtifactoryTempPath: "tmp/"}
+
+	attachments, cleanup, err := c.resolveAttachments(mock)
+	assert.ErrorContains(t, err, "failed to upload --attach-local file to 'tmp/second.txt'")
+	assert.Nil(t, attachments)
+	assert.Nil(t, cleanup)
+	assert.Equal(t, []string{"tmp/first.txt"}, mock.deleted, "the attachment uploaded before the failure should be removed")
+}
+
+func TestResolveAttachments_DirectoryArchive(t *testing.T) {
+	dir := writeAttachmentDirectory(t)
+	mock := newUploadRecordingServicesManager()
+	var archivePath string
+	mock.FileInfoFunc = func(repoPath string) (*utils.FileInfo, error) {
+		archivePath = mock.uploads[repoPath]
+		return NewFileInfoBuilder().WithSha256("zip-sha").Build(), nil
+	}
+	c := &createEvidenceBase{attachLocalPath: dir, attachArtifactoryTempPath: "tmp/"}
+
+	attachments, cleanup, err := c.resolveAttachments(mock)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"reports.zip"}, attachmentNames(attachments))
+	assert.Equal(t, "application/zip", attachments[0].Type)
+
+	archive, err := zip.OpenReader(archivePath)
+	require.NoError(t, err)
+	var entries []string
+	for _, file := range archive.File {
+		entries = append(entries, file.Name)
+	}
+	require.NoError(t, archive.Close())
+	assert.Equal(t, []string{"summary.txt", "unit/TEST-a.xml"}, entries)
+
+	cleanup()
+	assert.NoFileExists(t, archivePath, "the temporary archive should be removed")
+}
+
+func TestResolveAttachments_DirectoryExpand(t *testing.T) {
+	dir := writeAttachmentDirectory(t)
+	mock := newUploadRecordingServicesManager()
+	c := &createEvidenceBase{attachLocalPath: dir, attachArtifactoryTempPath: "tmp/run-1/", attachDirectoryMode: AttachDirectoryExpand}
+
+	attachments, cleanup, err := c.resolveAttachments(mock)
+	require.NoError(t, err)
+	require.NotNil(t, cleanup)
+	assert.Equal(t, []string{"reports/summary.txt", "reports/unit/TEST-a.xml"}, attachmentNames(attachments))
+	targets := make([]string, 0, len(mock.uploads))
+	for target := range mock.uploads {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+	assert.Equal(t, []string{"tmp/run-1/reports/summary.txt", "tmp/run-1/reports/unit/TEST-a.xml"}, targets)
+	assert.Equal(t, "run-1/reports/unit/TEST-a.xml", attachments[1].Path)
+}
+
+func TestResolveAttachments_EmptyDirectory(t *testing.T) {
+	for _, mode := range []string{AttachDirectoryArchive, AttachDirectoryExpand} {
+		t.Run(mode, func(t *testing.T) {
+			c := &createEvidenceBase{attachLocalPath: t.TempDir(), attachArtifactoryTempPath: "tmp/", attachDirectoryMode: mode}
+			_, _, err := c.resolveAttachments(newUploadRecordingServicesManager())
+			assert.ErrorContains(t, err, "contains no files")
+		})
+	}
+}
+
+func TestDirectoryBaseName(t *testing.T) {
+	t.Chdir(writeAttachmentDirectory(t))
+	assert.Equal(t, "reports", directoryBaseName("."))
+	assert.Equal(t, "unit", directoryBaseName("unit/"))
+	assert.False(t, strings.ContainsAny(directoryBaseName("."), `/\`))
+}
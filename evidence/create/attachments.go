@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"mime"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	evdConfig "github.com/jfrog/jfrog-cli-evidence/evidence/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/intoto"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -30,15 +33,118 @@ type parsedTarget struct {
 	TargetPath string
 }
 
-func (c *createEvidenceBase) resolveAttachment(client artifactory.ArtifactoryServicesManager) (*statementAttachment, func(), error) {
-	if c.attachLocalPath == "" && c.attachArtifactoryPath == "" {
+// resolveAttachments uploads or resolves every attachment of the evidence: the file generated by the CLI
+// (SBOM or integration report), each --attach-artifactory-path and each file of --attach-local. The returned
+// cleanup removes the temporary uploads and files; when resolving fails, whatever was already uploaded is
+// cleaned up before returning.
+func (c *createEvidenceBase) resolveAttachments(client artifactory.ArtifactoryServicesManager) (attachments []*statementAttachment, cleanup func(), err error) {
+	var cleanups []func()
+	cleanupAll := func() {
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i]()
+		}
+	}
+	defer func() {
+		if err != nil {
+			cleanupAll()
+		}
+	}()
+
+	generated, generatedCleanup, err := c.resolveGeneratedAttachment(client)
+	if generatedCleanup != nil {
+		cleanups = append(cleanups, generatedCleanup)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if generated != nil {
+		attachments = append(attachments, generated)
+	}
+
+	for _, repoPath := range splitAttachmentPaths(c.attachArtifactoryPath) {
+		attachment, err := c.resolveExistingArtifactoryAttachment(client, repoPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	files, removeFiles, err := c.collectLocalAttachments()
+	if removeFiles != nil {
+		cleanups = append(cleanups, removeFiles)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	uploads := len(files)
+	if generated != nil {
+		uploads++
+	}
+	if len(files) > 0 && uploads > 1 && !isAttachmentDirectoryTarget(c.attachArtifactoryTempPath) {
+		return nil, nil, errorutils.CheckErrorf("invalid --%s '%s': must be a directory (ending with '/') to upload %d attachments", flags.AttachArtifactoryTempPath, c.attachArtifactoryTempPath, uploads)
+	}
+	if err = c.checkAttachmentTargetClashes(files, generated); err != nil {
+		return nil, nil, err
+	}
+	for _, file := range files {
+		attachment, uploadCleanup, err := c.uploadLocalAttachment(client, file)
+		if uploadCleanup != nil {
+			cleanups = append(cleanups, uploadCleanup)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if len(attachments) == 0 && len(cleanups) == 0 {
 		return nil, nil, nil
 	}
-	if c.attachArtifactoryPath != "" {
-		att, err := c.resolveExistingArtifactoryAttachment(client, c.attachArtifactoryPath)
-		return att, nil, err
+	return attachments, cleanupAll, nil
+}
+
+// resolveGeneratedAttachment uploads the SBOM of --sbom-as-attachment or the file of the selected integration.
+func (c *createEvidenceBase) resolveGeneratedAttachment(client artifactory.ArtifactoryServicesManager) (*statementAttachment, func(), error) {
+	if c.sbomAsAttachment {
+		return c.uploadSbomAttachment(client)
+	}
+	return c.resolveIntegrationAttachment(client)
+}
+
+// resolveIntegrationAttachment uploads the local file provided by the selected integration, if any,
+// the same way as --attach-local.
+func (c *createEvidenceBase) resolveIntegrationAttachment(client artifactory.ArtifactoryServicesManager) (*statementAttachment, func(), error) {
+	integration, ok := lookupIntegration(c.integration)
+	if !ok {
+		return nil, nil, nil
+	}
+	provider, ok := integration.(integrations.AttachmentProvider)
+	if !ok {
+		return nil, nil, nil
+	}
+	localPath, removeLocal, err := provider.Attachment(&c.integrationRequest)
+	if err != nil || localPath == "" {
+		return nil, nil, err
+	}
+	return c.uploadGeneratedAttachment(client, localPath, removeLocal)
+}
+
+// uploadGeneratedAttachment uploads a file produced by the CLI itself the same way as --attach-local,
+// calling removeLocal, if set, once the attachment is no longer needed.
+func (c *createEvidenceBase) uploadGeneratedAttachment(client artifactory.ArtifactoryServicesManager, localPath string, removeLocal func()) (*statementAttachment, func(), error) {
+	if removeLocal == nil {
+		removeLocal = func() {}
+	}
+	if c.attachArtifactoryTempPath == "" {
+		c.attachArtifactoryTempPath = evdConfig.ResolveAttachmentArtifactoryTempPath()
 	}
-	return c.uploadLocalAttachment(client)
+	attachment, cleanup, err := c.uploadLocalAttachment(client, localAttachment{localPath: localPath})
+	return attachment, func() {
+		if cleanup != nil {
+			cleanup()
+		}
+		removeLocal()
+	}, err
 }
 
 func (c *createEvidenceBase) resolveExistingArtifactoryAttachment(client artifactory.ArtifactoryServicesManager, repoPath string) (*statementAttachment, error) {
@@ -65,18 +171,20 @@ func (c *createEvidenceBase) resolveExistingArtifactoryAttachment(client artifac
 	}, nil
 }
 
-func (c *createEvidenceBase) uploadLocalAttachment(client artifactory.ArtifactoryServicesManager) (*statementAttachment, func(), error) {
-	if _, err := os.Stat(c.attachLocalPath); err != nil {
-		return nil, nil, errorutils.CheckErrorf("failed to read --%s file '%s': %v", flags.AttachLocal, c.attachLocalPath, err)
+// uploadLocalAttachment uploads the file through --attach-artifactory-temp-path. Once the file is uploaded,
+// the returned cleanup deletes it again, also when resolving its checksum fails.
+func (c *createEvidenceBase) uploadLocalAttachment(client artifactory.ArtifactoryServicesManager, file localAttachment) (*statementAttachment, func(), error) {
+	if _, err := os.Stat(file.localPath); err != nil {
+		return nil, nil, errorutils.CheckErrorf("failed to read --%s file '%s': %v", flags.AttachLocal, file.localPath, err)
 	}
-	target, err := parseAttachmentArtifactoryTempPath(c.attachArtifactoryTempPath, c.attachLocalPath)
+	target, err := c.attachmentTarget(file)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	uploadParams := services.UploadParams{
 		CommonParams: &artUtils.CommonParams{
-			Pattern: c.attachLocalPath,
+			Pattern: file.localPath,
 			Target:  target.TargetPath,
 		},
 	}
@@ -88,18 +196,6 @@ func (c *createEvidenceBase) uploadLocalAttachment(client artifactory.Artifactor
 		return nil, nil, errorutils.CheckErrorf("failed to upload --%s file to '%s'", flags.AttachLocal, target.TargetPath)
 	}
 
-	repository, path, err := splitRepoPath(target.TargetPath)
-	if err != nil {
-		return nil, nil, err
-	}
-	fileInfo, err := client.FileInfo(target.TargetPath)
-	if err != nil {
-		return nil, nil, errorutils.CheckErrorf("failed to resolve uploaded attachment '%s': %v", target.TargetPath, err)
-	}
-	if fileInfo == nil || fileInfo.Checksums.Sha256 == "" {
-		return nil, nil, errorutils.CheckErrorf("uploaded attachment '%s' is invalid: sha256 checksum is missing", target.TargetPath)
-	}
-
 	cleanup := func() {
 		deleteParams := services.NewDeleteParams()
 		deleteParams.Pattern = target.TargetPath
@@ -119,42 +215,110 @@ func (c *createEvidenceBase) uploadLocalAttachment(client artifactory.Artifactor
 		}
 	}
 
+	repository, repoPath, err := splitRepoPath(target.TargetPath)
+	if err != nil {
+		return nil, cleanup, err
+	}
+	fileInfo, err := client.FileInfo(target.TargetPath)
+	if err != nil {
+		return nil, cleanup, errorutils.CheckErrorf("failed to resolve uploaded attachment '%s': %v", target.TargetPath, err)
+	}
+	if fileInfo == nil || fileInfo.Checksums.Sha256 == "" {
+		return nil, cleanup, errorutils.CheckErrorf("uploaded attachment '%s' is invalid: sha256 checksum is missing", target.TargetPath)
+	}
+
+	name := file.name
+	if name == "" {
+		name = filepath.Base(repoPath)
+	}
 	return &statementAttachment{
 		Repository: repository,
-		Path:       path,
+		Path:       repoPath,
 		Sha256:     fileInfo.Checksums.Sha256,
-		Name:       filepath.Base(path),
-		Type:       detectMimeType(c.attachLocalPath),
+		Name:       name,
+		Type:       detectMimeType(file.localPath),
 	}, cleanup, nil
 }
 
-func toStatementAttachmentMeta(att *statementAttachment) []intoto.Attachment {
-	if att == nil {
+// attachmentTarget returns where a local attachment is uploaded under --attach-artifactory-temp-path.
+func (c *createEvidenceBase) attachmentTarget(file localAttachment) (*parsedTarget, error) {
+	target, err := parseAttachmentArtifactoryTempPath(c.attachArtifactoryTempPath, file.localPath)
+	if err != nil {
+		return nil, err
+	}
+	if file.name != "" {
+		// Files of an expanded directory keep their relative path under the temp directory.
+		target.TargetPath = path.Join(path.Dir(target.TargetPath), file.name)
+	}
+	return target, nil
+}
+
+// checkAttachmentTargetClashes fails when two local attachments, or a local attachment and the generated one,
+// would be uploaded to the same temp path. The later upload would overwrite the earlier one, leaving the
+// checksum recorded for the first attachment pointing to the content of the second.
+func (c *createEvidenceBase) checkAttachmentTargetClashes(files []localAttachment, generated *statementAttachment) error {
+	sources := map[string]string{}
+	if generated != nil {
+		sources[generated.Repository+"/"+generated.Path] = generated.Name
+	}
+	for _, file := range files {
+		target, err := c.attachmentTarget(file)
+		if err != nil {
+			return err
+		}
+		if source, exists := sources[target.TargetPath]; exists {
+			return errorutils.CheckErrorf("attachments '%s' and '%s' would both be uploaded to '%s': give them different names", source, file.localPath, target.TargetPath)
+		}
+		sources[target.TargetPath] = file.localPath
+	}
+	return nil
+}
+
+func toStatementAttachmentMeta(attachments []*statementAttachment) []intoto.Attachment {
+	if len(attachments) == 0 {
 		return nil
 	}
-	return []intoto.Attachment{{
-		Name:   att.Name,
-		Sha256: att.Sha256,
-		Type:   att.Type,
-	}}
+	meta := make([]intoto.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		meta = append(meta, intoto.Attachment{
+			Name:   attachment.Name,
+			Sha256: attachment.Sha256,
+			Type:   attachment.Type,
+		})
+	}
+	return meta
 }
 
-func (c *createEvidenceBase) wrapCreatePayloadWithAttachments(envelopeBytes []byte, att *statementAttachment) ([]byte, error) {
-	if att == nil {
+func (c *createEvidenceBase) wrapCreatePayloadWithAttachments(envelopeBytes []byte, attachments []*statementAttachment) ([]byte, error) {
+	if len(attachments) == 0 {
 		return envelopeBytes, nil
 	}
 	var payload map[string]any
 	if err := jsonUnmarshal(envelopeBytes, &payload); err != nil {
 		return nil, err
 	}
-	payload["attachments"] = []map[string]string{{
-		"repository": att.Repository,
-		"path":       att.Path,
-		"sha256":     att.Sha256,
-	}}
+	payloadAttachments := make([]map[string]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		payloadAttachments = append(payloadAttachments, map[string]string{
+			"repository": attachment.Repository,
+			"path":       attachment.Path,
+			"sha256":     attachment.Sha256,
+		})
+	}
+	payload["attachments"] = payloadAttachments
 	return jsonMarshal(payload)
 }
 
+// findAttachment returns the attachment with the given checksum, if any.
+func findAttachment(attachments []*statementAttachment, sha256 string) *statementAttachment {
+	for _, attachment := range attachments {
+		if attachment.Sha256 == sha256 {
+			return attachment
+		}
+	}
+	return nil
+}
+
 func parseAttachmentArtifactoryTempPath(target, localFilePath string) (*parsedTarget, error) {
 	if target == "" {
 		return nil, errorutils.CheckErrorf("--%s cannot be empty", flags.AttachArtifactoryTempPath)
@@ -168,7 +332,7 @@ func parseAttachmentArtifactoryTempPath(target, localFilePath string) (*parsedTa
 	}
 	repo := segments[0]
 
-	isDirectoryInput := len(segments) == 1 || strings.HasSuffix(target, "/")
+	isDirectoryInput := isAttachmentDirectoryTarget(target)
 	localName := filepath.Base(localFilePath)
 	var finalPath string
 	if isDirectoryInput {
@@ -187,6 +351,11 @@ func parseAttachmentArtifactoryTempPath(target, localFilePath string) (*parsedTa
 	}, nil
 }
 
+// isAttachmentDirectoryTarget reports whether the temp path is a directory the local file names are appended to.
+func isAttachmentDirectoryTarget(target string) bool {
+	return !strings.Contains(target, "/") || strings.HasSuffix(target, "/")
+}
+
 func splitRepoPath(repoPath string) (string, string, error) {
 	parts := strings.SplitN(repoPath, "/", 2)
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -43,9 +43,9 @@ func TestResolveAttachment_NoAttachments_SkipsVersionCheck(t *testing.T) {
 		},
 	}
 	c := &createEvidenceBase{}
-	att, cleanup, err := c.resolveAttachment(mock)
+	atts, cleanup, err := c.resolveAttachments(mock)
 	require.NoError(t, err)
-	assert.Nil(t, att)
+	assert.Nil(t, atts)
 	assert.Nil(t, cleanup)
 	assert.False(t, versionCalled, "GetVersion should not be called when no attachments are requested")
 }
@@ -57,17 +57,17 @@ func TestResolveAttachment_WithAttachment_Success(t *testing.T) {
 		},
 	}
 	c := &createEvidenceBase{attachArtifactoryPath: "repo/path/file.txt"}
-	att, _, err := c.resolveAttachment(mock)
+	atts, _, err := c.resolveAttachments(mock)
 	require.NoError(t, err)
-	require.NotNil(t, att)
-	assert.Equal(t, "abc123", att.Sha256)
+	require.Len(t, atts, 1)
+	assert.Equal(t, "abc123", atts[0].Sha256)
 }
 
 func TestWrapCreatePayloadWithAttachments(t *testing.T) {
 	base := &createEvidenceBase{}
 	envelope := []byte(`{"payload":"abc","payloadType":"application/vnd.in-toto+json","signatures":[]}`)
 	att := &statementAttachment{Repository: "repo", Path: "a/b.txt", Sha256: "sha"}
-	wrapped, err := base.wrapCreatePayloadWithAttachments(envelope, att)
+	wrapped, err := base.wrapCreatePayloadWithAttachments(envelope, []*statementAttachment{att})
 	require.NoError(t, err)
 	assert.Contains(t, string(wrapped), `"attachments"`)
 	assert.Contains(t, string(wrapped), `"repository":"repo"`)
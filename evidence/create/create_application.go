@@ -23,8 +23,8 @@ type createEvidenceApplication struct {
 }
 
 func NewCreateEvidenceApplication(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, applicationKey,
-	applicationVersion, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string) evidence.Command {
-	return &createEvidenceApplication{
+	applicationVersion, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string, opts ...EvidenceOption) evidence.Command {
+	cmd := &createEvidenceApplication{
 		createEvidenceBase: createEvidenceBase{
 			serverDetails:             serverDetails,
 			predicateFilePath:         predicateFilePath,
@@ -42,6 +42,8 @@ func NewCreateEvidenceApplication(serverDetails *config.ServerDetails, predicate
 		applicationKey:     applicationKey,
 		applicationVersion: applicationVersion,
 	}
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 func (c *createEvidenceApplication) CommandName() string {
@@ -64,7 +66,7 @@ func (c *createEvidenceApplication) Run() error {
 		log.Error("failed to create Artifactory client", err)
 		return err
 	}
-	attachment, cleanup, err := c.resolveAttachment(artifactoryClient)
+	attachments, cleanup, err := c.resolveAttachments(artifactoryClient)
 	if err != nil {
 		return err
 	}
@@ -76,11 +78,11 @@ func (c *createEvidenceApplication) Run() error {
 	if err != nil {
 		return err
 	}
-	envelope, err := c.createEnvelope(subject, sha256, attachment)
+	envelope, err := c.createEnvelope(subject, sha256, attachments)
 	if err != nil {
 		return err
 	}
-	response, err := c.uploadEvidence(envelope, subject, attachment)
+	response, err := c.uploadEvidence(envelope, subject, attachments)
 	if err != nil {
 		return err
 	}
@@ -1,20 +1,28 @@
 package create
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	markdownUtils "github.com/jfrog/jfrog-cli-core/v2/utils/markdown"
+	evdConfig "github.com/jfrog/jfrog-cli-evidence/evidence/config"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cryptox"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/foureyes"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/intoto"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/predschema"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sbom"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/sign"
-	"github.com/jfrog/jfrog-cli-evidence/evidence/sonar"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sigstore"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa"
 	evidenceUtils "github.com/jfrog/jfrog-cli-evidence/evidence/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 
@@ -27,12 +35,18 @@ import (
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
+// Indirections over the Sigstore keyless flow, timestamping and integrations, replaced in tests.
+var (
+	resolveIdentityToken = sigstore.ResolveIdentityToken
+	signKeyless          = sigstore.SignStatementKeyless
+	requestTimestamp     = tsa.RequestTimestamp
+	lookupIntegration    = integrations.Get
+)
+
 type evidenceUploader interface {
 	UploadEvidence(evidenceService.EvidenceDetails) ([]byte, error)
 }
 
-const sonarProviderId = "sonar"
-
 const maxImageSizePixels = 400
 
 type createEvidenceBase struct {
@@ -40,6 +54,10 @@ type createEvidenceBase struct {
 	predicateFilePath         string
 	predicateType             string
 	markdownFilePath          string
+	markdownTemplatePath      string
+	predicateSchemaPath       string
+	predicateSchema           *predschema.Schema
+	predicateSchemas          *predschema.Registry
 	key                       string
 	keyId                     string
 	providerId                string
@@ -50,10 +68,26 @@ type createEvidenceBase struct {
 	attachLocalPath           string
 	attachArtifactoryTempPath string
 	attachArtifactoryPath     string
+	attachDirectoryMode       string
 	artifactoryClient         artifactory.ArtifactoryServicesManager
 	uploader                  evidenceUploader
-	stmtResolver              sonar.StatementResolver
+	integrationRequest        integrations.Request
+	integrationResult         *integrations.Result
 	collectedResponses        []*model.CreateResponse
+	keyless                   bool
+	identityToken             string
+	fulcioURL                 string
+	rekorURL                  string
+	tsaURL                    string
+	validFor                  string
+	expiresAt                 string
+	provenanceTarget          string
+	sbomAsAttachment          bool
+	sbomDocument              *sbom.Document
+	gitRepoPath               string
+	gitFromRef                string
+	gitToRef                  string
+	fourEyes                  *foureyes.Policy
 }
 
 // CollectedResponses returns the list of CreateResponse objects gathered during evidence upload.
@@ -63,21 +97,38 @@ func (c *createEvidenceBase) CollectedResponses() []*model.CreateResponse {
 
 const EvdDefaultUser = "JFrog CLI"
 
-func (c *createEvidenceBase) createEnvelope(subject, subjectSha256 string, attachment *statementAttachment) ([]byte, error) {
+func (c *createEvidenceBase) createEnvelope(subject, subjectSha256 string, attachments []*statementAttachment) ([]byte, error) {
 	var statementJson []byte
 	var err error
-	if evidenceUtils.IsSonarIntegration(c.integration) {
-		statementJson, err = c.buildSonarStatement(subject, subjectSha256, attachment)
+	if integration, ok := lookupIntegration(c.integration); ok {
+		statementJson, err = c.buildIntegrationStatement(integration, subject, subjectSha256, attachments)
 	} else {
-		statementJson, err = c.buildIntotoStatementJson(subject, subjectSha256, attachment)
+		statementJson, err = c.buildIntotoStatementJson(subject, subjectSha256, attachments)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if statementJson, err = c.applyMarkdownTemplate(statementJson, subject); err != nil {
+		return nil, err
+	}
+	return c.signStatement(statementJson)
+}
+
+// signStatement signs the statement either with the configured private key (DSSE envelope)
+// or keylessly via Fulcio and Rekor (Sigstore bundle), and returns the payload to upload.
+func (c *createEvidenceBase) signStatement(statementJson []byte) ([]byte, error) {
+	if c.keyless {
+		return c.signStatementKeyless(statementJson)
+	}
 	signedEnvelope, err := createAndSignEnvelope(statementJson, c.key, c.keyId)
 	if err != nil {
 		return nil, err
 	}
+	if tsaURL := evdConfig.ResolveTSAURL(c.tsaURL); tsaURL != "" {
+		if err = timestampSignatures(signedEnvelope, tsaURL); err != nil {
+			return nil, err
+		}
+	}
 	envelopeBytes, err := json.Marshal(signedEnvelope)
 	if err != nil {
 		return nil, err
@@ -85,56 +136,56 @@ func (c *createEvidenceBase) createEnvelope(subject, subjectSha256 string, attac
 	return envelopeBytes, nil
 }
 
-// buildSonarStatement get in-toto statement from sonar, augments it with subject and stage, and returns it.
-func (c *createEvidenceBase) buildSonarStatement(subject, subjectSha256 string, attachment *statementAttachment) ([]byte, error) {
-	if c.stmtResolver == nil {
-		c.stmtResolver = sonar.NewStatementResolver()
-	}
-	statementBytes, err := c.stmtResolver.ResolveStatement()
+func (c *createEvidenceBase) signStatementKeyless(statementJson []byte) ([]byte, error) {
+	identityToken, err := resolveIdentityToken(c.identityToken)
 	if err != nil {
 		return nil, err
 	}
-
-	servicesManager, err := c.createArtifactoryClient()
-	if err != nil {
-		return nil, err
-	}
-
-	sha256, err := c.resolveSubjectSha256(servicesManager, subject, subjectSha256)
+	fulcioURL, rekorURL := evdConfig.ResolveSigstoreEndpoints(c.fulcioURL, c.rekorURL)
+	log.Info("Signing evidence keylessly using Sigstore")
+	signedBundle, err := signKeyless(statementJson, intoto.PayloadType, sigstore.KeylessOptions{
+		FulcioURL:     fulcioURL,
+		RekorURL:      rekorURL,
+		IdentityToken: identityToken,
+		TSAURL:        evdConfig.ResolveTSAURL(c.tsaURL),
+	})
 	if err != nil {
 		return nil, err
 	}
+	return json.Marshal(signedBundle)
+}
 
-	extendedStatement, err := adjustSonarStatement(statementBytes, sha256, c.stage, toStatementAttachmentMeta(attachment))
-	if err != nil {
-		return nil, err
+// timestampSignatures attaches an RFC 3161 timestamp token over each signature of the envelope.
+func timestampSignatures(envelope *dsse.Envelope, tsaURL string) error {
+	log.Info("Timestamping evidence signature using", tsaURL)
+	for i := range envelope.Signatures {
+		signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[i].Sig)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		timestampResponse, err := requestTimestamp(tsaURL, signature)
+		if err != nil {
+			return err
+		}
+		envelope.Signatures[i].Timestamp = base64.StdEncoding.EncodeToString(timestampResponse)
 	}
-	c.providerId = sonarProviderId
-	return extendedStatement, nil
+	return nil
 }
 
 func (c *createEvidenceBase) createEnvelopeWithPredicateAndPredicateType(subject,
-	subjectSha256, predicateType string, predicate []byte, attachment *statementAttachment) ([]byte, error) {
+	subjectSha256, predicateType string, predicate []byte, attachments []*statementAttachment) ([]byte, error) {
 	statementJson, err := c.buildIntotoStatementJsonWithPredicateAndPredicateType(subject,
-		subjectSha256, predicateType, predicate, attachment)
-	if err != nil {
-		return nil, err
-	}
-
-	signedEnvelope, err := createAndSignEnvelope(statementJson, c.key, c.keyId)
+		subjectSha256, predicateType, predicate, attachments)
 	if err != nil {
 		return nil, err
 	}
-
-	// Encode signedEnvelope into a byte slice
-	envelopeBytes, err := json.Marshal(signedEnvelope)
-	if err != nil {
+	if statementJson, err = c.applyMarkdownTemplate(statementJson, subject); err != nil {
 		return nil, err
 	}
-	return envelopeBytes, nil
+	return c.signStatement(statementJson)
 }
 
-func (c *createEvidenceBase) buildIntotoStatementJson(subject, subjectSha256 string, attachment *statementAttachment) ([]byte, error) {
+func (c *createEvidenceBase) buildIntotoStatementJson(subject, subjectSha256 string, attachments []*statementAttachment) ([]byte, error) {
 	predicate, err := os.ReadFile(c.predicateFilePath)
 	if err != nil {
 		log.Warn(fmt.Sprintf("failed to read predicate file '%s'", predicate))
@@ -151,11 +202,24 @@ func (c *createEvidenceBase) buildIntotoStatementJson(subject, subjectSha256 str
 		user = EvdDefaultUser
 	}
 
+	var generatedMarkdown []byte
+	if c.isSbomPredicate() {
+		if predicate, generatedMarkdown, err = c.resolveSbomPredicate(predicate, attachments); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = c.validatePredicate(predicate, c.predicateType); err != nil {
+		return nil, err
+	}
 	statement := intoto.NewStatement(predicate, c.predicateType, user)
 	err = c.setMarkdown(statement)
 	if err != nil {
 		return nil, err
 	}
+	if c.markdownFilePath == "" && generatedMarkdown != nil {
+		statement.SetMarkdown(generatedMarkdown)
+	}
 	sha256, err := c.resolveSubjectSha256(artifactoryClient, subject, subjectSha256)
 	if err != nil {
 		return nil, err
@@ -165,7 +229,10 @@ func (c *createEvidenceBase) buildIntotoStatementJson(subject, subjectSha256 str
 		return nil, err
 	}
 	statement.SetStage(c.stage)
-	statement.SetAttachments(toStatementAttachmentMeta(attachment))
+	statement.SetAttachments(toStatementAttachmentMeta(attachments))
+	if err = c.setExpiresAt(statement); err != nil {
+		return nil, err
+	}
 	statementJson, err := statement.Marshal()
 	if err != nil {
 		log.Error("failed marshaling statement json file", err)
@@ -185,12 +252,15 @@ func (c *createEvidenceBase) resolveSubjectSha256(servicesManager artifactory.Ar
 	return sha256, nil
 }
 
-func (c *createEvidenceBase) buildIntotoStatementJsonWithPredicateAndPredicateType(subject, subjectSha256, predicateType string, predicate []byte, attachment *statementAttachment) ([]byte, error) {
+func (c *createEvidenceBase) buildIntotoStatementJsonWithPredicateAndPredicateType(subject, subjectSha256, predicateType string, predicate []byte, attachments []*statementAttachment) ([]byte, error) {
 	artifactoryClient, err := c.createArtifactoryClient()
 	if err != nil {
 		return nil, err
 	}
 
+	if err = c.validatePredicate(predicate, predicateType); err != nil {
+		return nil, err
+	}
 	statement := intoto.NewStatement(predicate, predicateType, c.serverDetails.User)
 	err = c.setMarkdown(statement)
 	if err != nil {
@@ -207,7 +277,10 @@ func (c *createEvidenceBase) buildIntotoStatementJsonWithPredicateAndPredicateTy
 		return nil, err
 	}
 
-	statement.SetAttachments(toStatementAttachmentMeta(attachment))
+	statement.SetAttachments(toStatementAttachmentMeta(attachments))
+	if err = c.setExpiresAt(statement); err != nil {
+		return nil, err
+	}
 	statementJson, err := statement.Marshal()
 	if err != nil {
 		log.Error("failed marshaling statement json file", err)
@@ -216,6 +289,51 @@ func (c *createEvidenceBase) buildIntotoStatementJsonWithPredicateAndPredicateTy
 	return statementJson, nil
 }
 
+// setExpiresAt records the configured validity period, if any, relative to the statement creation time.
+func (c *createEvidenceBase) setExpiresAt(statement *intoto.Statement) error {
+	createdAt, err := statement.CreatedAtTime()
+	if err != nil {
+		return err
+	}
+	expiresAt, err := c.resolveExpiresAt(createdAt)
+	if err != nil || expiresAt.IsZero() {
+		return err
+	}
+	statement.SetExpiresAt(expiresAt)
+	return nil
+}
+
+// resolveExpiresAt returns the evidence expiry time, or the zero time when no validity period is configured.
+func (c *createEvidenceBase) resolveExpiresAt(createdAt time.Time) (time.Time, error) {
+	if c.validFor != "" && c.expiresAt != "" {
+		return time.Time{}, errorutils.CheckErrorf("only one of valid-for or expires-at can be set")
+	}
+	var expiresAt time.Time
+	switch {
+	case c.validFor != "":
+		validFor, err := evidenceUtils.ParseDuration(c.validFor)
+		if err != nil {
+			return time.Time{}, errorutils.CheckErrorf("invalid valid-for value: %s", err.Error())
+		}
+		if validFor <= 0 {
+			return time.Time{}, errorutils.CheckErrorf("valid-for must be a positive duration")
+		}
+		expiresAt = createdAt.Add(validFor)
+	case c.expiresAt != "":
+		parsed, err := evidenceUtils.ParseTimestamp(c.expiresAt)
+		if err != nil {
+			return time.Time{}, errorutils.CheckErrorf("invalid expires-at value '%s': expected RFC 3339 time or YYYY-MM-DD date", c.expiresAt)
+		}
+		expiresAt = parsed
+	default:
+		return time.Time{}, nil
+	}
+	if !expiresAt.After(createdAt) {
+		return time.Time{}, errorutils.CheckErrorf("evidence expiry %s must be after its creation time", intoto.FormatTime(expiresAt))
+	}
+	return expiresAt, nil
+}
+
 func (c *createEvidenceBase) setMarkdown(statement *intoto.Statement) error {
 	if c.markdownFilePath != "" {
 		if !strings.HasSuffix(c.markdownFilePath, ".md") {
@@ -236,7 +354,7 @@ func (c *createEvidenceBase) setMarkdown(statement *intoto.Statement) error {
 	return nil
 }
 
-func (c *createEvidenceBase) uploadEvidence(evidencePayload []byte, repoPath string, attachment *statementAttachment) (*model.CreateResponse, error) {
+func (c *createEvidenceBase) uploadEvidence(evidencePayload []byte, repoPath string, attachments []*statementAttachment) (*model.CreateResponse, error) {
 	if c.uploader == nil {
 		manager, err := utils.CreateEvidenceServiceManager(c.serverDetails, false)
 		if err != nil {
@@ -245,18 +363,18 @@ func (c *createEvidenceBase) uploadEvidence(evidencePayload []byte, repoPath str
 		c.uploader = manager
 	}
 
-	payload, err := c.wrapCreatePayloadWithAttachments(evidencePayload, attachment)
+	payload, err := c.wrapCreatePayloadWithAttachments(evidencePayload, attachments)
 	if err != nil {
 		return nil, err
 	}
 
 	var evidenceAttachments []evidenceService.AttachmentDetails
-	if attachment != nil {
-		evidenceAttachments = []evidenceService.AttachmentDetails{{
+	for _, attachment := range attachments {
+		evidenceAttachments = append(evidenceAttachments, evidenceService.AttachmentDetails{
 			Repository: attachment.Repository,
 			Path:       attachment.Path,
 			Sha256:     attachment.Sha256,
-		}}
+		})
 	}
 	evidenceDetails := evidenceService.EvidenceDetails{
 		SubjectUri: repoPath,
@@ -394,8 +512,18 @@ func enhanceKeyError(originalErr error, keyId string) error {
 	return fmt.Errorf("failed to load private key. Please verify the provided key is correct. Original error: %w", originalErr)
 }
 
-// adjustSonarStatement injects subject and stage into the given in-toto statement JSON.
-func adjustSonarStatement(statement []byte, sha256 string, stage string, attachments []intoto.Attachment) ([]byte, error) {
+// setStatementField sets a top level field of a JSON statement.
+func setStatementField(statement []byte, field string, value any) ([]byte, error) {
+	var m map[string]any
+	if err := json.Unmarshal(statement, &m); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	m[field] = value
+	return json.Marshal(m)
+}
+
+// adjustIntegrationStatement injects subject, stage and attachments into the given in-toto statement JSON.
+func adjustIntegrationStatement(statement []byte, sha256 string, stage string, attachments []intoto.Attachment) ([]byte, error) {
 	var m map[string]any
 	if err := json.Unmarshal(statement, &m); err != nil {
 		return nil, err
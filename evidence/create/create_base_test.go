@@ -8,12 +8,14 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/commandsummary"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cryptox"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/intoto"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -241,6 +243,15 @@ type fakeStmtResolver struct {
 
 func (f *fakeStmtResolver) ResolveStatement() ([]byte, error) { return f.out, f.err }
 
+// stubIntegration makes integration the only integration the create commands can look up.
+func stubIntegration(t *testing.T, integration integrations.Integration) {
+	original := lookupIntegration
+	lookupIntegration = func(name string) (integrations.Integration, bool) {
+		return integration, name == integration.Name()
+	}
+	t.Cleanup(func() { lookupIntegration = original })
+}
+
 func TestCreateEnvelope_Intoto_AndUploadDetails(t *testing.T) {
 	dir := t.TempDir()
 	pred := filepath.Join(dir, "predicate.json")
@@ -304,8 +315,8 @@ func TestCreateEnvelope_Sonar_ProviderAndSubjectStage(t *testing.T) {
 		stage:             "promoted",
 		integration:       "sonar",
 		artifactoryClient: art,
-		stmtResolver:      &fakeStmtResolver{out: stmt},
 	}
+	stubIntegration(t, integrations.NewSonar(&fakeStmtResolver{out: stmt}))
 
 	env, err := c.createEnvelope("any/repo/path", "", nil)
 	assert.NoError(t, err)
@@ -507,9 +518,9 @@ func TestUploadEvidence_UsesServerAttachmentsWhenPresent(t *testing.T) {
 		Type:       "text/plain",
 	}
 
-	wrappedPayload, err := c.wrapCreatePayloadWithAttachments([]byte(`{"payloadType":"x","payload":"y","signatures":[]}`), att)
+	wrappedPayload, err := c.wrapCreatePayloadWithAttachments([]byte(`{"payloadType":"x","payload":"y","signatures":[]}`), []*statementAttachment{att})
 	assert.NoError(t, err)
-	resp, err := c.uploadEvidence(wrappedPayload, "r/p", att)
+	resp, err := c.uploadEvidence(wrappedPayload, "r/p", []*statementAttachment{att})
 	assert.NoError(t, err)
 	assert.Len(t, resp.Attachments, 1)
 	assert.Equal(t, "scan.txt", resp.Attachments[0].Name)
@@ -539,7 +550,7 @@ func TestUploadEvidence_InvalidJSON(t *testing.T) {
 
 func TestAddSubjectAndStageToStatement_StageEmpty(t *testing.T) {
 	in := []byte(`{"predicateType":"x"}`)
-	out, err := adjustSonarStatement(in, "abc", "", nil)
+	out, err := adjustIntegrationStatement(in, "abc", "", nil)
 	assert.NoError(t, err)
 	var m map[string]any
 	assert.NoError(t, json.Unmarshal(out, &m))
@@ -556,15 +567,17 @@ func TestAddSubjectAndStageToStatement_StageEmpty(t *testing.T) {
 
 func TestBuildSonarStatement_ResolverError(t *testing.T) {
 	keyContent, _ := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
-	c := &createEvidenceBase{stmtResolver: &fakeStmtResolver{err: errors.New("x")}, artifactoryClient: createFileInfoOnlyMock("s"), key: string(keyContent)}
-	_, err := c.buildSonarStatement("r/p", "", nil)
+	sonarIntegration := integrations.NewSonar(&fakeStmtResolver{err: errors.New("x")})
+	c := &createEvidenceBase{artifactoryClient: createFileInfoOnlyMock("s"), key: string(keyContent)}
+	_, err := c.buildIntegrationStatement(sonarIntegration, "r/p", "", nil)
 	assert.Error(t, err)
 }
 
 func TestBuildSonarStatement_InvalidJSON(t *testing.T) {
 	keyContent, _ := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
-	c := &createEvidenceBase{stmtResolver: &fakeStmtResolver{out: []byte("x")}, artifactoryClient: createFileInfoOnlyMock("s"), key: string(keyContent)}
-	_, err := c.buildSonarStatement("r/p", "", nil)
+	sonarIntegration := integrations.NewSonar(&fakeStmtResolver{out: []byte("x")})
+	c := &createEvidenceBase{artifactoryClient: createFileInfoOnlyMock("s"), key: string(keyContent)}
+	_, err := c.buildIntegrationStatement(sonarIntegration, "r/p", "", nil)
 	assert.Error(t, err)
 }
 
@@ -628,7 +641,7 @@ func TestCreateEnvelopeWithPredicateAndPredicateType_SetsFields(t *testing.T) {
 }
 
 func TestAddSubjectAndStageToStatement_InvalidJSON(t *testing.T) {
-	_, err := adjustSonarStatement([]byte("not-json"), "abc", "stage", nil)
+	_, err := adjustIntegrationStatement([]byte("not-json"), "abc", "stage", nil)
 	assert.Error(t, err)
 }
 
@@ -640,8 +653,9 @@ func (f *failingArt) FileInfo(_ string) (*utils.FileInfo, error) { return nil, e
 
 func TestBuildSonarStatement_FileInfoError(t *testing.T) {
 	keyContent, _ := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
-	c := &createEvidenceBase{stmtResolver: &fakeStmtResolver{out: []byte(`{"a":1}`)}, artifactoryClient: &failingArt{}, key: string(keyContent)}
-	_, err := c.buildSonarStatement("r/p", "", nil)
+	sonarIntegration := integrations.NewSonar(&fakeStmtResolver{out: []byte(`{"a":1}`)})
+	c := &createEvidenceBase{artifactoryClient: &failingArt{}, key: string(keyContent)}
+	_, err := c.buildIntegrationStatement(sonarIntegration, "r/p", "", nil)
 	assert.Error(t, err)
 }
 
@@ -719,3 +733,49 @@ func TestGetFileChecksum_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "abc", sha)
 }
+
+func TestBuildIntotoStatementJson_ValidFor(t *testing.T) {
+	art := createFileInfoOnlyMock("zzz")
+	c := &createEvidenceBase{serverDetails: &config.ServerDetails{User: "bob"}, artifactoryClient: art}
+	WithValidity("90d", "")(c)
+	out, err := c.buildIntotoStatementJsonWithPredicateAndPredicateType("r/p", "", "ptype", []byte(`{"a":2}`), nil)
+	assert.NoError(t, err)
+	var st intoto.Statement
+	assert.NoError(t, json.Unmarshal(out, &st))
+	createdAt, err := st.CreatedAtTime()
+	assert.NoError(t, err)
+	expiresAt, err := time.Parse(time.RFC3339, st.ExpiresAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 90*24*time.Hour, expiresAt.Sub(createdAt))
+}
+
+func TestResolveExpiresAt(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	expiresAt, err := (&createEvidenceBase{}).resolveExpiresAt(createdAt)
+	assert.NoError(t, err)
+	assert.True(t, expiresAt.IsZero())
+
+	expiresAt, err = (&createEvidenceBase{validFor: "2w"}).resolveExpiresAt(createdAt)
+	assert.NoError(t, err)
+	assert.Equal(t, createdAt.Add(14*24*time.Hour), expiresAt)
+
+	expiresAt, err = (&createEvidenceBase{expiresAt: "2025-04-01"}).resolveExpiresAt(createdAt)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), expiresAt)
+
+	_, err = (&createEvidenceBase{expiresAt: "2024-12-31"}).resolveExpiresAt(createdAt)
+	assert.ErrorContains(t, err, "must be after its creation time")
+
+	_, err = (&createEvidenceBase{validFor: "soon"}).resolveExpiresAt(createdAt)
+	assert.ErrorContains(t, err, "invalid valid-for value")
+
+	_, err = (&createEvidenceBase{validFor: "1d", expiresAt: "2025-04-01"}).resolveExpiresAt(createdAt)
+	assert.ErrorContains(t, err, "only one of valid-for or expires-at")
+}
+
+func TestSetStatementField(t *testing.T) {
+	out, err := setStatementField([]byte(`{"predicateType":"sonar"}`), "expiresAt", "2025-04-01T00:00:00.000Z")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"predicateType":"sonar","expiresAt":"2025-04-01T00:00:00.000Z"}`, string(out))
+}
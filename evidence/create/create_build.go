@@ -3,7 +3,13 @@ package create
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	artifactoryUtils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
 
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/commandsummary"
@@ -25,8 +31,8 @@ type createEvidenceBuild struct {
 }
 
 func NewCreateEvidenceBuild(serverDetails *config.ServerDetails,
-	predicateFilePath, predicateType, markdownFilePath, key, keyId, project, buildName, buildNumber, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string) evidence.Command {
-	return &createEvidenceBuild{
+	predicateFilePath, predicateType, markdownFilePath, key, keyId, project, buildName, buildNumber, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string, opts ...EvidenceOption) evidence.Command {
+	cmd := &createEvidenceBuild{
 		createEvidenceBase: createEvidenceBase{
 			serverDetails:             serverDetails,
 			predicateFilePath:         predicateFilePath,
@@ -44,6 +50,8 @@ func NewCreateEvidenceBuild(serverDetails *config.ServerDetails,
 		buildName:   buildName,
 		buildNumber: buildNumber,
 	}
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 func (c *createEvidenceBuild) CommandName() string {
@@ -61,7 +69,7 @@ func (c *createEvidenceBuild) Run() error {
 		return err
 	}
 
-	attachment, cleanup, err := c.resolveAttachment(artifactoryClient)
+	attachments, cleanup, err := c.resolveAttachments(artifactoryClient)
 	if err != nil {
 		return err
 	}
@@ -69,7 +77,11 @@ func (c *createEvidenceBuild) Run() error {
 		defer cleanup()
 	}
 
-	timestamp, err := getBuildLatestTimestamp(c.buildName, c.buildNumber, c.project, artifactoryClient)
+	publishedBuildInfo, err := getPublishedBuildInfo(c.buildName, c.buildNumber, c.project, artifactoryClient)
+	if err != nil {
+		return err
+	}
+	timestamp, err := buildInfoTimestamp(publishedBuildInfo)
 	if err != nil {
 		return err
 	}
@@ -78,12 +90,18 @@ func (c *createEvidenceBuild) Run() error {
 	if err != nil {
 		return err
 	}
-	envelope, err := c.createEnvelope(subject, sha256, attachment)
+	c.integrationRequest.Project = c.project
+	c.integrationRequest.BuildInfo = &publishedBuildInfo.BuildInfo
+	c.integrationRequest.GitCommits = c.gitCommitsSincePreviousBuild
+	if strings.EqualFold(c.provenanceTarget, integrations.ProvenanceTargetArtifacts) {
+		return c.createArtifactsEvidence(artifactoryClient, &publishedBuildInfo.BuildInfo, attachments)
+	}
+	envelope, err := c.createEnvelope(subject, sha256, attachments)
 	if err != nil {
 		return err
 	}
 
-	response, err := c.uploadEvidence(envelope, subject, attachment)
+	response, err := c.uploadEvidence(envelope, subject, attachments)
 	if err != nil {
 		return err
 	}
@@ -125,6 +143,14 @@ func (c *createEvidenceBuild) recordSummary(subject string, sha256 string, respo
 }
 
 func getBuildLatestTimestamp(name string, number string, project string, artifactoryClient artifactory.ArtifactoryServicesManager) (string, error) {
+	res, err := getPublishedBuildInfo(name, number, project, artifactoryClient)
+	if err != nil {
+		return "", err
+	}
+	return buildInfoTimestamp(res)
+}
+
+func getPublishedBuildInfo(name string, number string, project string, artifactoryClient artifactory.ArtifactoryServicesManager) (*buildinfo.PublishedBuildInfo, error) {
 	buildInfo := services.BuildInfoParams{
 		BuildName:   name,
 		BuildNumber: number,
@@ -133,12 +159,17 @@ func getBuildLatestTimestamp(name string, number string, project string, artifac
 	log.Debug("Getting build info for buildName:", name, "buildNumber:", number, "project:", project)
 	res, ok, err := artifactoryClient.GetBuildInfo(buildInfo)
 	if err != nil {
-		return "", fmt.Errorf("failed to get build info for buildName: %s, buildNumber: %s, project: %s, error: %w", name, number, project, err)
+		return nil, fmt.Errorf("failed to get build info for buildName: %s, buildNumber: %s, project: %s, error: %w", name, number, project, err)
 	}
 	if !ok {
 		errorMessage := fmt.Sprintf("failed to find buildName, name:%s, number:%s, project: %s", name, number, project)
-		return "", errorutils.CheckError(errors.New(errorMessage))
+		return nil, errorutils.CheckError(errors.New(errorMessage))
 	}
+	return res, nil
+}
+
+// buildInfoTimestamp returns the build start time in epoch milliseconds, as used in the build-info JSON path.
+func buildInfoTimestamp(res *buildinfo.PublishedBuildInfo) (string, error) {
 	timestamp, err := utils.ParseIsoTimestamp(res.BuildInfo.Started)
 	if err != nil {
 		return "", err
@@ -159,3 +190,19 @@ func getBuildInfoPathChecksum(buildInfoPath string, artifactoryClient artifactor
 	}
 	return res.Checksums.Sha256, nil
 }
+
+// gitCommitsSincePreviousBuild lists the commits committer evidence covers, for integrations working on them.
+func (c *createEvidenceBuild) gitCommitsSincePreviousBuild() ([]model.GitLogEntry, error) {
+	buildConfiguration := new(build.BuildConfiguration)
+	buildConfiguration.SetBuildName(c.buildName).SetBuildNumber(c.buildNumber).SetProject(c.project)
+	gitDetails := artifactoryUtils.GitLogDetails{LogLimit: previousBuildLogLimit, PrettyFormat: gitFormat}
+	entries, err := gitCommitEntriesSincePreviousBuild(c.serverDetails, buildConfiguration, gitDetails)
+	if err != nil || len(entries) == 0 {
+		return entries, err
+	}
+	dotGit, err := artifactoryUtils.GetDotGit("")
+	if err != nil {
+		return nil, err
+	}
+	return entries, readCommitBodies(filepath.Dir(dotGit), entries)
+}
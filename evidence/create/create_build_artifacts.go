@@ -0,0 +1,113 @@
+package create
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/commandsummary"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const buildArtifactsAqlQueryTemplate = `items.find(%s).include("repo","path","name","sha256")`
+
+// aqlTimeLayout is the ISO 8601 layout AQL compares build start times in.
+const aqlTimeLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// buildArtifact is an artifact deployed by the build, addressed by its Artifactory path.
+type buildArtifact struct {
+	repoPath string
+	sha256   string
+}
+
+// createArtifactsEvidence attaches the evidence to every artifact the build deployed instead of to the build-info.
+func (c *createEvidenceBuild) createArtifactsEvidence(artifactoryClient artifactory.ArtifactoryServicesManager, buildInfo *buildinfo.BuildInfo, attachments []*statementAttachment) error {
+	artifacts, err := c.getBuildArtifacts(artifactoryClient, buildInfo)
+	if err != nil {
+		return err
+	}
+	if len(artifacts) == 0 {
+		return errorutils.CheckErrorf("no artifacts found for build %s %s", c.buildName, c.buildNumber)
+	}
+	log.Info(fmt.Sprintf("Attaching evidence to %d artifacts of build %s %s", len(artifacts), c.buildName, c.buildNumber))
+	for _, artifact := range artifacts {
+		envelope, err := c.createEnvelope(artifact.repoPath, artifact.sha256, attachments)
+		if err != nil {
+			return fmt.Errorf("failed to create evidence for %s: %w", artifact.repoPath, err)
+		}
+		response, err := c.uploadEvidence(envelope, artifact.repoPath, attachments)
+		if err != nil {
+			return fmt.Errorf("failed to create evidence for %s: %w", artifact.repoPath, err)
+		}
+		c.recordArtifactSummary(artifact, response.PredicateSlug, response.Verified)
+	}
+	return nil
+}
+
+// getBuildArtifacts lists the artifacts deployed by the build, as recorded by Artifactory for the build run.
+// The search is limited to the published run, so runs of other projects or re-runs with the same number are left out.
+func (c *createEvidenceBuild) getBuildArtifacts(artifactoryClient artifactory.ArtifactoryServicesManager, buildInfo *buildinfo.BuildInfo) ([]buildArtifact, error) {
+	started, err := utils.ParseIsoTimestamp(buildInfo.Started)
+	if err != nil {
+		return nil, err
+	}
+	query, err := buildArtifactsAqlQuery(c.buildName, c.buildNumber, started, utils.BuildBuildInfoRepoKey(c.project))
+	if err != nil {
+		return nil, err
+	}
+	result, err := utils.ExecuteAqlQuery(query, &artifactoryClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find artifacts of build %s %s: %w", c.buildName, c.buildNumber, err)
+	}
+	seen := make(map[string]bool)
+	var artifacts []buildArtifact
+	for _, item := range result.Results {
+		repoPath := path.Join(item.Repo, item.Path, item.Name)
+		if seen[repoPath] || item.Sha256 == "" {
+			continue
+		}
+		seen[repoPath] = true
+		artifacts = append(artifacts, buildArtifact{repoPath: repoPath, sha256: item.Sha256})
+	}
+	return artifacts, nil
+}
+
+// buildArtifactsAqlQuery finds the artifacts of a build run. The criteria are JSON encoded, so quotes in the build
+// name or number cannot change the query.
+func buildArtifactsAqlQuery(buildName, buildNumber string, started time.Time, buildInfoRepo string) (string, error) {
+	criteria := map[string]string{
+		"artifact.module.build.name":    buildName,
+		"artifact.module.build.number":  buildNumber,
+		"artifact.module.build.started": started.UTC().Format(aqlTimeLayout),
+		"artifact.module.build.repo":    buildInfoRepo,
+	}
+	var encoded bytes.Buffer
+	encoder := json.NewEncoder(&encoded)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(criteria); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return fmt.Sprintf(buildArtifactsAqlQueryTemplate, strings.TrimSpace(encoded.String())), nil
+}
+
+func (c *createEvidenceBuild) recordArtifactSummary(artifact buildArtifact, predicateSlug string, verified bool) {
+	commandSummary := commandsummary.EvidenceSummaryData{
+		Subject:       artifact.repoPath,
+		SubjectSha256: artifact.sha256,
+		PredicateType: c.predicateType,
+		PredicateSlug: predicateSlug,
+		Verified:      verified,
+		DisplayName:   artifact.repoPath,
+		SubjectType:   commandsummary.SubjectTypeArtifact,
+	}
+	if err := c.recordEvidenceSummary(commandSummary); err != nil {
+		log.Warn("Failed to record evidence summary:", err.Error())
+	}
+}
@@ -0,0 +1,136 @@
+package create
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/slsa"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	servicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	evdservices "github.com/jfrog/jfrog-client-go/evidence/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingUploader struct{ uploads []evdservices.EvidenceDetails }
+
+func (r *recordingUploader) UploadEvidence(details evdservices.EvidenceDetails) ([]byte, error) {
+	r.uploads = append(r.uploads, details)
+	return json.Marshal(model.CreateResponse{PredicateSlug: "slsa-provenance", Verified: true, PredicateType: slsa.PredicateType})
+}
+
+func newProvenanceBuildCommand(t *testing.T, target string, art *SimpleMockServicesManager, uploader evidenceUploader) *createEvidenceBuild {
+	t.Helper()
+	keyContent, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	require.NoError(t, err)
+	art.GetBuildInfoFunc = func(services.BuildInfoParams) (*buildinfo.PublishedBuildInfo, bool, error) {
+		return &buildinfo.PublishedBuildInfo{BuildInfo: buildinfo.BuildInfo{
+			Name:    "my-build",
+			Number:  "42",
+			Started: "2024-01-17T22:04:05.000+0000",
+			VcsList: []buildinfo.Vcs{{Url: "https://github.com/my-org/app.git", Revision: "abc123"}},
+		}}, true, nil
+	}
+	return &createEvidenceBuild{
+		createEvidenceBase: createEvidenceBase{
+			serverDetails:     &config.ServerDetails{User: "u"},
+			key:               string(keyContent),
+			integration:       integrations.SlsaProvenanceName,
+			provenanceTarget:  target,
+			artifactoryClient: art,
+			uploader:          uploader,
+		},
+		buildName:   "my-build",
+		buildNumber: "42",
+	}
+}
+
+func uploadedStatement(t *testing.T, details evdservices.EvidenceDetails) map[string]any {
+	t.Helper()
+	var envelope dsse.Envelope
+	require.NoError(t, json.Unmarshal(details.DSSEFileRaw, &envelope))
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	require.NoError(t, err)
+	var statement map[string]any
+	require.NoError(t, json.Unmarshal(payload, &statement))
+	return statement
+}
+
+func TestCreateEvidenceBuild_SlsaProvenance_BuildTarget(t *testing.T) {
+	uploader := &recordingUploader{}
+	c := newProvenanceBuildCommand(t, "", createMockArtifactoryManagerForBuildTests(), uploader)
+
+	require.NoError(t, c.Run())
+	require.Len(t, uploader.uploads, 1)
+	assert.Equal(t, "artifactory-build-info/my-build/42-1705529045000.json", uploader.uploads[0].SubjectUri)
+
+	statement := uploadedStatement(t, uploader.uploads[0])
+	assert.Equal(t, slsa.PredicateType, statement["predicateType"])
+	predicate := statement["predicate"].(map[string]any)
+	externalParameters := predicate["buildDefinition"].(map[string]any)["externalParameters"].(map[string]any)
+	assert.Equal(t, "my-build", externalParameters["buildName"])
+	assert.Equal(t, "42", externalParameters["buildNumber"])
+	assert.Equal(t, slsa.PredicateType, c.predicateType)
+}
+
+func TestCreateEvidenceBuild_SlsaProvenance_ArtifactsTarget(t *testing.T) {
+	art := createMockArtifactoryManagerForBuildTests()
+	var query string
+	art.AqlFunc = func(q string) (io.ReadCloser, error) {
+		query = q
+		return io.NopCloser(strings.NewReader(`{"results":[
+			{"repo":"generic-local","path":"app/1.0","name":"app.tgz","sha256":"sha-app"},
+			{"repo":"generic-local","path":".","name":"README.md","sha256":"sha-readme"},
+			{"repo":"generic-local","path":"app/1.0","name":"app.tgz","sha256":"sha-app"}
+		]}`)), nil
+	}
+	checksums := map[string]string{"generic-local/app/1.0/app.tgz": "sha-app", "generic-local/README.md": "sha-readme"}
+	art.FileInfoFunc = func(path string) (*servicesUtils.FileInfo, error) {
+		info := &servicesUtils.FileInfo{Uri: path}
+		info.Checksums.Sha256 = checksums[path]
+		if info.Checksums.Sha256 == "" {
+			info.Checksums.Sha256 = "dummy_sha256"
+		}
+		return info, nil
+	}
+	uploader := &recordingUploader{}
+	c := newProvenanceBuildCommand(t, "artifacts", art, uploader)
+
+	require.NoError(t, c.Run())
+	assert.Contains(t, query, `"artifact.module.build.name":"my-build"`)
+	assert.Contains(t, query, `"artifact.module.build.number":"42"`)
+	assert.Contains(t, query, `"artifact.module.build.started":"2024-01-17T22:04:05.000Z"`)
+	assert.Contains(t, query, `"artifact.module.build.repo":"artifactory-build-info"`)
+	require.Len(t, uploader.uploads, 2)
+	assert.Equal(t, "generic-local/app/1.0/app.tgz", uploader.uploads[0].SubjectUri)
+	assert.Equal(t, "generic-local/README.md", uploader.uploads[1].SubjectUri)
+	for _, upload := range uploader.uploads {
+		assert.Equal(t, slsa.PredicateType, uploadedStatement(t, upload)["predicateType"])
+	}
+}
+
+func TestBuildArtifactsAqlQuery_EscapesValues(t *testing.T) {
+	query, err := buildArtifactsAqlQuery(`app","artifact.module.build.number":"1`, `42\`, time.Date(2024, 1, 17, 22, 4, 5, 0, time.UTC), "proj-build-info")
+	require.NoError(t, err)
+	assert.Equal(t, `items.find({"artifact.module.build.name":"app\",\"artifact.module.build.number\":\"1","artifact.module.build.number":"42\\",`+
+		`"artifact.module.build.repo":"proj-build-info","artifact.module.build.started":"2024-01-17T22:04:05.000Z"}).include("repo","path","name","sha256")`, query)
+}
+
+func TestCreateEvidenceBuild_SlsaProvenance_NoArtifacts(t *testing.T) {
+	uploader := &recordingUploader{}
+	c := newProvenanceBuildCommand(t, "artifacts", createMockArtifactoryManagerForBuildTests(), uploader)
+
+	assert.ErrorContains(t, c.Run(), "no artifacts found for build my-build 42")
+	assert.Empty(t, uploader.uploads)
+}
@@ -7,7 +7,10 @@ import (
 	"strings"
 
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/commandsummary"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/create/resolvers"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 
 	"github.com/jfrog/jfrog-client-go/utils/log"
 	"github.com/sigstore/sigstore-go/pkg/bundle"
@@ -33,14 +36,14 @@ type createEvidenceCustom struct {
 }
 
 func NewCreateEvidenceCustom(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, subjectRepoPath,
-	subjectSha256, sigstoreBundlePath, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string) evidence.Command {
+	subjectSha256, sigstoreBundlePath, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string, opts ...EvidenceOption) evidence.Command {
 	var subjectRepoPathSlice []string
 	if subjectRepoPath != "" {
 		subjectRepoPathSlice = []string{subjectRepoPath}
 	} else {
 		subjectRepoPathSlice = []string{}
 	}
-	return &createEvidenceCustom{
+	cmd := &createEvidenceCustom{
 		createEvidenceBase: createEvidenceBase{
 			serverDetails:             serverDetails,
 			predicateFilePath:         predicateFilePath,
@@ -58,8 +61,10 @@ func NewCreateEvidenceCustom(serverDetails *config.ServerDetails, predicateFileP
 		subjectRepoPaths:   subjectRepoPathSlice,
 		subjectSha256:      subjectSha256,
 		sigstoreBundlePath: sigstoreBundlePath,
-		lookup:             resolvers.DefaultSubjectLookup{},
+		lookup:             resolvers.DefaultSubjectLookup{ServerDetails: serverDetails},
 	}
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 func (c *createEvidenceCustom) CommandName() string {
@@ -73,7 +78,7 @@ func (c *createEvidenceCustom) ServerDetails() (*config.ServerDetails, error) {
 func (c *createEvidenceCustom) Run() error {
 	var evidencePayload []byte
 	var err error
-	var attachment *statementAttachment
+	var attachments []*statementAttachment
 	var cleanup func()
 	client, err := c.createArtifactoryClient()
 	if err != nil {
@@ -86,7 +91,10 @@ func (c *createEvidenceCustom) Run() error {
 		log.Info("Reading sigstore bundle from path:", c.sigstoreBundlePath)
 		evidencePayload, err = c.processSigstoreBundle()
 	} else {
-		attachment, cleanup, err = c.resolveAttachment(client)
+		if err = c.resolveSubjectReference(client); err != nil {
+			return err
+		}
+		attachments, cleanup, err = c.resolveAttachments(client)
 		if err != nil {
 			return err
 		}
@@ -94,7 +102,7 @@ func (c *createEvidenceCustom) Run() error {
 			defer cleanup()
 		}
 		log.Info("Creating DSSE envelope for subject:", c.subjectRepoPaths)
-		evidencePayload, err = c.createDSSEEnvelope(attachment)
+		evidencePayload, err = c.createDSSEEnvelope(attachments)
 	}
 
 	if err != nil {
@@ -117,7 +125,7 @@ func (c *createEvidenceCustom) Run() error {
 			continue
 		}
 
-		response, err := c.uploadEvidence(evidencePayload, subjectRepoPath, attachment)
+		response, err := c.uploadEvidence(evidencePayload, subjectRepoPath, attachments)
 		if err != nil {
 			handledErr := c.handleSubjectNotFound(subjectRepoPath, err)
 			log.Error("Evidence upload failed for", subjectRepoPath, ":", handledErr.Error())
@@ -191,9 +199,80 @@ func (c *createEvidenceCustom) extractSubjectFromBundle(bundle *bundle.Bundle) (
 	return subjects, nil
 }
 
-func (c *createEvidenceCustom) createDSSEEnvelope(attachment *statementAttachment) ([]byte, error) {
+// resolveSubjectReference resolves a subject given as an image reference, such as docker://registry/image:tag,
+// to the repository paths of the image manifest. The digest comes from --subject-sha256 or, when the selected
+// integration knows the scanned artifact, from the integration. A Package URL, such as pkg:npm/lodash@4.17.21,
+// is resolved to the package version lead file and needs no digest.
+func (c *createEvidenceCustom) resolveSubjectReference(client artifactory.ArtifactoryServicesManager) error {
+	if len(c.subjectRepoPaths) != 1 {
+		return nil
+	}
+	subject := c.subjectRepoPaths[0]
+	if resolvers.IsPackageURL(subject) {
+		return c.resolvePackageURLSubject(client, subject)
+	}
+	if !strings.Contains(subject, "://") {
+		return nil
+	}
+	checksum := c.subjectSha256
+	if checksum == "" {
+		var err error
+		if checksum, err = c.integrationSubjectDigest(); err != nil {
+			return err
+		}
+	}
+	if checksum == "" {
+		return errorutils.CheckErrorf("--%s is required to resolve subject '%s'", flags.SubjectSha256, subject)
+	}
+
+	log.Info("Resolving subject:", subject, "with checksum:", checksum)
+	subjects, err := c.lookup.ResolveSubject(subject, checksum, client)
+	if err != nil {
+		return errorutils.CheckErrorf("failed to resolve subject '%s' with checksum '%s': %s", subject, checksum, err.Error())
+	}
+	if len(subjects) == 0 {
+		return c.newSubjectError(fmt.Sprintf("Subject resolution returned no results for '%s' with checksum '%s'", subject, checksum))
+	}
+	log.Info("Successfully resolved", len(subjects), "subjects:", strings.Join(subjects, ", "))
+	c.subjectRepoPaths = subjects
+	c.subjectSha256 = checksum
+	return nil
+}
+
+// resolvePackageURLSubject replaces a Package URL subject with the repository path of its lead file, which must
+// be found in a single repository. The subject checksum is then read from Artifactory and checked against --subject-sha256, as for any repo path.
+func (c *createEvidenceCustom) resolvePackageURLSubject(client artifactory.ArtifactoryServicesManager, subject string) error {
+	log.Info("Resolving package URL subject:", subject)
+	subjects, err := c.lookup.ResolveSubject(subject, c.subjectSha256, client)
+	if err != nil {
+		return errorutils.CheckErrorf("failed to resolve subject '%s': %s", subject, err.Error())
+	}
+	if len(subjects) == 0 {
+		return c.newSubjectError(fmt.Sprintf("Subject resolution returned no results for '%s'", subject))
+	}
+	if len(subjects) > 1 {
+		return errorutils.CheckErrorf("subject '%s' resolved to %d files (%s); add a repository_url qualifier to select the repository", subject, len(subjects), strings.Join(subjects, ", "))
+	}
+	log.Info("Successfully resolved subject:", subjects[0])
+	c.subjectRepoPaths = subjects
+	return nil
+}
+
+func (c *createEvidenceCustom) integrationSubjectDigest() (string, error) {
+	integration, ok := lookupIntegration(c.integration)
+	if !ok {
+		return "", nil
+	}
+	provider, ok := integration.(integrations.SubjectDigestProvider)
+	if !ok {
+		return "", nil
+	}
+	return provider.SubjectDigest(&c.integrationRequest)
+}
+
+func (c *createEvidenceCustom) createDSSEEnvelope(attachments []*statementAttachment) ([]byte, error) {
 	// There's always only one subject in this case.
-	envelope, err := c.createEnvelope(c.subjectRepoPaths[0], c.subjectSha256, attachment)
+	envelope, err := c.createEnvelope(c.subjectRepoPaths[0], c.subjectSha256, attachments)
 	if err != nil {
 		return nil, err
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/create/resolvers"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/sigstore"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -876,3 +877,73 @@ func TestCreateEvidenceCustom_HandleSubjectNotFound_ImprovedErrorContext(t *test
 	assert.Error(t, err)
 	assert.Equal(t, otherErr, err)
 }
+
+type fakeDigestIntegration struct {
+	fakeIntegration
+	digest string
+}
+
+func (f *fakeDigestIntegration) SubjectDigest(_ *integrations.Request) (string, error) {
+	return f.digest, nil
+}
+
+func TestCreateEvidenceCustom_ResolveSubjectReference(t *testing.T) {
+	var resolvedSubject, resolvedChecksum string
+	lookup := subjectLookupFunc(func(subject, checksum string, _ artifactory.ArtifactoryServicesManager) ([]string, error) {
+		resolvedSubject, resolvedChecksum = subject, checksum
+		return []string{"docker-local/app/1.0/manifest.json"}, nil
+	})
+
+	t.Run("digest from integration", func(t *testing.T) {
+		stubIntegration(t, &fakeDigestIntegration{digest: "abcd"})
+		c := &createEvidenceCustom{createEvidenceBase: createEvidenceBase{integration: "fake"}, subjectRepoPaths: []string{"docker://app:1.0"}, lookup: lookup}
+		assert.NoError(t, c.resolveSubjectReference(&mockArtifactoryServicesManagerCustom{}))
+		assert.Equal(t, "docker://app:1.0", resolvedSubject)
+		assert.Equal(t, "abcd", resolvedChecksum)
+		assert.Equal(t, []string{"docker-local/app/1.0/manifest.json"}, c.subjectRepoPaths)
+		assert.Equal(t, "abcd", c.subjectSha256)
+	})
+
+	t.Run("subject sha256 takes precedence", func(t *testing.T) {
+		stubIntegration(t, &fakeDigestIntegration{digest: "abcd"})
+		c := &createEvidenceCustom{createEvidenceBase: createEvidenceBase{integration: "fake"}, subjectRepoPaths: []string{"oci://app:1.0"}, subjectSha256: "ef01", lookup: lookup}
+		assert.NoError(t, c.resolveSubjectReference(&mockArtifactoryServicesManagerCustom{}))
+		assert.Equal(t, "ef01", resolvedChecksum)
+	})
+
+	t.Run("digest required", func(t *testing.T) {
+		c := &createEvidenceCustom{subjectRepoPaths: []string{"docker://app:1.0"}, lookup: lookup}
+		err := c.resolveSubjectReference(&mockArtifactoryServicesManagerCustom{})
+		assert.ErrorContains(t, err, "--subject-sha256 is required to resolve subject 'docker://app:1.0'")
+	})
+
+	t.Run("repository path unchanged", func(t *testing.T) {
+		c := &createEvidenceCustom{subjectRepoPaths: []string{"repo/path/name"}, lookup: lookup}
+		assert.NoError(t, c.resolveSubjectReference(&mockArtifactoryServicesManagerCustom{}))
+		assert.Equal(t, []string{"repo/path/name"}, c.subjectRepoPaths)
+	})
+
+	t.Run("package URL without digest", func(t *testing.T) {
+		purlLookup := subjectLookupFunc(func(subject, checksum string, _ artifactory.ArtifactoryServicesManager) ([]string, error) {
+			resolvedSubject, resolvedChecksum = subject, checksum
+			return []string{"npm-local/lodash/-/lodash-4.17.21.tgz"}, nil
+		})
+		c := &createEvidenceCustom{subjectRepoPaths: []string{"pkg:npm/lodash@4.17.21"}, lookup: purlLookup}
+		assert.NoError(t, c.resolveSubjectReference(&mockArtifactoryServicesManagerCustom{}))
+		assert.Equal(t, "pkg:npm/lodash@4.17.21", resolvedSubject)
+		assert.Empty(t, resolvedChecksum)
+		assert.Equal(t, []string{"npm-local/lodash/-/lodash-4.17.21.tgz"}, c.subjectRepoPaths)
+		assert.Empty(t, c.subjectSha256, "the checksum is read from the resolved lead file")
+	})
+
+	t.Run("package URL in several repositories", func(t *testing.T) {
+		purlLookup := subjectLookupFunc(func(string, string, artifactory.ArtifactoryServicesManager) ([]string, error) {
+			return []string{"npm-local/lodash/-/lodash-4.17.21.tgz", "npm-release/lodash/-/lodash-4.17.21.tgz"}, nil
+		})
+		c := &createEvidenceCustom{subjectRepoPaths: []string{"pkg:npm/lodash@4.17.21"}, lookup: purlLookup}
+		err := c.resolveSubjectReference(&mockArtifactoryServicesManagerCustom{})
+		assert.ErrorContains(t, err, "resolved to 2 files")
+		assert.ErrorContains(t, err, "repository_url")
+		assert.Equal(t, []string{"pkg:npm/lodash@4.17.21"}, c.subjectRepoPaths)
+	})
+}
@@ -0,0 +1,253 @@
+package create
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	artifactoryUtils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const gitDefaultPredicateType = "https://jfrog.com/evidence/git-committer/v1"
+
+// Commits are read with git's unit and record separators between fields and commits, so subjects and
+// names containing quotes or newlines need no escaping.
+var localGitFields = []string{"%H", "%h", "%T", "%t", "%P", "%p", "%s", "%f", "%an", "%ae", "%ad", "%cn", "%ce", "%cd", "%G?", "%GK", "%GF", "%GS"}
+
+var localGitFormat = strings.Join(localGitFields, "%x1f") + "%x1e"
+
+const previousBuildLogLimit = 100
+
+// gitSignatureStatuses maps git's %G? verification codes to the status recorded in the predicate.
+var gitSignatureStatuses = map[string]string{
+	"G": "good",
+	"B": "bad",
+	"U": "good-unknown-validity",
+	"X": "expired-signature",
+	"Y": "expired-key",
+	"R": "revoked-key",
+	"E": "cannot-verify",
+	// N for a signed commit means git is not configured to verify its format (e.g. no gpg.ssh.allowedSignersFile).
+	"N": "unverified",
+}
+
+// signatureFormats maps the armor header of a commit signature to its format.
+var signatureFormats = map[string]string{
+	"-----BEGIN PGP SIGNATURE-----":  "gpg",
+	"-----BEGIN SSH SIGNATURE-----":  "ssh",
+	"-----BEGIN SIGNED MESSAGE-----": "x509",
+}
+
+// runGit runs git in repoPath with input on stdin and returns its stdout.
+var runGit = func(repoPath, input string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", repoPath}, args...)...)
+	cmd.Stdin = strings.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errorutils.CheckErrorf("git %s failed: %s", args[0], strings.TrimSpace(stderr.String()+" "+err.Error()))
+	}
+	return stdout.String(), nil
+}
+
+func localGitProvider() vcsProvider {
+	return vcsProvider{
+		ciName:        "a local git clone",
+		isRunning:     func() bool { return true },
+		predicateType: gitDefaultPredicateType,
+	}
+}
+
+// localGitCommitInfo reads the commits of the range from the local clone, with their signatures.
+func (c *createGitHubEvidence) localGitCommitInfo() ([]model.GitLogEntry, error) {
+	repoPath, err := c.localGitRepoPath()
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := c.localGitRevisions(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	entries := []model.GitLogEntry{}
+	if len(revisions) == 0 {
+		return entries, nil
+	}
+	input := strings.Join(revisions, "\n") + "\n"
+	gitLog, err := runGit(repoPath, input, "log", "--no-walk=unsorted", "--stdin", "--pretty=format:"+localGitFormat)
+	if err != nil {
+		return nil, err
+	}
+	signatureFormatsByCommit, err := readSignatureFormats(repoPath, input)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range strings.Split(gitLog, "\x1e") {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		entry, err := parseLocalGitEntry(record, signatureFormatsByCommit)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// readCommitBodies fills the message body of the entries from the local clone. The JSON log format cannot
+// carry multi-line bodies, so they are read with the separator format instead.
+func readCommitBodies(repoPath string, entries []model.GitLogEntry) error {
+	revisions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		revisions = append(revisions, entry.Commit)
+	}
+	gitLog, err := runGit(repoPath, strings.Join(revisions, "\n")+"\n", "log", "--no-walk=unsorted", "--stdin", "--pretty=format:%H%x1f%b%x1e")
+	if err != nil {
+		return err
+	}
+	bodies := map[string]string{}
+	for _, record := range strings.Split(gitLog, "\x1e") {
+		commit, body, found := strings.Cut(strings.TrimLeft(record, "\n"), "\x1f")
+		if found {
+			bodies[commit] = strings.TrimSpace(body)
+		}
+	}
+	for i := range entries {
+		entries[i].Body = bodies[entries[i].Commit]
+	}
+	return nil
+}
+
+func (c *createGitHubEvidence) localGitRepoPath() (string, error) {
+	if c.gitRepoPath != "" {
+		return c.gitRepoPath, nil
+	}
+	dotGit, err := artifactoryUtils.GetDotGit("")
+	if err != nil {
+		return "", err
+	}
+	return filepath.Dir(dotGit), nil
+}
+
+// localGitRevisions lists the commits between the refs, or since the previous build's VCS revision when
+// no start ref is given, newest first.
+func (c *createGitHubEvidence) localGitRevisions(repoPath string) ([]string, error) {
+	for _, ref := range []string{c.gitFromRef, c.gitToRef} {
+		if strings.HasPrefix(ref, "-") {
+			return nil, errorutils.CheckErrorf("invalid git ref: %s", ref)
+		}
+	}
+	var output string
+	var err error
+	if c.gitFromRef != "" {
+		toRef := c.gitToRef
+		if toRef == "" {
+			toRef = "HEAD"
+		}
+		output, err = runGit(repoPath, "", "rev-list", c.gitFromRef+".."+toRef)
+	} else {
+		if c.gitToRef != "" {
+			return nil, errorutils.CheckErrorf("a git to-ref requires a git from-ref")
+		}
+		gitDetails := artifactoryUtils.GitLogDetails{LogLimit: previousBuildLogLimit, PrettyFormat: "%H", DotGitPath: filepath.Join(repoPath, ".git")}
+		output, err = getPlainGitLogFromPreviousBuild(c.serverDetails, c.createBuildConfiguration(), gitDetails)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(output), nil
+}
+
+func parseLocalGitEntry(record string, signatureFormatsByCommit map[string]string) (model.GitLogEntry, error) {
+	fields := strings.Split(record, "\x1f")
+	if len(fields) != len(localGitFields) {
+		return model.GitLogEntry{}, errorutils.CheckErrorf("unexpected git log output: %q", record)
+	}
+	var entry model.GitLogEntry
+	entry.Commit, entry.AbbreviatedCommit = fields[0], fields[1]
+	entry.Tree, entry.AbbreviatedTree = fields[2], fields[3]
+	entry.Parent, entry.AbbreviatedParent = fields[4], fields[5]
+	entry.Subject, entry.SanitizedSubject = fields[6], fields[7]
+	entry.Author.Name, entry.Author.Email, entry.Author.Date = fields[8], fields[9], fields[10]
+	entry.Commiter.Name, entry.Commiter.Email, entry.Commiter.Date = fields[11], fields[12], fields[13]
+
+	signature := &model.GitSignature{Status: gitSignatureStatuses[fields[14]], Key: fields[15], Fingerprint: fields[16], Signer: fields[17]}
+	signature.Format, signature.Signed = signatureFormatsByCommit[entry.Commit]
+	if !signature.Signed {
+		signature.Status = ""
+	}
+	entry.Signature = signature
+	return entry, nil
+}
+
+// readSignatureFormats reads the raw commit objects to find the signed commits and the format of their signature,
+// which %G? does not report when git cannot verify that format.
+func readSignatureFormats(repoPath, revisions string) (map[string]string, error) {
+	output, err := runGit(repoPath, revisions, "cat-file", "--batch")
+	if err != nil {
+		return nil, err
+	}
+	formats := map[string]string{}
+	reader := bufio.NewReader(strings.NewReader(output))
+	for {
+		header, err := reader.ReadString('\n')
+		if err == io.EOF {
+			return formats, nil
+		}
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		// Each object is written as "<sha> <type> <size>\n<content>\n".
+		parts := strings.Fields(header)
+		if len(parts) != 3 {
+			return nil, errorutils.CheckErrorf("unexpected git cat-file output: %q", header)
+		}
+		size, err := strconv.Atoi(parts[2])
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		content := make([]byte, size+1)
+		if _, err = io.ReadFull(reader, content); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if format := commitSignatureFormat(string(content)); format != "" {
+			formats[parts[0]] = format
+		}
+	}
+}
+
+func commitSignatureFormat(commit string) string {
+	headers, _, _ := strings.Cut(commit, "\n\n")
+	for _, line := range strings.Split(headers, "\n") {
+		value, ok := strings.CutPrefix(line, "gpgsig ")
+		if !ok {
+			value, ok = strings.CutPrefix(line, "gpgsig-sha256 ")
+		}
+		if !ok {
+			continue
+		}
+		if format, known := signatureFormats[strings.TrimSpace(value)]; known {
+			return format
+		}
+		return "unknown"
+	}
+	return ""
+}
+
+// localGitCommitEntriesJson is the predicate of --type git-committer.
+func (c *createGitHubEvidence) localGitCommitEntriesJson() ([]byte, error) {
+	entries, err := c.localGitCommitInfo()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(entries, "", "  ")
+}
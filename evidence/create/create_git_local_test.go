@@ -0,0 +1,194 @@
+package create
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	artifactoryUtils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLocalGitRepo creates a clone with an unsigned commit, a commit whose subject needs escaping and,
+// when ssh-keygen is available, an SSH signed commit. It returns the commits, oldest first.
+func newLocalGitRepo(t *testing.T) (string, []string, bool) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", repo, "-c", "user.name=Jane Doe", "-c", "user.email=jane@example.com"}, args...)...)
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+		return strings.TrimSpace(string(output))
+	}
+	git("init", "-q")
+	git("commit", "-q", "--allow-empty", "-m", "initial")
+	git("commit", "-q", "--allow-empty", "-m", `fix "quoted" subject`)
+	commits := []string{git("rev-parse", "HEAD~1"), git("rev-parse", "HEAD")}
+
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		return repo, commits, false
+	}
+	key := filepath.Join(t.TempDir(), "signing")
+	output, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-f", key).CombinedOutput()
+	require.NoError(t, err, string(output))
+	git("-c", "gpg.format=ssh", "-c", "user.signingkey="+key+".pub", "commit", "-q", "-S", "--allow-empty", "-m", "signed")
+	return repo, append(commits, git("rev-parse", "HEAD")), true
+}
+
+func TestLocalGitCommitInfo_Range(t *testing.T) {
+	repo, commits, signed := newLocalGitRepo(t)
+	c := &createGitHubEvidence{createEvidenceBase: createEvidenceBase{flagType: FlagTypeGitCommitter, gitRepoPath: repo, gitFromRef: commits[0]}}
+
+	entries, err := c.localGitCommitInfo()
+	require.NoError(t, err)
+	require.Len(t, entries, len(commits)-1)
+
+	// Newest first, like git log.
+	oldest := entries[len(entries)-1]
+	assert.Equal(t, commits[1], oldest.Commit)
+	assert.Equal(t, commits[0], oldest.Parent)
+	assert.Equal(t, `fix "quoted" subject`, oldest.Subject)
+	assert.Equal(t, "Jane Doe", oldest.Author.Name)
+	assert.Equal(t, "jane@example.com", oldest.Commiter.Email)
+	require.NotNil(t, oldest.Signature)
+	assert.False(t, oldest.Signature.Signed)
+	assert.Empty(t, oldest.Signature.Status)
+
+	if signed {
+		newest := entries[0]
+		assert.Equal(t, commits[2], newest.Commit)
+		require.NotNil(t, newest.Signature)
+		assert.True(t, newest.Signature.Signed)
+		assert.Equal(t, "ssh", newest.Signature.Format)
+		assert.NotEmpty(t, newest.Signature.Status)
+	}
+}
+
+func TestLocalGitCommitInfo_ToRef(t *testing.T) {
+	repo, commits, _ := newLocalGitRepo(t)
+	c := &createGitHubEvidence{createEvidenceBase: createEvidenceBase{gitRepoPath: repo, gitFromRef: commits[0], gitToRef: commits[1]}}
+
+	entries, err := c.localGitCommitInfo()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, commits[1], entries[0].Commit)
+}
+
+func TestLocalGitCommitInfo_SincePreviousBuild(t *testing.T) {
+	repo, commits, _ := newLocalGitRepo(t)
+	orig := getPlainGitLogFromPreviousBuild
+	defer func() { getPlainGitLogFromPreviousBuild = orig }()
+	var gitDetails artifactoryUtils.GitLogDetails
+	getPlainGitLogFromPreviousBuild = func(_ *config.ServerDetails, _ *build.BuildConfiguration, details artifactoryUtils.GitLogDetails) (string, error) {
+		gitDetails = details
+		return commits[1] + "\n", nil
+	}
+	c := &createGitHubEvidence{createEvidenceBase: createEvidenceBase{gitRepoPath: repo}, buildName: "b", buildNumber: "1"}
+
+	entries, err := c.localGitCommitInfo()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, commits[1], entries[0].Commit)
+	assert.Equal(t, "%H", gitDetails.PrettyFormat)
+	assert.Equal(t, filepath.Join(repo, ".git"), gitDetails.DotGitPath)
+}
+
+func TestReadCommitBodies(t *testing.T) {
+	repo, commits, _ := newLocalGitRepo(t)
+	cmd := exec.Command("git", "-C", repo, "-c", "user.name=Jane Doe", "-c", "user.email=jane@example.com", "commit", "-q", "--allow-empty", "-m", "add login", "-m", "Refs: PROJ-1\nReviewed-by: John")
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	head, err := exec.Command("git", "-C", repo, "rev-parse", "HEAD").Output()
+	require.NoError(t, err)
+	entries := []model.GitLogEntry{{Commit: strings.TrimSpace(string(head))}, {Commit: commits[0]}}
+
+	require.NoError(t, readCommitBodies(repo, entries))
+	assert.Equal(t, "Refs: PROJ-1\nReviewed-by: John", entries[0].Body)
+	assert.Empty(t, entries[1].Body)
+}
+
+func TestLocalGitCommitInfo_Errors(t *testing.T) {
+	repo, _, _ := newLocalGitRepo(t)
+	tests := []struct {
+		name     string
+		from, to string
+		expected string
+	}{
+		{"option as ref", "--output=/tmp/x", "", "invalid git ref: --output=/tmp/x"},
+		{"to without from", "", "HEAD", "a git to-ref requires a git from-ref"},
+		{"unknown ref", "no-such-ref", "", "git rev-list failed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &createGitHubEvidence{createEvidenceBase: createEvidenceBase{gitRepoPath: repo, gitFromRef: tt.from, gitToRef: tt.to}}
+			_, err := c.localGitCommitInfo()
+			assert.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
+
+func TestCommitSignatureFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		commit   string
+		expected string
+	}{
+		{"unsigned", "tree abc\nauthor a\n\nmessage\n", ""},
+		{"gpg", "tree abc\ngpgsig -----BEGIN PGP SIGNATURE-----\n \n -----END PGP SIGNATURE-----\n\nmessage\n", "gpg"},
+		{"ssh", "tree abc\ngpgsig -----BEGIN SSH SIGNATURE-----\n -----END SSH SIGNATURE-----\n\nmessage\n", "ssh"},
+		{"x509 sha256", "tree abc\ngpgsig-sha256 -----BEGIN SIGNED MESSAGE-----\n\nmessage\n", "x509"},
+		{"signature in message", "tree abc\n\ngpgsig -----BEGIN PGP SIGNATURE-----\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, commitSignatureFormat(tt.commit))
+		})
+	}
+}
+
+func TestCreateGithub_Run_GitCommitter_WithoutSummary(t *testing.T) {
+	repo, commits, _ := newLocalGitRepo(t)
+	t.Setenv("GITHUB_ACTIONS", "")
+	t.Setenv(coreutils.SummaryOutputDirPathEnv, "")
+	key, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	require.NoError(t, err)
+
+	upl := &captureUploaderGH{}
+	c := &createGitHubEvidence{
+		createEvidenceBase: createEvidenceBase{
+			serverDetails:     &config.ServerDetails{User: "u"},
+			key:               string(key),
+			artifactoryClient: &fakeArtMgrGH{},
+			uploader:          upl,
+			flagType:          FlagTypeGitCommitter,
+			gitRepoPath:       repo,
+			gitFromRef:        commits[0],
+		},
+		buildName:   "b",
+		buildNumber: "1",
+	}
+
+	require.NoError(t, c.Run())
+	assert.Contains(t, string(decodeEnvelopePayload(t, upl.body)), gitDefaultPredicateType)
+}
+
+func decodeEnvelopePayload(t *testing.T, envelope []byte) []byte {
+	var parsed struct {
+		Payload string `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(envelope, &parsed))
+	payload, err := base64.StdEncoding.DecodeString(parsed.Payload)
+	require.NoError(t, err)
+	return payload
+}
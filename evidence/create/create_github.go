@@ -3,7 +3,6 @@ package create
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"os"
 	"regexp"
@@ -15,18 +14,23 @@ import (
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/commandsummary"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-cli-evidence/evidence"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/foureyes"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
 	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type FlagType string
 
 const (
-	FlagTypeCommitterReviewer FlagType = "gh-commiter"
-	FlagTypeOther             FlagType = "other"
+	FlagTypeCommitterReviewer       FlagType = "gh-commiter"
+	FlagTypeGitLabCommitterReviewer FlagType = "gl-committer"
+	FlagTypeGitCommitter            FlagType = "git-committer"
+	FlagTypeOther                   FlagType = "other"
 )
 
 const ghDefaultPredicateType = "https://jfrog.com/evidence/gh-commiter/v1"
@@ -42,6 +46,32 @@ var (
 	}
 )
 
+// vcsProvider holds what differs between the CI systems committer/reviewer evidence is collected on.
+type vcsProvider struct {
+	ciName        string
+	isRunning     func() bool
+	predicateType string
+	repository    func() (owner, repository string, err error)
+	newClient     func(token string) (pullRequestClient, error)
+	// reviews returns the reviews of a pull request, in the shape recorded in the predicate.
+	reviews func(client pullRequestClient, owner, repository string, pullRequestId int) ([]vcsclient.PullRequestReviewDetails, error)
+}
+
+func gitHubProvider() vcsProvider {
+	return vcsProvider{
+		ciName:        "GitHub Actions",
+		isRunning:     utils.IsRunningUnderGitHubAction,
+		predicateType: ghDefaultPredicateType,
+		repository:    gitHubRepositoryDetails,
+		newClient:     newVcsClient,
+		reviews:       listPullRequestReviews,
+	}
+}
+
+func listPullRequestReviews(client pullRequestClient, owner, repository string, pullRequestId int) ([]vcsclient.PullRequestReviewDetails, error) {
+	return client.ListPullRequestReviews(context.Background(), owner, repository, pullRequestId)
+}
+
 // pullRequestClient is a minimal interface for what we actually use from vcsclient
 type pullRequestClient interface {
 	ListPullRequestsAssociatedWithCommit(ctx context.Context, owner, repository, commit string) ([]vcsclient.PullRequestInfo, error)
@@ -50,14 +80,15 @@ type pullRequestClient interface {
 
 type createGitHubEvidence struct {
 	createEvidenceBase
-	project     string
-	buildName   string
-	buildNumber string
+	project        string
+	buildName      string
+	buildNumber    string
+	fourEyesResult *foureyes.Result
 }
 
-func NewCreateGithub(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, project, buildName, buildNumber, typeFlag, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string) evidence.Command {
+func NewCreateGithub(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, project, buildName, buildNumber, typeFlag, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string, opts ...EvidenceOption) evidence.Command {
 	flagType := getFlagType(typeFlag)
-	return &createGitHubEvidence{
+	cmd := &createGitHubEvidence{
 		createEvidenceBase: createEvidenceBase{
 			serverDetails:             serverDetails,
 			predicateFilePath:         predicateFilePath,
@@ -74,16 +105,28 @@ func NewCreateGithub(serverDetails *config.ServerDetails, predicateFilePath, pre
 		buildName:   buildName,
 		buildNumber: buildNumber,
 	}
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 func getFlagType(typeFlag string) FlagType {
-	var flagType FlagType
-	if typeFlag == "gh-commiter" {
-		flagType = FlagTypeCommitterReviewer
-	} else {
-		flagType = FlagTypeOther
+	switch FlagType(typeFlag) {
+	case FlagTypeCommitterReviewer, FlagTypeGitLabCommitterReviewer, FlagTypeGitCommitter:
+		return FlagType(typeFlag)
+	default:
+		return FlagTypeOther
+	}
+}
+
+func (c *createGitHubEvidence) vcsProvider() vcsProvider {
+	switch c.flagType {
+	case FlagTypeGitLabCommitterReviewer:
+		return gitLabProvider()
+	case FlagTypeGitCommitter:
+		return localGitProvider()
+	default:
+		return gitHubProvider()
 	}
-	return flagType
 }
 
 func (c *createGitHubEvidence) CommandName() string {
@@ -95,8 +138,9 @@ func (c *createGitHubEvidence) ServerDetails() (*config.ServerDetails, error) {
 }
 
 func (c *createGitHubEvidence) Run() error {
-	if !utils.IsRunningUnderGitHubAction() {
-		return errors.New("this command is intended to be run under GitHub Actions")
+	provider := c.vcsProvider()
+	if !provider.isRunning() {
+		return fmt.Errorf("this command is intended to be run under %s", provider.ciName)
 	}
 	evidencePredicate, err := c.committerReviewerEvidence()
 	if err != nil {
@@ -108,7 +152,7 @@ func (c *createGitHubEvidence) Run() error {
 		log.Error("failed to create Artifactory client", err)
 		return err
 	}
-	attachment, cleanup, err := c.resolveAttachment(artifactoryClient)
+	attachments, cleanup, err := c.resolveAttachments(artifactoryClient)
 	if err != nil {
 		return err
 	}
@@ -120,21 +164,40 @@ func (c *createGitHubEvidence) Run() error {
 	if err != nil {
 		return err
 	}
-	envelope, err := c.createEnvelopeWithPredicateAndPredicateType(subject,
-		sha256, ghDefaultPredicateType, evidencePredicate, attachment)
+	statementJson, err := c.buildIntotoStatementJsonWithPredicateAndPredicateType(subject,
+		sha256, provider.predicateType, evidencePredicate, attachments)
 	if err != nil {
 		return err
 	}
-	_, err = c.uploadEvidence(envelope, subject, attachment)
+	if c.fourEyesResult != nil && c.markdownFilePath == "" {
+		if statementJson, err = setStatementField(statementJson, "markdown", string(c.fourEyesResult.Markdown())); err != nil {
+			return err
+		}
+	}
+	if statementJson, err = c.applyMarkdownTemplate(statementJson, subject); err != nil {
+		return err
+	}
+	envelope, err := c.signStatement(statementJson)
 	if err != nil {
 		return err
 	}
-
-	err = c.recordEvidenceSummaryData(evidencePredicate, subject, sha256)
+	_, err = c.uploadEvidence(envelope, subject, attachments)
 	if err != nil {
 		return err
 	}
 
+	// Outside GitHub Actions and GitLab CI there may be no job summary to record the commits in.
+	if c.flagType != FlagTypeGitCommitter || os.Getenv(coreutils.SummaryOutputDirPathEnv) != "" {
+		err = c.recordEvidenceSummaryData(evidencePredicate, subject, sha256)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The evidence records the violations; failing afterwards lets the policy gate the pipeline.
+	if c.fourEyesResult != nil {
+		return errorutils.CheckError(c.fourEyesResult.Err())
+	}
 	return nil
 }
 
@@ -199,8 +262,12 @@ func marshalEvidenceToGitLogEntryView(evidence []byte) (*model.GitLogEntryView,
 }
 
 func (c *createGitHubEvidence) committerReviewerEvidence() ([]byte, error) {
-	if c.flagType != FlagTypeCommitterReviewer {
-		return nil, errors.New("flag type must be gh-commiter")
+	switch c.flagType {
+	case FlagTypeCommitterReviewer, FlagTypeGitLabCommitterReviewer:
+	case FlagTypeGitCommitter:
+		return c.localGitCommitEntriesJson()
+	default:
+		return nil, fmt.Errorf("flag type must be %s, %s or %s", FlagTypeCommitterReviewer, FlagTypeGitLabCommitterReviewer, FlagTypeGitCommitter)
 	}
 
 	createBuildConfiguration := c.createBuildConfiguration()
@@ -219,7 +286,8 @@ func (c *createGitHubEvidence) createBuildConfiguration() *build.BuildConfigurat
 }
 
 func (c *createGitHubEvidence) getGitCommitInfo(serverDetails *config.ServerDetails, createBuildConfiguration *build.BuildConfiguration, gitDetails artifactoryUtils.GitLogDetails) ([]byte, error) {
-	owner, repository, err := gitHubRepositoryDetails()
+	provider := c.vcsProvider()
+	owner, repository, err := provider.repository()
 	if err != nil {
 		return nil, err
 	}
@@ -229,13 +297,13 @@ func (c *createGitHubEvidence) getGitCommitInfo(serverDetails *config.ServerDeta
 		return nil, err
 	}
 
-	ghToken, err := utils.GetEnvVariable("JF_GIT_TOKEN")
+	gitToken, err := utils.GetEnvVariable("JF_GIT_TOKEN")
 	if err != nil {
 		return nil, err
 	}
 
 	// Create VCS client
-	client, err := newVcsClient(ghToken)
+	client, err := provider.newClient(gitToken)
 	if err != nil {
 		return nil, err
 	}
@@ -252,8 +320,10 @@ func (c *createGitHubEvidence) getGitCommitInfo(serverDetails *config.ServerDeta
 			entries[i].PRreviewer = []vcsclient.PullRequestReviewDetails{}
 			continue
 		}
+		entries[i].PRnumber = prMetadata[0].ID
+		entries[i].PRauthor = prMetadata[0].Author
 
-		prReviewer, err := client.ListPullRequestReviews(context.Background(), owner, repository, int(prMetadata[0].ID))
+		prReviewer, err := provider.reviews(client, owner, repository, int(prMetadata[0].ID))
 		if err != nil {
 			log.Warn(fmt.Sprintf("Failed to get PR reviews for PR ID %d: %v", prMetadata[0].ID, err))
 			entries[i].PRreviewer = []vcsclient.PullRequestReviewDetails{}
@@ -263,6 +333,9 @@ func (c *createGitHubEvidence) getGitCommitInfo(serverDetails *config.ServerDeta
 		entries[i].PRreviewer = make([]vcsclient.PullRequestReviewDetails, len(prReviewer))
 		copy(entries[i].PRreviewer, prReviewer)
 	}
+	if c.fourEyes != nil {
+		c.fourEyesResult = c.fourEyes.Evaluate(entries)
+	}
 
 	out, err := json.MarshalIndent(entries, "", "  ")
 	if err != nil {
@@ -272,6 +345,12 @@ func (c *createGitHubEvidence) getGitCommitInfo(serverDetails *config.ServerDeta
 }
 
 func (c *createGitHubEvidence) getGitCommitEntries(serverDetails *config.ServerDetails, createBuildConfiguration *build.BuildConfiguration, gitDetails artifactoryUtils.GitLogDetails) ([]model.GitLogEntry, error) {
+	return gitCommitEntriesSincePreviousBuild(serverDetails, createBuildConfiguration, gitDetails)
+}
+
+// gitCommitEntriesSincePreviousBuild reads the commits of the local clone made since the VCS revision of the
+// previous build, up to gitDetails.LogLimit commits.
+func gitCommitEntriesSincePreviousBuild(serverDetails *config.ServerDetails, createBuildConfiguration *build.BuildConfiguration, gitDetails artifactoryUtils.GitLogDetails) ([]model.GitLogEntry, error) {
 	fullLog, err := getPlainGitLogFromPreviousBuild(serverDetails, createBuildConfiguration, gitDetails)
 	if err != nil {
 		return nil, err
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	artifactoryUtils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/foureyes"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
 
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
@@ -54,6 +55,7 @@ func TestGetFlagType(t *testing.T) {
 	}{
 		{"Empty flag", "", FlagTypeOther},
 		{"Committer reviewer flag", "gh-commiter", FlagTypeCommitterReviewer},
+		{"GitLab committer reviewer flag", "gl-committer", FlagTypeGitLabCommitterReviewer},
 		{"Other flag", "some-other-flag", FlagTypeOther},
 	}
 
@@ -255,6 +257,60 @@ func TestCreateGithub_Run_Success_WithInjectedDeps(t *testing.T) {
 	assert.NotNil(t, upl.body)
 }
 
+func TestCreateGithub_Run_FourEyesViolation(t *testing.T) {
+	origGetPlainGitLog := getPlainGitLogFromPreviousBuild
+	origGetLastBuildLink := getLastBuildLink
+	origNewVcsClient := newVcsClient
+	defer func() {
+		getPlainGitLogFromPreviousBuild = origGetPlainGitLog
+		getLastBuildLink = origGetLastBuildLink
+		newVcsClient = origNewVcsClient
+	}()
+
+	t.Setenv("GITHUB_ACTIONS", "true")
+	t.Setenv("GITHUB_REPOSITORY", "acme/repo")
+	t.Setenv("JF_GIT_TOKEN", "t")
+	t.Setenv(coreutils.SummaryOutputDirPathEnv, t.TempDir())
+	key, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	assert.NoError(t, err)
+
+	getPlainGitLogFromPreviousBuild = func(*config.ServerDetails, *build.BuildConfiguration, artifactoryUtils.GitLogDetails) (string, error) {
+		return `'{"commit":"c1","subject":"s"}'`, nil
+	}
+	getLastBuildLink = func(*config.ServerDetails, *build.BuildConfiguration) (string, error) {
+		return "http://link", nil
+	}
+	newVcsClient = func(token string) (pullRequestClient, error) {
+		return &mockPullRequestClient{
+			prInfo:  []vcsclient.PullRequestInfo{{ID: 7, Author: "dev"}},
+			reviews: []vcsclient.PullRequestReviewDetails{{Reviewer: "dev", State: "APPROVED"}},
+		}, nil
+	}
+
+	upl := &captureUploaderGH{}
+	c := &createGitHubEvidence{
+		createEvidenceBase: createEvidenceBase{
+			serverDetails:     &config.ServerDetails{User: "u"},
+			key:               string(key),
+			artifactoryClient: &fakeArtMgrGH{},
+			uploader:          upl,
+			flagType:          FlagTypeCommitterReviewer,
+		},
+		buildName:   "b",
+		buildNumber: "1",
+	}
+	c.applyOptions([]EvidenceOption{WithFourEyesPolicy(foureyes.Policy{RequiredApprovals: 1})})
+
+	err = c.Run()
+	assert.EqualError(t, err, "four-eyes policy violated by 1 of 1 commits: c1 (0 of 1 required approvals)")
+	// The evidence recording the violation is uploaded before the command fails.
+	assert.NotNil(t, upl.body)
+	payload := string(decodeEnvelopePayload(t, upl.body))
+	assert.Contains(t, payload, `"pr_author":"dev"`)
+	assert.Contains(t, payload, `"four_eyes":{"approvals":0,"reason":"0 of 1 required approvals","required":1,"satisfied":false}`)
+	assert.Contains(t, payload, "# Four-eyes policy: FAILED")
+}
+
 func TestCreateGithub_Run_NotGhActions_Error(t *testing.T) {
 	_ = os.Unsetenv("GITHUB_ACTIONS")
 	c := &createGitHubEvidence{}
@@ -0,0 +1,92 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/jfrog/froggit-go/vcsutils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/xanzy/go-gitlab"
+)
+
+const glDefaultPredicateType = "https://jfrog.com/evidence/gl-committer/v1"
+
+const reviewStateApproved = "APPROVED"
+
+var newGitLabVcsClient = func(token, apiEndpoint string) (pullRequestClient, error) {
+	client, err := vcsclient.NewClientBuilder(vcsutils.GitLab).ApiEndpoint(apiEndpoint).Token(token).Build()
+	if err != nil {
+		return nil, err
+	}
+	options := []gitlab.ClientOptionFunc{}
+	if apiEndpoint != "" {
+		options = append(options, gitlab.WithBaseURL(apiEndpoint))
+	}
+	apiClient, err := gitlab.NewClient(token, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &gitLabClient{pullRequestClient: client, approvals: apiClient.MergeRequestApprovals}, nil
+}
+
+// mergeRequestApprovalsGetter is the part of the GitLab merge request approvals API we use.
+type mergeRequestApprovalsGetter interface {
+	GetConfiguration(pid interface{}, mr int, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequestApprovals, *gitlab.Response, error)
+}
+
+// gitLabClient lists merge requests through froggit, but reads reviews from the merge request approvals API.
+// froggit reports every merge request note as a review and hides whether it is a system note, so a user
+// comment reading "approved this merge request" would be indistinguishable from a real approval.
+type gitLabClient struct {
+	pullRequestClient
+	approvals mergeRequestApprovalsGetter
+}
+
+// ListPullRequestReviews returns one approved review for each user currently approving the merge request.
+func (c *gitLabClient) ListPullRequestReviews(ctx context.Context, owner, repository string, mergeRequestId int) ([]vcsclient.PullRequestReviewDetails, error) {
+	approvals, _, err := c.approvals.GetConfiguration(owner+"/"+repository, mergeRequestId, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	reviews := []vcsclient.PullRequestReviewDetails{}
+	for _, approver := range approvals.ApprovedBy {
+		if approver == nil || approver.User == nil {
+			continue
+		}
+		reviews = append(reviews, vcsclient.PullRequestReviewDetails{
+			Reviewer: approver.User.Username,
+			State:    reviewStateApproved,
+		})
+	}
+	return reviews, nil
+}
+
+func gitLabProvider() vcsProvider {
+	return vcsProvider{
+		ciName:        "GitLab CI",
+		isRunning:     utils.IsRunningUnderGitLabCI,
+		predicateType: glDefaultPredicateType,
+		repository:    gitLabRepositoryDetails,
+		newClient: func(token string) (pullRequestClient, error) {
+			// CI_API_V4_URL points to the instance running the pipeline, including self-managed ones.
+			return newGitLabVcsClient(token, os.Getenv("CI_API_V4_URL"))
+		},
+		reviews: listPullRequestReviews,
+	}
+}
+
+// gitLabRepositoryDetails splits CI_PROJECT_PATH ("group/subgroup/project") into the namespace and the project.
+func gitLabRepositoryDetails() (string, string, error) {
+	projectPath := os.Getenv("CI_PROJECT_PATH")
+	if projectPath == "" {
+		return "", "", fmt.Errorf("CI_PROJECT_PATH environment variable is not set")
+	}
+	separator := strings.LastIndex(projectPath, "/")
+	if separator <= 0 || separator == len(projectPath)-1 {
+		return "", "", fmt.Errorf("invalid CI_PROJECT_PATH format: %s", projectPath)
+	}
+	return projectPath[:separator], projectPath[separator+1:], nil
+}
@@ -0,0 +1,168 @@
+package create
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	artifactoryUtils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGithub_vcsProvider(t *testing.T) {
+	gh := (&createGitHubEvidence{createEvidenceBase: createEvidenceBase{flagType: FlagTypeCommitterReviewer}}).vcsProvider()
+	assert.Equal(t, ghDefaultPredicateType, gh.predicateType)
+	assert.Equal(t, "GitHub Actions", gh.ciName)
+
+	gl := (&createGitHubEvidence{createEvidenceBase: createEvidenceBase{flagType: FlagTypeGitLabCommitterReviewer}}).vcsProvider()
+	assert.Equal(t, glDefaultPredicateType, gl.predicateType)
+	assert.Equal(t, "GitLab CI", gl.ciName)
+}
+
+func TestGitLabRepositoryDetails(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectPath string
+		owner       string
+		repository  string
+		expectError bool
+	}{
+		{"project", "acme/repo", "acme", "repo", false},
+		{"nested group", "acme/platform/repo", "acme/platform", "repo", false},
+		{"missing", "", "", "", true},
+		{"no group", "repo", "", "", true},
+		{"trailing slash", "acme/", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CI_PROJECT_PATH", tt.projectPath)
+			owner, repository, err := gitLabRepositoryDetails()
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.owner, owner)
+			assert.Equal(t, tt.repository, repository)
+		})
+	}
+}
+
+func TestGitLabClient_ListPullRequestReviews_UsesApprovalsApi(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/7/approvals"):
+			_, _ = w.Write([]byte(`{"approved_by":[{"user":{"username":"bob"}},{"user":{"username":"carol"}}]}`))
+		case strings.HasSuffix(r.URL.Path, "/merge_requests/7/notes"):
+			// A user comment with the approval text must not count as an approval.
+			_, _ = w.Write([]byte(`[{"id":1,"body":"approved this merge request","system":false,"author":{"username":"mallory"}}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := newGitLabVcsClient("t", server.URL+"/api/v4")
+	require.NoError(t, err)
+	reviews, err := gitLabProvider().reviews(client, "acme", "repo", 7)
+	require.NoError(t, err)
+	assert.Equal(t, []vcsclient.PullRequestReviewDetails{
+		{Reviewer: "bob", State: "APPROVED"},
+		{Reviewer: "carol", State: "APPROVED"},
+	}, reviews)
+}
+
+func TestGitLabClient_ListPullRequestReviews_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client, err := newGitLabVcsClient("t", server.URL+"/api/v4")
+	require.NoError(t, err)
+	_, err = client.ListPullRequestReviews(context.Background(), "acme", "repo", 7)
+	assert.Error(t, err)
+}
+
+func TestCreateGithub_Run_NotGitLabCI_Error(t *testing.T) {
+	t.Setenv("GITLAB_CI", "")
+	c := &createGitHubEvidence{createEvidenceBase: createEvidenceBase{flagType: FlagTypeGitLabCommitterReviewer}}
+	assert.EqualError(t, c.Run(), "this command is intended to be run under GitLab CI")
+}
+
+func TestCreateGithub_Run_GitLab_Success_WithInjectedDeps(t *testing.T) {
+	origGetPlainGitLog := getPlainGitLogFromPreviousBuild
+	origGetLastBuildLink := getLastBuildLink
+	origNewGitLabVcsClient := newGitLabVcsClient
+	defer func() {
+		getPlainGitLogFromPreviousBuild = origGetPlainGitLog
+		getLastBuildLink = origGetLastBuildLink
+		newGitLabVcsClient = origNewGitLabVcsClient
+	}()
+
+	t.Setenv("GITLAB_CI", "true")
+	t.Setenv("CI_PROJECT_PATH", "acme/repo")
+	t.Setenv("CI_API_V4_URL", "https://gitlab.example.com/api/v4")
+	t.Setenv("JF_GIT_TOKEN", "t")
+	t.Setenv(coreutils.SummaryOutputDirPathEnv, t.TempDir())
+
+	pred := filepath.Join(t.TempDir(), "p.json")
+	require.NoError(t, os.WriteFile(pred, []byte(`{"k":1}`), 0600))
+	key, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	require.NoError(t, err)
+
+	getPlainGitLogFromPreviousBuild = func(*config.ServerDetails, *build.BuildConfiguration, artifactoryUtils.GitLogDetails) (string, error) {
+		return `'{"commit":"c1","subject":"s"}'`, nil
+	}
+	getLastBuildLink = func(*config.ServerDetails, *build.BuildConfiguration) (string, error) {
+		return "http://link", nil
+	}
+	var apiEndpoint string
+	newGitLabVcsClient = func(token, endpoint string) (pullRequestClient, error) {
+		apiEndpoint = endpoint
+		return &mockPullRequestClient{
+			prInfo:  []vcsclient.PullRequestInfo{{ID: 7}},
+			reviews: []vcsclient.PullRequestReviewDetails{{Reviewer: "r", State: "APPROVED"}},
+		}, nil
+	}
+
+	upl := &captureUploaderGH{}
+	c := &createGitHubEvidence{
+		createEvidenceBase: createEvidenceBase{
+			serverDetails:     &config.ServerDetails{User: "u"},
+			predicateFilePath: pred,
+			predicateType:     "t",
+			key:               string(key),
+			artifactoryClient: &fakeArtMgrGH{},
+			uploader:          upl,
+			flagType:          FlagTypeGitLabCommitterReviewer,
+		},
+		project:     "proj",
+		buildName:   "b",
+		buildNumber: "1",
+	}
+
+	require.NoError(t, c.Run())
+	assert.Equal(t, "https://gitlab.example.com/api/v4", apiEndpoint)
+
+	var statement struct {
+		PredicateType string          `json:"predicateType"`
+		Predicate     json.RawMessage `json:"predicate"`
+	}
+	require.NoError(t, json.Unmarshal(decodeEnvelopePayload(t, upl.body), &statement))
+	assert.Equal(t, glDefaultPredicateType, statement.PredicateType)
+	assert.Contains(t, string(statement.Predicate), `"Reviewer":"r"`)
+	assert.Contains(t, string(statement.Predicate), `"State":"APPROVED"`)
+}
@@ -0,0 +1,82 @@
+package create
+
+import (
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/intoto"
+)
+
+// buildIntegrationStatement builds the statement for subject from the content generated by the integration,
+// either by adjusting the statement it resolved or by wrapping the predicate it generated.
+func (c *createEvidenceBase) buildIntegrationStatement(integration integrations.Integration, subject, subjectSha256 string, attachments []*statementAttachment) ([]byte, error) {
+	result, err := c.resolveIntegration(integration)
+	if err != nil {
+		return nil, err
+	}
+	if providerId := integration.ProviderId(); providerId != "" {
+		c.providerId = providerId
+	}
+	var statementJson []byte
+	if result.Statement != nil {
+		statementJson, err = c.adjustResolvedStatement(result.Statement, subject, subjectSha256, attachments)
+		if c.predicateType == "" {
+			c.predicateType = integration.DefaultPredicateType()
+		}
+	} else {
+		c.predicateType = result.PredicateType
+		if c.predicateType == "" {
+			c.predicateType = integration.DefaultPredicateType()
+		}
+		statementJson, err = c.buildIntotoStatementJsonWithPredicateAndPredicateType(subject, subjectSha256, c.predicateType, result.Predicate, attachments)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Markdown) > 0 && c.markdownFilePath == "" {
+		return setStatementField(statementJson, "markdown", string(result.Markdown))
+	}
+	return statementJson, nil
+}
+
+// resolveIntegration resolves the integration content once, so evidence created for several subjects shares it.
+func (c *createEvidenceBase) resolveIntegration(integration integrations.Integration) (*integrations.Result, error) {
+	if c.integrationResult != nil {
+		return c.integrationResult, nil
+	}
+	request := c.integrationRequest
+	result, err := integration.Resolve(&request)
+	if err != nil {
+		return nil, err
+	}
+	c.integrationResult = result
+	return result, nil
+}
+
+// adjustResolvedStatement sets the subject, stage, attachments and expiry of a statement resolved by an integration.
+func (c *createEvidenceBase) adjustResolvedStatement(statement []byte, subject, subjectSha256 string, attachments []*statementAttachment) ([]byte, error) {
+	servicesManager, err := c.createArtifactoryClient()
+	if err != nil {
+		return nil, err
+	}
+
+	sha256, err := c.resolveSubjectSha256(servicesManager, subject, subjectSha256)
+	if err != nil {
+		return nil, err
+	}
+
+	extendedStatement, err := adjustIntegrationStatement(statement, sha256, c.stage, toStatementAttachmentMeta(attachments))
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := c.resolveExpiresAt(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !expiresAt.IsZero() {
+		if extendedStatement, err = setStatementField(extendedStatement, "expiresAt", intoto.FormatTime(expiresAt)); err != nil {
+			return nil, err
+		}
+	}
+	return extendedStatement, nil
+}
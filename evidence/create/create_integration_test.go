@@ -0,0 +1,76 @@
+package create
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/intoto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIntegration struct {
+	result  *integrations.Result
+	resolve int
+}
+
+func (f *fakeIntegration) Name() string                                  { return "fake" }
+func (f *fakeIntegration) Flags() []string                               { return nil }
+func (f *fakeIntegration) ValidateFlags(_ integrations.FlagReader) error { return nil }
+func (f *fakeIntegration) DefaultPredicateType() string                  { return "https://example.com/fake/v1" }
+func (f *fakeIntegration) ProviderId() string                            { return "fake-provider" }
+
+func (f *fakeIntegration) Resolve(_ *integrations.Request) (*integrations.Result, error) {
+	f.resolve++
+	return f.result, nil
+}
+
+func TestBuildIntegrationStatement_Predicate(t *testing.T) {
+	integration := &fakeIntegration{result: &integrations.Result{Predicate: []byte(`{"a":1}`), Markdown: []byte("# Generated")}}
+	c := &createEvidenceBase{serverDetails: &config.ServerDetails{User: "u"}, artifactoryClient: createFileInfoOnlyMock("sha"), providerId: "user"}
+
+	for i := 0; i < 2; i++ {
+		statementJson, err := c.buildIntegrationStatement(integration, "r/p", "", nil)
+		require.NoError(t, err)
+		var statement intoto.Statement
+		require.NoError(t, json.Unmarshal(statementJson, &statement))
+		assert.Equal(t, "https://example.com/fake/v1", statement.PredicateType)
+		assert.Equal(t, "# Generated", statement.Markdown)
+		assert.Equal(t, "sha", statement.Subject[0].Digest.Sha256)
+	}
+	assert.Equal(t, 1, integration.resolve)
+	assert.Equal(t, "fake-provider", c.providerId)
+	assert.Equal(t, "https://example.com/fake/v1", c.predicateType)
+}
+
+type fakeAttachmentIntegration struct {
+	fakeIntegration
+	path    string
+	removed bool
+}
+
+func (f *fakeAttachmentIntegration) AttachmentRequested(_ integrations.FlagReader) bool { return true }
+
+func (f *fakeAttachmentIntegration) Attachment(_ *integrations.Request) (string, func(), error) {
+	return f.path, func() { f.removed = true }, nil
+}
+
+func TestResolveAttachment_IntegrationAttachment(t *testing.T) {
+	integration := &fakeAttachmentIntegration{path: filepath.Join(t.TempDir(), "missing.zip")}
+	stubIntegration(t, integration)
+	c := &createEvidenceBase{integration: "fake", attachArtifactoryTempPath: "repo/tmp/"}
+
+	_, _, err := c.resolveAttachments(&SimpleMockServicesManager{})
+	assert.ErrorContains(t, err, integration.path)
+	assert.True(t, integration.removed, "the integration file should be removed when the upload fails")
+
+	integration.path = ""
+	c = &createEvidenceBase{integration: "fake"}
+	attachments, cleanup, err := c.resolveAttachments(&SimpleMockServicesManager{})
+	require.NoError(t, err)
+	assert.Nil(t, attachments)
+	assert.Nil(t, cleanup)
+}
@@ -20,8 +20,8 @@ type createEvidencePackage struct {
 }
 
 func NewCreateEvidencePackage(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, packageName,
-	packageVersion, packageRepoName, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string) evidence.Command {
-	return &createEvidencePackage{
+	packageVersion, packageRepoName, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string, opts ...EvidenceOption) evidence.Command {
+	cmd := &createEvidencePackage{
 		createEvidenceBase: createEvidenceBase{
 			serverDetails:             serverDetails,
 			predicateFilePath:         predicateFilePath,
@@ -37,6 +37,8 @@ func NewCreateEvidencePackage(serverDetails *config.ServerDetails, predicateFile
 		},
 		packageService: evidence.NewPackageService(packageName, packageVersion, packageRepoName),
 	}
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 func (c *createEvidencePackage) CommandName() string {
@@ -54,7 +56,7 @@ func (c *createEvidencePackage) Run() error {
 		return err
 	}
 
-	attachment, cleanup, err := c.resolveAttachment(artifactoryClient)
+	attachments, cleanup, err := c.resolveAttachments(artifactoryClient)
 	if err != nil {
 		return err
 	}
@@ -83,11 +85,11 @@ func (c *createEvidencePackage) Run() error {
 	if err != nil {
 		return err
 	}
-	envelope, err := c.createEnvelope(leadArtifactPath, leadArtifactChecksum, attachment)
+	envelope, err := c.createEnvelope(leadArtifactPath, leadArtifactChecksum, attachments)
 	if err != nil {
 		return err
 	}
-	response, err := c.uploadEvidence(envelope, leadArtifactPath, attachment)
+	response, err := c.uploadEvidence(envelope, leadArtifactPath, attachments)
 	if err != nil {
 		return err
 	}
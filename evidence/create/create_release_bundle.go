@@ -23,8 +23,8 @@ type createEvidenceReleaseBundle struct {
 }
 
 func NewCreateEvidenceReleaseBundle(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, project, releaseBundle,
-	releaseBundleVersion, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string) evidence.Command {
-	return &createEvidenceReleaseBundle{
+	releaseBundleVersion, providerId, integration, attachLocalPath, attachArtifactoryTempPath, attachArtifactoryPath string, opts ...EvidenceOption) evidence.Command {
+	cmd := &createEvidenceReleaseBundle{
 		createEvidenceBase: createEvidenceBase{
 			serverDetails:             serverDetails,
 			predicateFilePath:         predicateFilePath,
@@ -43,6 +43,8 @@ func NewCreateEvidenceReleaseBundle(serverDetails *config.ServerDetails, predica
 		releaseBundle:        releaseBundle,
 		releaseBundleVersion: releaseBundleVersion,
 	}
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 func (c *createEvidenceReleaseBundle) CommandName() string {
@@ -59,7 +61,7 @@ func (c *createEvidenceReleaseBundle) Run() error {
 		log.Error("failed to create Artifactory client", err)
 		return err
 	}
-	attachment, cleanup, err := c.resolveAttachment(artifactoryClient)
+	attachments, cleanup, err := c.resolveAttachments(artifactoryClient)
 	if err != nil {
 		return err
 	}
@@ -71,11 +73,11 @@ func (c *createEvidenceReleaseBundle) Run() error {
 	if err != nil {
 		return err
 	}
-	envelope, err := c.createEnvelope(subject, sha256, attachment)
+	envelope, err := c.createEnvelope(subject, sha256, attachments)
 	if err != nil {
 		return err
 	}
-	response, err := c.uploadEvidence(envelope, subject, attachment)
+	response, err := c.uploadEvidence(envelope, subject, attachments)
 	if err != nil {
 		return err
 	}
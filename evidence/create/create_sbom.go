@@ -0,0 +1,71 @@
+package create
+
+import (
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sbom"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// isSbomPredicate reports whether the predicate is an SBOM whose format is detected with --predicate-type auto.
+func (c *createEvidenceBase) isSbomPredicate() bool {
+	return c.sbomDocument != nil || strings.EqualFold(c.predicateType, sbom.PredicateTypeAuto)
+}
+
+// resolveSbomDocument detects and validates the SBOM once, so evidence created for several subjects shares it.
+func (c *createEvidenceBase) resolveSbomDocument(predicate []byte) (*sbom.Document, error) {
+	if c.sbomDocument != nil {
+		return c.sbomDocument, nil
+	}
+	if predicate == nil {
+		var err error
+		if predicate, err = os.ReadFile(c.predicateFilePath); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	}
+	document, err := sbom.Parse(predicate)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("--%s %s: %s", flags.PredicateType, sbom.PredicateTypeAuto, err.Error())
+	}
+	log.Info("Detected", document.Format, document.Version, "SBOM with", document.Summary.Components, "components")
+	c.sbomDocument = document
+	if c.sbomAsAttachment {
+		c.predicateType = sbom.ReferencePredicateType
+	} else {
+		c.predicateType = document.PredicateType
+	}
+	return document, nil
+}
+
+// resolveSbomPredicate returns the predicate recorded for the SBOM, either the document itself or, when it is
+// stored as an attachment, a reference to it, along with the generated markdown summary.
+func (c *createEvidenceBase) resolveSbomPredicate(predicate []byte, attachments []*statementAttachment) ([]byte, []byte, error) {
+	document, err := c.resolveSbomDocument(predicate)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !c.sbomAsAttachment {
+		return predicate, document.Markdown(), nil
+	}
+	attachment := findAttachment(attachments, document.Sha256)
+	if attachment == nil {
+		return nil, nil, errorutils.CheckErrorf("the uploaded SBOM attachment does not match --%s", flags.Predicate)
+	}
+	reference, err := document.Reference(attachment.Name)
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	return reference, document.Markdown(), nil
+}
+
+// uploadSbomAttachment validates the SBOM before uploading it as the evidence attachment.
+func (c *createEvidenceBase) uploadSbomAttachment(client artifactory.ArtifactoryServicesManager) (*statementAttachment, func(), error) {
+	if _, err := c.resolveSbomDocument(nil); err != nil {
+		return nil, nil, err
+	}
+	return c.uploadGeneratedAttachment(client, c.predicateFilePath, nil)
+}
@@ -0,0 +1,118 @@
+package create
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/intoto"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sbom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCycloneDX = `{"bomFormat": "CycloneDX", "specVersion": "1.6",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app"}},
+  "components": [{"bom-ref": "lib", "type": "library", "name": "lib", "version": "1.0", "licenses": [{"license": {"id": "MIT"}}]}],
+  "dependencies": [{"ref": "app", "dependsOn": ["lib"]}]}`
+
+func writeSbom(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "bom.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func unmarshalStatement(t *testing.T, statementJson []byte) intoto.Statement {
+	var statement intoto.Statement
+	require.NoError(t, json.Unmarshal(statementJson, &statement))
+	return statement
+}
+
+func TestBuildIntotoStatementJson_SbomAutoPredicateType(t *testing.T) {
+	c := &createEvidenceBase{serverDetails: &config.ServerDetails{User: "u"}, predicateFilePath: writeSbom(t, testCycloneDX), predicateType: "AUTO", artifactoryClient: createFileInfoOnlyMock("sha")}
+
+	for i := 0; i < 2; i++ {
+		statementJson, err := c.buildIntotoStatementJson("r/p", "", nil)
+		require.NoError(t, err)
+		statement := unmarshalStatement(t, statementJson)
+		assert.Equal(t, "https://cyclonedx.org/bom/v1.6", statement.PredicateType)
+		assert.Contains(t, string(statement.Predicate), `"bomFormat"`)
+		assert.Contains(t, statement.Markdown, "- `lib@1.0`")
+	}
+	assert.Equal(t, "https://cyclonedx.org/bom/v1.6", c.predicateType)
+}
+
+func TestBuildIntotoStatementJson_SbomKeepsUserMarkdown(t *testing.T) {
+	markdown := filepath.Join(t.TempDir(), "notes.md")
+	require.NoError(t, os.WriteFile(markdown, []byte("# Notes"), 0600))
+	c := &createEvidenceBase{serverDetails: &config.ServerDetails{User: "u"}, predicateFilePath: writeSbom(t, testCycloneDX), predicateType: sbom.PredicateTypeAuto,
+		markdownFilePath: markdown, artifactoryClient: createFileInfoOnlyMock("sha")}
+	statementJson, err := c.buildIntotoStatementJson("r/p", "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "# Notes", unmarshalStatement(t, statementJson).Markdown)
+}
+
+func TestBuildIntotoStatementJson_SbomAsAttachmentReference(t *testing.T) {
+	c := &createEvidenceBase{serverDetails: &config.ServerDetails{User: "u"}, predicateFilePath: writeSbom(t, testCycloneDX), predicateType: sbom.PredicateTypeAuto,
+		sbomAsAttachment: true, artifactoryClient: createFileInfoOnlyMock("sha")}
+	document, err := c.resolveSbomDocument(nil)
+	require.NoError(t, err)
+
+	attachment := &statementAttachment{Repository: "tmp", Path: "bom.json", Name: "bom.json", Sha256: document.Sha256}
+	statementJson, err := c.buildIntotoStatementJson("r/p", "", []*statementAttachment{attachment})
+	require.NoError(t, err)
+	statement := unmarshalStatement(t, statementJson)
+	assert.Equal(t, sbom.ReferencePredicateType, statement.PredicateType)
+	var reference sbom.Reference
+	require.NoError(t, json.Unmarshal(statement.Predicate, &reference))
+	assert.Equal(t, "https://cyclonedx.org/bom/v1.6", reference.PredicateType)
+	assert.Equal(t, document.Sha256, reference.Digest["sha256"])
+	assert.Equal(t, 1, reference.Summary.Components)
+	assert.NotEmpty(t, statement.Markdown)
+
+	attachment.Sha256 = "other"
+	_, err = c.buildIntotoStatementJson("r/p", "", []*statementAttachment{attachment})
+	assert.ErrorContains(t, err, "the uploaded SBOM attachment does not match --predicate")
+}
+
+func TestResolveAttachment_InvalidSbomNotUploaded(t *testing.T) {
+	versionCalled := false
+	mock := &SimpleMockServicesManager{GetVersionFunc: func() (string, error) {
+		versionCalled = true
+		return "7.999.0", nil
+	}}
+	c := &createEvidenceBase{predicateFilePath: writeSbom(t, `{"bomFormat": "CycloneDX", "specVersion": "0.9"}`), predicateType: sbom.PredicateTypeAuto,
+		sbomAsAttachment: true, attachArtifactoryTempPath: "tmp/"}
+	_, _, err := c.resolveAttachments(mock)
+	assert.ErrorContains(t, err, "--predicate-type auto: invalid CycloneDX document")
+	assert.False(t, versionCalled, "an invalid SBOM should fail before the upload starts")
+}
+
+func TestResolveAttachments_SbomWithUserAttachments(t *testing.T) {
+	report := filepath.Join(t.TempDir(), "report.html")
+	require.NoError(t, os.WriteFile(report, []byte("<html/>"), 0o600))
+	predicate := writeSbom(t, testCycloneDX)
+	mock := newUploadRecordingServicesManager()
+	c := &createEvidenceBase{predicateFilePath: predicate, predicateType: sbom.PredicateTypeAuto, sbomAsAttachment: true,
+		attachLocalPath: report, attachArtifactoryPath: "repo/existing/scan.sarif", attachArtifactoryTempPath: "tmp/evidence/"}
+
+	attachments, cleanup, err := c.resolveAttachments(mock)
+	require.NoError(t, err)
+	require.NotNil(t, cleanup)
+	assert.Equal(t, []string{filepath.Base(predicate), "scan.sarif", "report.html"}, attachmentNames(attachments))
+	assert.Equal(t, map[string]string{"tmp/evidence/" + filepath.Base(predicate): predicate, "tmp/evidence/report.html": report}, mock.uploads)
+}
+
+func TestResolveAttachments_SbomWithUserAttachmentNeedsDirectoryTarget(t *testing.T) {
+	report := filepath.Join(t.TempDir(), "report.html")
+	require.NoError(t, os.WriteFile(report, []byte("<html/>"), 0o600))
+	mock := newUploadRecordingServicesManager()
+	c := &createEvidenceBase{predicateFilePath: writeSbom(t, testCycloneDX), predicateType: sbom.PredicateTypeAuto, sbomAsAttachment: true,
+		attachLocalPath: report, attachArtifactoryTempPath: "tmp/evidence/bom.json"}
+
+	_, _, err := c.resolveAttachments(mock)
+	assert.ErrorContains(t, err, "must be a directory (ending with '/') to upload 2 attachments")
+	assert.Equal(t, []string{"tmp/evidence/bom.json"}, mock.deleted, "the uploaded SBOM should be removed")
+}
@@ -0,0 +1,100 @@
+package create
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/intoto"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sigstore"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	protodsse "github.com/sigstore/protobuf-specs/gen/pb-go/dsse"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/stretchr/testify/assert"
+)
+
+func stubKeyless(t *testing.T, token string, tokenErr error) *sigstore.KeylessOptions {
+	captured := &sigstore.KeylessOptions{}
+	origResolve, origSign := resolveIdentityToken, signKeyless
+	resolveIdentityToken = func(identityToken string) (string, error) {
+		if tokenErr != nil {
+			return "", tokenErr
+		}
+		if identityToken != "" {
+			return identityToken, nil
+		}
+		return token, nil
+	}
+	signKeyless = func(statement []byte, payloadType string, opts sigstore.KeylessOptions) (*bundle.Bundle, error) {
+		*captured = opts
+		return &bundle.Bundle{Bundle: &protobundle.Bundle{
+			MediaType: "application/vnd.dev.sigstore.bundle.v0.3+json",
+			Content: &protobundle.Bundle_DsseEnvelope{DsseEnvelope: &protodsse.Envelope{
+				Payload:     statement,
+				PayloadType: payloadType,
+				Signatures:  []*protodsse.Signature{{Sig: []byte("sig")}},
+			}},
+		}}, nil
+	}
+	t.Cleanup(func() { resolveIdentityToken, signKeyless = origResolve, origSign })
+	return captured
+}
+
+func TestCreateEnvelope_Keyless_ProducesSigstoreBundle(t *testing.T) {
+	dir := t.TempDir()
+	pred := filepath.Join(dir, "predicate.json")
+	assert.NoError(t, os.WriteFile(pred, []byte(`{"k":"v"}`), 0600))
+	captured := stubKeyless(t, "", nil)
+
+	c := &createEvidenceBase{
+		serverDetails:     &config.ServerDetails{User: "alice"},
+		predicateFilePath: pred,
+		predicateType:     "test-type",
+		artifactoryClient: createFileInfoOnlyMock("abc123"),
+	}
+	WithKeyless("token-from-flag", "https://fulcio.example.com", "https://rekor.example.com")(c)
+
+	payload, err := c.createEnvelope("repo/path/name", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "token-from-flag", captured.IdentityToken)
+	assert.Equal(t, "https://fulcio.example.com", captured.FulcioURL)
+	assert.Equal(t, "https://rekor.example.com", captured.RekorURL)
+
+	var b struct {
+		DsseEnvelope struct {
+			Payload     []byte `json:"payload"`
+			PayloadType string `json:"payloadType"`
+		} `json:"dsseEnvelope"`
+	}
+	assert.NoError(t, json.Unmarshal(payload, &b))
+	assert.Equal(t, intoto.PayloadType, b.DsseEnvelope.PayloadType)
+	var st intoto.Statement
+	assert.NoError(t, json.Unmarshal(b.DsseEnvelope.Payload, &st))
+	assert.Equal(t, "test-type", st.PredicateType)
+	assert.Equal(t, "abc123", st.Subject[0].Digest.Sha256)
+}
+
+func TestCreateEnvelope_Keyless_EndpointsFromEnv(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("EVIDENCE_SIGSTORE_FULCIO_URL", "https://fulcio.internal")
+	t.Setenv("EVIDENCE_SIGSTORE_REKOR_URL", "https://rekor.internal")
+	captured := stubKeyless(t, "ambient-token", nil)
+
+	c := &createEvidenceBase{keyless: true}
+	_, err := c.signStatement([]byte(`{}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "ambient-token", captured.IdentityToken)
+	assert.Equal(t, "https://fulcio.internal", captured.FulcioURL)
+	assert.Equal(t, "https://rekor.internal", captured.RekorURL)
+}
+
+func TestCreateEnvelope_Keyless_TokenError(t *testing.T) {
+	stubKeyless(t, "", errors.New("no OIDC identity token available"))
+
+	c := &createEvidenceBase{keyless: true}
+	_, err := c.signStatement([]byte(`{}`))
+	assert.ErrorContains(t, err, "no OIDC identity token available")
+}
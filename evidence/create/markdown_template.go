@@ -0,0 +1,58 @@
+package create
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/mdtemplate"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// applyMarkdownTemplate renders --markdown-template into the statement markdown. Without it, a statement that
+// has no markdown yet gets the built-in template of its predicate type, if there is one.
+func (c *createEvidenceBase) applyMarkdownTemplate(statementJson []byte, subject string) ([]byte, error) {
+	if c.markdownTemplatePath != "" {
+		source, err := os.ReadFile(c.markdownTemplatePath)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed to read markdown template '%s': %s", c.markdownTemplatePath, err.Error())
+		}
+		markdown, err := renderMarkdownTemplate(c.markdownTemplatePath, source, statementJson, subject)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		return setStatementField(statementJson, "markdown", string(markdown))
+	}
+	if c.markdownFilePath != "" {
+		return statementJson, nil
+	}
+	var existing struct {
+		Markdown      string `json:"markdown"`
+		PredicateType string `json:"predicateType"`
+	}
+	if err := json.Unmarshal(statementJson, &existing); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if existing.Markdown != "" {
+		return statementJson, nil
+	}
+	source, ok := mdtemplate.Builtin(existing.PredicateType)
+	if !ok {
+		return statementJson, nil
+	}
+	markdown, err := renderMarkdownTemplate(existing.PredicateType, source, statementJson, subject)
+	if err != nil {
+		// The built-in templates expect the predicates the CLI generates; others are left without markdown.
+		log.Warn("Failed to render the default markdown:", err.Error())
+		return statementJson, nil
+	}
+	return setStatementField(statementJson, "markdown", string(markdown))
+}
+
+func renderMarkdownTemplate(name string, source, statementJson []byte, subject string) ([]byte, error) {
+	data, err := mdtemplate.DataFromStatement(statementJson, subject)
+	if err != nil {
+		return nil, err
+	}
+	return mdtemplate.Render(name, source, data)
+}
@@ -0,0 +1,66 @@
+package create
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statementMarkdown(t *testing.T, statementJson []byte) string {
+	var statement struct {
+		Markdown string `json:"markdown"`
+	}
+	require.NoError(t, json.Unmarshal(statementJson, &statement))
+	return statement.Markdown
+}
+
+func TestApplyMarkdownTemplate_File(t *testing.T) {
+	templatePath := filepath.Join(t.TempDir(), "summary.md.tmpl")
+	require.NoError(t, os.WriteFile(templatePath, []byte(`# {{ get .Predicate "tool" }} on {{ .Subject.Path }} ({{ shortSha .Subject.Sha256 }})`), 0o600))
+	statement := []byte(`{"predicateType":"https://example.com/scan/v1","predicate":{"tool":"scanner"},"subject":[{"digest":{"sha256":"0123456789abcdef"}}],"markdown":"generated"}`)
+
+	c := &createEvidenceBase{markdownTemplatePath: templatePath}
+	result, err := c.applyMarkdownTemplate(statement, "generic-local/app.tgz")
+	require.NoError(t, err)
+	assert.Equal(t, "# scanner on generic-local/app.tgz (0123456789ab)", statementMarkdown(t, result))
+}
+
+func TestApplyMarkdownTemplate_FileErrors(t *testing.T) {
+	statement := []byte(`{"predicateType":"https://example.com/scan/v1","predicate":{}}`)
+	c := &createEvidenceBase{markdownTemplatePath: filepath.Join(t.TempDir(), "missing.tmpl")}
+	_, err := c.applyMarkdownTemplate(statement, "repo/a")
+	assert.ErrorContains(t, err, "failed to read markdown template")
+
+	templatePath := filepath.Join(t.TempDir(), "broken.tmpl")
+	require.NoError(t, os.WriteFile(templatePath, []byte("{{ end }}"), 0o600))
+	c.markdownTemplatePath = templatePath
+	_, err = c.applyMarkdownTemplate(statement, "repo/a")
+	assert.ErrorContains(t, err, "invalid markdown template")
+}
+
+func TestApplyMarkdownTemplate_Builtin(t *testing.T) {
+	statement := []byte(`{"predicateType":"https://slsa.dev/provenance/v1","predicate":{"buildDefinition":{"externalParameters":{"buildName":"b","buildNumber":"1"}},"runDetails":{"builder":{"id":"builder"}}}}`)
+
+	result, err := (&createEvidenceBase{}).applyMarkdownTemplate(statement, "repo/a")
+	require.NoError(t, err)
+	assert.Contains(t, statementMarkdown(t, result), "| Build | b 1 |")
+
+	withMarkdown, err := setStatementField(statement, "markdown", "custom")
+	require.NoError(t, err)
+	result, err = (&createEvidenceBase{}).applyMarkdownTemplate(withMarkdown, "repo/a")
+	require.NoError(t, err)
+	assert.Equal(t, "custom", statementMarkdown(t, result))
+
+	result, err = (&createEvidenceBase{markdownFilePath: "summary.md"}).applyMarkdownTemplate(statement, "repo/a")
+	require.NoError(t, err)
+	assert.Equal(t, statement, result)
+
+	other := []byte(`{"predicateType":"https://example.com/scan/v1","predicate":{}}`)
+	result, err = (&createEvidenceBase{}).applyMarkdownTemplate(other, "repo/a")
+	require.NoError(t, err)
+	assert.Equal(t, other, result)
+}
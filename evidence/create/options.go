@@ -0,0 +1,112 @@
+package create
+
+import (
+	"github.com/jfrog/jfrog-cli-evidence/evidence/foureyes"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/integrations"
+)
+
+// EvidenceOption customizes optional behaviour of the create commands that is shared
+// across all subject types but not required for plain key-based evidence creation.
+type EvidenceOption func(*createEvidenceBase)
+
+// WithKeyless signs the statement with an ephemeral key certified by Fulcio and logged in Rekor
+// instead of a user provided private key. Empty endpoints fall back to evidence.yml or the public-good instance.
+func WithKeyless(identityToken, fulcioURL, rekorURL string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.keyless = true
+		c.identityToken = identityToken
+		c.fulcioURL = fulcioURL
+		c.rekorURL = rekorURL
+	}
+}
+
+// WithTimestampAuthority requests an RFC 3161 timestamp over every signature from the given
+// timestamp authority. An empty URL falls back to evidence.yml / environment.
+func WithTimestampAuthority(tsaURL string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.tsaURL = tsaURL
+	}
+}
+
+// WithValidity records in the statement when the evidence expires, either relative to its creation
+// time (validFor, e.g. "90d") or as an absolute time (expiresAt, RFC 3339 or YYYY-MM-DD).
+func WithValidity(validFor, expiresAt string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.validFor = validFor
+		c.expiresAt = expiresAt
+	}
+}
+
+// WithProvenanceTarget selects whether SLSA provenance generated from build-info is attached to the
+// build itself ("build", the default) or to each artifact of the build ("artifacts").
+func WithProvenanceTarget(target string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.provenanceTarget = target
+	}
+}
+
+// WithIntegrationFlags gives the integration selected by --integration access to the command flags,
+// including the flags it owns.
+func WithIntegrationFlags(flagReader integrations.FlagReader) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.integrationRequest.Flags = flagReader
+	}
+}
+
+// WithSbomAsAttachment stores an SBOM given with --predicate-type auto as an attachment and records
+// a digest reference to it as the predicate, instead of inlining it in the DSSE payload.
+func WithSbomAsAttachment() EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.sbomAsAttachment = true
+	}
+}
+
+// WithGitRange selects the local clone and the commit range read by --type git-committer. Without fromRef
+// the commits since the previous build's revision are read; toRef defaults to HEAD.
+func WithGitRange(repoPath, fromRef, toRef string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.gitRepoPath = repoPath
+		c.gitFromRef = fromRef
+		c.gitToRef = toRef
+	}
+}
+
+// WithFourEyesPolicy evaluates committer/reviewer evidence against the policy: violating commits are marked in
+// the predicate and listed in the markdown, and the command fails after the evidence is uploaded.
+func WithFourEyesPolicy(policy foureyes.Policy) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.fourEyes = &policy
+	}
+}
+
+// WithMarkdownTemplate renders the evidence markdown from a text/template file instead of reading it from
+// --markdown or generating it.
+func WithMarkdownTemplate(path string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.markdownTemplatePath = path
+	}
+}
+
+// WithPredicateSchema validates the predicate against the JSON Schema file before the statement is signed,
+// instead of the schema registered in evidence.yml for the predicate type.
+func WithPredicateSchema(path string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.predicateSchemaPath = path
+	}
+}
+
+// WithAttachDirectoryMode sets how a directory given in --attach-local is attached: archived into a single
+// zip attachment (AttachDirectoryArchive, the default) or expanded into one attachment per file.
+func WithAttachDirectoryMode(mode string) EvidenceOption {
+	return func(c *createEvidenceBase) {
+		c.attachDirectoryMode = mode
+	}
+}
+
+func (c *createEvidenceBase) applyOptions(opts []EvidenceOption) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+}
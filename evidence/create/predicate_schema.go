@@ -0,0 +1,37 @@
+package create
+
+import (
+	evdConfig "github.com/jfrog/jfrog-cli-evidence/evidence/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/predschema"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// validatePredicate checks the predicate against --predicate-schema or, without it, against the schema
+// registered in evidence.yml for the predicate type. Predicates without a schema are not validated.
+func (c *createEvidenceBase) validatePredicate(predicate []byte, predicateType string) error {
+	schema, err := c.resolvePredicateSchema(predicateType)
+	if err != nil || schema == nil {
+		return err
+	}
+	log.Debug("Validating the predicate against schema", schema.Name())
+	return errorutils.CheckError(schema.Validate(predicate))
+}
+
+func (c *createEvidenceBase) resolvePredicateSchema(predicateType string) (*predschema.Schema, error) {
+	if c.predicateSchemaPath == "" {
+		if c.predicateSchemas == nil {
+			c.predicateSchemas = predschema.NewRegistry(evdConfig.ResolvePredicateSchemas())
+		}
+		schema, err := c.predicateSchemas.Lookup(predicateType)
+		return schema, errorutils.CheckError(err)
+	}
+	if c.predicateSchema == nil {
+		schema, err := predschema.Load(c.predicateSchemaPath)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		c.predicateSchema = schema
+	}
+	return c.predicateSchema, nil
+}
@@ -0,0 +1,47 @@
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/predschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scanSchema = `{"type":"object","required":["tool"],"properties":{"tool":{"type":"string"}}}`
+
+func TestValidatePredicate_ExplicitSchema(t *testing.T) {
+	schemaPath := filepath.Join(t.TempDir(), "scan.schema.json")
+	require.NoError(t, os.WriteFile(schemaPath, []byte(scanSchema), 0o600))
+	c := &createEvidenceBase{predicateSchemaPath: schemaPath}
+
+	assert.NoError(t, c.validatePredicate([]byte(`{"tool":"scanner"}`), "https://example.com/scan/v1"))
+
+	err := c.validatePredicate([]byte(`{"tool":1}`), "https://example.com/other/v1")
+	var validationError *predschema.ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "/tool", validationError.Violations[0].Pointer)
+	assert.ErrorContains(t, err, "predicate does not match schema scan.schema.json")
+}
+
+func TestValidatePredicate_ExplicitSchemaMissing(t *testing.T) {
+	c := &createEvidenceBase{predicateSchemaPath: filepath.Join(t.TempDir(), "missing.json")}
+	assert.ErrorContains(t, c.validatePredicate([]byte(`{}`), "https://example.com/scan/v1"), "failed to load predicate schema")
+}
+
+func TestValidatePredicate_ConfiguredSchemas(t *testing.T) {
+	dir := t.TempDir()
+	evidenceDir := filepath.Join(dir, ".jfrog", "evidence")
+	require.NoError(t, os.MkdirAll(evidenceDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(evidenceDir, "scan.schema.json"), []byte(scanSchema), 0o600))
+	config := "predicateSchemas:\n  - predicateType: https://example.com/scan/v1\n    path: scan.schema.json\n"
+	require.NoError(t, os.WriteFile(filepath.Join(evidenceDir, "evidence.yml"), []byte(config), 0o600))
+	t.Chdir(dir)
+
+	c := &createEvidenceBase{}
+	assert.NoError(t, c.validatePredicate([]byte(`{"tool":"scanner"}`), "https://example.com/scan/v1"))
+	assert.ErrorContains(t, c.validatePredicate([]byte(`{}`), "https://example.com/scan/v1"), "(root): ")
+	assert.NoError(t, c.validatePredicate([]byte(`{}`), "https://example.com/unregistered/v1"))
+}
@@ -0,0 +1,131 @@
+package resolvers
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const purlScheme = "pkg:"
+
+// PackageURL is a parsed Package URL (https://github.com/package-url/purl-spec), such as
+// pkg:npm/%40angular/core@17.0.0 or pkg:maven/org.apache.commons/commons-lang3@3.14.0.
+type PackageURL struct {
+	Type       string
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers map[string]string
+	Subpath    string
+}
+
+// IsPackageURL reports whether subject is given as a Package URL.
+func IsPackageURL(subject string) bool {
+	return len(subject) > len(purlScheme) && strings.EqualFold(subject[:len(purlScheme)], purlScheme)
+}
+
+// ParsePackageURL parses pkg:<type>/<namespace>/<name>@<version>?<qualifiers>#<subpath>. The namespace,
+// qualifiers and subpath are optional; the version is required since evidence is attached to a package version.
+func ParsePackageURL(subject string) (*PackageURL, error) {
+	if !IsPackageURL(subject) {
+		return nil, fmt.Errorf("invalid package URL '%s': must start with '%s'", subject, purlScheme)
+	}
+	remainder := strings.TrimLeft(subject[len(purlScheme):], "/")
+	purl := &PackageURL{Qualifiers: map[string]string{}}
+
+	if index := strings.LastIndex(remainder, "#"); index >= 0 {
+		subpath, err := url.PathUnescape(strings.Trim(remainder[index+1:], "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid package URL '%s': %w", subject, err)
+		}
+		purl.Subpath = subpath
+		remainder = remainder[:index]
+	}
+	if index := strings.LastIndex(remainder, "?"); index >= 0 {
+		for _, pair := range strings.Split(remainder[index+1:], "&") {
+			key, value, found := strings.Cut(pair, "=")
+			if !found || key == "" {
+				continue
+			}
+			decoded, err := url.QueryUnescape(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid package URL '%s': qualifier '%s': %w", subject, key, err)
+			}
+			purl.Qualifiers[strings.ToLower(key)] = decoded
+		}
+		remainder = remainder[:index]
+	}
+
+	purlType, path, found := strings.Cut(strings.TrimRight(remainder, "/"), "/")
+	if !found || purlType == "" {
+		return nil, fmt.Errorf("invalid package URL '%s': expected pkg:<type>/<name>@<version>", subject)
+	}
+	purl.Type = strings.ToLower(purlType)
+
+	if index := strings.LastIndex(path, "@"); index >= 0 {
+		version, err := url.PathUnescape(path[index+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid package URL '%s': %w", subject, err)
+		}
+		purl.Version = version
+		path = path[:index]
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid package URL '%s': %w", subject, err)
+		}
+		segments[i] = decoded
+	}
+	purl.Name = segments[len(segments)-1]
+	purl.Namespace = strings.Join(segments[:len(segments)-1], "/")
+
+	if purl.Name == "" {
+		return nil, fmt.Errorf("invalid package URL '%s': package name is missing", subject)
+	}
+	if purl.Version == "" {
+		return nil, fmt.Errorf("invalid package URL '%s': package version is missing", subject)
+	}
+	return purl, nil
+}
+
+// PackageName returns the name Artifactory knows the package by: groupId:artifactId for Maven, the scoped
+// name for npm and the full path for the other types.
+func (p *PackageURL) PackageName() string {
+	if p.Namespace == "" {
+		return p.Name
+	}
+	if p.Type == "maven" || p.Type == "gradle" {
+		return p.Namespace + ":" + p.Name
+	}
+	return p.Namespace + "/" + p.Name
+}
+
+// RepositoryKey returns the Artifactory repository named by the repository_url qualifier, if any. Both
+// https://host/artifactory/api/npm/npm-local and host/npm-local forms are accepted.
+func (p *PackageURL) RepositoryKey() (string, error) {
+	repositoryURL := p.Qualifiers["repository_url"]
+	if repositoryURL == "" {
+		return "", nil
+	}
+	if !strings.Contains(repositoryURL, "://") {
+		repositoryURL = "https://" + repositoryURL
+	}
+	parsed, err := url.Parse(repositoryURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository_url '%s': %w", p.Qualifiers["repository_url"], err)
+	}
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) > 0 && segments[0] == "artifactory" {
+		segments = segments[1:]
+	}
+	// Native package manager endpoints: api/<package type>/<repository key>[/...]
+	if len(segments) >= 3 && segments[0] == "api" {
+		return segments[2], nil
+	}
+	if len(segments) == 0 || segments[0] == "" {
+		return "", fmt.Errorf("invalid repository_url '%s': repository key is missing", p.Qualifiers["repository_url"])
+	}
+	return segments[0], nil
+}
@@ -0,0 +1,124 @@
+package resolvers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/metadata"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// purlArtifactoryPackageTypes maps Package URL types to Artifactory repository package types, where they differ.
+var purlArtifactoryPackageTypes = map[string]string{
+	"gem":         "gems",
+	"golang":      "go",
+	"deb":         "debian",
+	"oci":         "docker",
+	"huggingface": "huggingfaceml",
+}
+
+// PurlSubjectResolver resolves a Package URL to the repository path of the package version lead file.
+type PurlSubjectResolver struct {
+	subject        string
+	client         artifactory.ArtifactoryServicesManager
+	metadataClient metadata.Manager
+}
+
+// NewPurlSubjectResolver creates a new PurlSubjectResolver instance
+func NewPurlSubjectResolver(subject string, client artifactory.ArtifactoryServicesManager, metadataClient metadata.Manager) *PurlSubjectResolver {
+	return &PurlSubjectResolver{
+		subject:        subject,
+		client:         client,
+		metadataClient: metadataClient,
+	}
+}
+
+// Resolve returns the lead file of the package version. With a repository_url qualifier only that repository is
+// used; otherwise every local repository of the package type is searched. The checksum is not needed, since the
+// package version identifies the lead file.
+func (r *PurlSubjectResolver) Resolve(_ string) ([]string, error) {
+	if r.client == nil || r.metadataClient == nil {
+		return nil, fmt.Errorf("artifactory client is not properly initialized")
+	}
+	purl, err := ParsePackageURL(r.subject)
+	if err != nil {
+		return nil, err
+	}
+	repoKey, err := purl.RepositoryKey()
+	if err != nil {
+		return nil, err
+	}
+	if repoKey != "" {
+		leadArtifactPath, err := r.resolveInRepository(purl, repoKey)
+		if err != nil {
+			return nil, err
+		}
+		return []string{leadArtifactPath}, nil
+	}
+
+	packageType := purl.ArtifactoryPackageType()
+	repositories, err := r.client.GetAllRepositoriesFiltered(services.RepositoriesFilterParams{PackageType: packageType})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s repositories: %w", packageType, err)
+	}
+	var subjects []string
+	if repositories != nil {
+		for _, repository := range *repositories {
+			if !isLocalRepository(repository) {
+				continue
+			}
+			packageService := evidence.NewPackageService(purl.PackageName(), purl.Version, repository.Key)
+			leadArtifactPath, err := packageService.GetPackageVersionLeadArtifact(packageType, r.metadataClient, r.client)
+			if err != nil {
+				log.Debug("Package", purl.PackageName(), purl.Version, "not found in repository", repository.Key+":", err.Error())
+				continue
+			}
+			subjects = append(subjects, leadArtifactPath)
+		}
+	}
+	if len(subjects) == 0 {
+		return nil, fmt.Errorf("no %s repository contains package %s version %s; add a repository_url qualifier to select the repository", packageType, purl.PackageName(), purl.Version)
+	}
+	return subjects, nil
+}
+
+// resolveInRepository returns the lead file of the package version in repoKey, after checking that the
+// repository holds packages of the Package URL type.
+func (r *PurlSubjectResolver) resolveInRepository(purl *PackageURL, repoKey string) (string, error) {
+	packageService := evidence.NewPackageService(purl.PackageName(), purl.Version, repoKey)
+	packageType, err := packageService.GetPackageType(r.client)
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(packageType, purl.ArtifactoryPackageType()) {
+		return "", fmt.Errorf("repository '%s' is a %s repository and cannot hold %s package %s", repoKey, packageType, purl.Type, purl.PackageName())
+	}
+	leadArtifactPath, err := packageService.GetPackageVersionLeadArtifact(packageType, r.metadataClient, r.client)
+	if err != nil {
+		return "", fmt.Errorf("package %s version %s not found in repository '%s': %w", purl.PackageName(), purl.Version, repoKey, err)
+	}
+	return leadArtifactPath, nil
+}
+
+// ArtifactoryPackageType returns the Artifactory repository package type holding packages of the Package URL type.
+func (p *PackageURL) ArtifactoryPackageType() string {
+	if packageType, ok := purlArtifactoryPackageTypes[p.Type]; ok {
+		return packageType
+	}
+	return p.Type
+}
+
+func isLocalRepository(repository services.RepositoryDetails) bool {
+	repoType := strings.ToLower(repository.GetRepoType())
+	return repoType == "local" || repoType == "federated"
+}
+
+// newMetadataClient creates a metadata service client for the platform of the server details.
+var newMetadataClient = func(serverDetails *config.ServerDetails) (metadata.Manager, error) {
+	return utils.CreateMetadataServiceManager(serverDetails, false)
+}
@@ -0,0 +1,133 @@
+package resolvers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/metadata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// packageServicesManager serves repository package types and package lead files keyed by "<repo>/<package name>".
+type packageServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	repositories []services.RepositoryDetails
+	leadFiles    map[string]string
+	leadRequests []services.LeadFileParams
+}
+
+func (m *packageServicesManager) GetRepository(repoKey string, repoDetails interface{}) error {
+	for _, repository := range m.repositories {
+		if repository.Key == repoKey {
+			details, ok := repoDetails.(*services.RepositoryDetails)
+			if ok {
+				*details = repository
+			}
+			return nil
+		}
+	}
+	return errors.New("404 Not Found")
+}
+
+func (m *packageServicesManager) GetAllRepositoriesFiltered(params services.RepositoriesFilterParams) (*[]services.RepositoryDetails, error) {
+	var filtered []services.RepositoryDetails
+	for _, repository := range m.repositories {
+		if repository.PackageType == params.PackageType {
+			filtered = append(filtered, repository)
+		}
+	}
+	return &filtered, nil
+}
+
+func (m *packageServicesManager) GetPackageLeadFile(params services.LeadFileParams) ([]byte, error) {
+	m.leadRequests = append(m.leadRequests, params)
+	leadFile, ok := m.leadFiles[params.PackageRepoName+"/"+params.PackageName]
+	if !ok {
+		return nil, errors.New("404 Not Found")
+	}
+	return []byte(leadFile), nil
+}
+
+type stubMetadataManager struct{}
+
+func (stubMetadataManager) GraphqlQuery(_ []byte) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
+func newPackageServicesManager() *packageServicesManager {
+	return &packageServicesManager{
+		repositories: []services.RepositoryDetails{
+			{Key: "npm-local", Type: "local", PackageType: "npm"},
+			{Key: "npm-release", Type: "local", PackageType: "npm"},
+			{Key: "npm-remote", Type: "remote", PackageType: "npm"},
+			{Key: "maven-local", Type: "local", PackageType: "maven"},
+		},
+		leadFiles: map[string]string{
+			"npm-local/lodash":                             "npm-local:lodash/-/lodash-4.17.21.tgz",
+			"npm-remote/lodash":                            "npm-remote:lodash/-/lodash-4.17.21.tgz",
+			"maven-local/org.apache.commons:commons-lang3": "maven-local:org/apache/commons/commons-lang3/3.14.0/commons-lang3-3.14.0.jar",
+		},
+	}
+}
+
+func TestPurlSubjectResolver_Resolve_SearchesLocalRepositories(t *testing.T) {
+	client := newPackageServicesManager()
+	subjects, err := NewPurlSubjectResolver("pkg:npm/lodash@4.17.21", client, stubMetadataManager{}).Resolve("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"npm-local/lodash/-/lodash-4.17.21.tgz"}, subjects)
+	for _, request := range client.leadRequests {
+		assert.NotEqual(t, "npm-remote", request.PackageRepoName, "remote repositories should not be searched")
+	}
+}
+
+func TestPurlSubjectResolver_Resolve_RepositoryQualifier(t *testing.T) {
+	client := newPackageServicesManager()
+	subject := "pkg:maven/org.apache.commons/commons-lang3@3.14.0?repository_url=https://acme.jfrog.io/artifactory/api/maven/maven-local"
+	subjects, err := NewPurlSubjectResolver(subject, client, stubMetadataManager{}).Resolve("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"maven-local/org/apache/commons/commons-lang3/3.14.0/commons-lang3-3.14.0.jar"}, subjects)
+	require.Len(t, client.leadRequests, 1)
+	assert.Equal(t, services.LeadFileParams{PackageType: "MAVEN", PackageRepoName: "maven-local", PackageName: "org.apache.commons:commons-lang3", PackageVersion: "3.14.0"}, client.leadRequests[0])
+}
+
+func TestPurlSubjectResolver_Resolve_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		wantErr string
+	}{
+		{name: "package type mismatch", subject: "pkg:npm/lodash@4.17.21?repository_url=acme.jfrog.io/maven-local", wantErr: "repository 'maven-local' is a maven repository and cannot hold npm package lodash"},
+		{name: "not in qualified repository", subject: "pkg:npm/lodash@4.17.21?repository_url=acme.jfrog.io/npm-release", wantErr: "package lodash version 4.17.21 not found in repository 'npm-release'"},
+		{name: "not found", subject: "pkg:npm/left-pad@1.3.0", wantErr: "no npm repository contains package left-pad version 1.3.0"},
+		{name: "invalid", subject: "pkg:npm/lodash", wantErr: "package version is missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newPackageServicesManager()
+			_, err := NewPurlSubjectResolver(tt.subject, client, stubMetadataManager{}).Resolve("")
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestResolveSubject_PackageURL(t *testing.T) {
+	_, err := DefaultSubjectLookup{ServerDetails: &config.ServerDetails{}}.ResolveSubject("pkg:npm/lodash@4.17.21", "", nil)
+	assert.ErrorContains(t, err, "artifactory client cannot be nil")
+	_, err = ResolveSubject("pkg:npm/lodash@4.17.21", "", newPackageServicesManager())
+	assert.ErrorContains(t, err, "server details are required to resolve package URL 'pkg:npm/lodash@4.17.21'")
+
+	original := newMetadataClient
+	t.Cleanup(func() { newMetadataClient = original })
+	serverDetails := &config.ServerDetails{Url: "https://acme.jfrog.io/"}
+	newMetadataClient = func(details *config.ServerDetails) (metadata.Manager, error) {
+		assert.Same(t, serverDetails, details)
+		return stubMetadataManager{}, nil
+	}
+	subjects, err := DefaultSubjectLookup{ServerDetails: serverDetails}.ResolveSubject("pkg:npm/lodash@4.17.21", "", newPackageServicesManager())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"npm-local/lodash/-/lodash-4.17.21.tgz"}, subjects)
+}
@@ -0,0 +1,99 @@
+package resolvers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePackageURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		subject     string
+		expected    PackageURL
+		packageName string
+	}{
+		{
+			name:        "npm",
+			subject:     "pkg:npm/lodash@4.17.21",
+			expected:    PackageURL{Type: "npm", Name: "lodash", Version: "4.17.21", Qualifiers: map[string]string{}},
+			packageName: "lodash",
+		},
+		{
+			name:        "npm scoped",
+			subject:     "pkg:npm/%40angular/core@17.0.0",
+			expected:    PackageURL{Type: "npm", Namespace: "@angular", Name: "core", Version: "17.0.0", Qualifiers: map[string]string{}},
+			packageName: "@angular/core",
+		},
+		{
+			name:    "maven with qualifiers",
+			subject: "pkg:maven/org.apache.commons/commons-lang3@3.14.0?type=jar&repository_url=https%3A%2F%2Facme.jfrog.io%2Fartifactory%2Fapi%2Fmaven%2Fmaven-local",
+			expected: PackageURL{Type: "maven", Namespace: "org.apache.commons", Name: "commons-lang3", Version: "3.14.0",
+				Qualifiers: map[string]string{"type": "jar", "repository_url": "https://acme.jfrog.io/artifactory/api/maven/maven-local"}},
+			packageName: "org.apache.commons:commons-lang3",
+		},
+		{
+			name:        "golang with subpath",
+			subject:     "PKG:golang/github.com/acme/tool@v1.2.3#cmd/tool",
+			expected:    PackageURL{Type: "golang", Namespace: "github.com/acme", Name: "tool", Version: "v1.2.3", Qualifiers: map[string]string{}, Subpath: "cmd/tool"},
+			packageName: "github.com/acme/tool",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purl, err := ParsePackageURL(tt.subject)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, *purl)
+			assert.Equal(t, tt.packageName, purl.PackageName())
+		})
+	}
+}
+
+func TestParsePackageURL_Invalid(t *testing.T) {
+	tests := []struct {
+		subject string
+		wantErr string
+	}{
+		{subject: "npm/lodash@4.17.21", wantErr: "must start with 'pkg:'"},
+		{subject: "pkg:lodash", wantErr: "expected pkg:<type>/<name>@<version>"},
+		{subject: "pkg:npm/@4.17.21", wantErr: "package name is missing"},
+		{subject: "pkg:npm/lodash", wantErr: "package version is missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.subject, func(t *testing.T) {
+			_, err := ParsePackageURL(tt.subject)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPackageURL_RepositoryKey(t *testing.T) {
+	tests := []struct {
+		repositoryURL string
+		expected      string
+	}{
+		{repositoryURL: "", expected: ""},
+		{repositoryURL: "https://acme.jfrog.io/artifactory/api/npm/npm-local", expected: "npm-local"},
+		{repositoryURL: "https://acme.jfrog.io/artifactory/api/pypi/pypi-local/simple", expected: "pypi-local"},
+		{repositoryURL: "https://acme.jfrog.io/artifactory/maven-local/", expected: "maven-local"},
+		{repositoryURL: "acme.jfrog.io/npm-local", expected: "npm-local"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repositoryURL, func(t *testing.T) {
+			purl := &PackageURL{Qualifiers: map[string]string{"repository_url": tt.repositoryURL}}
+			repoKey, err := purl.RepositoryKey()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, repoKey)
+		})
+	}
+
+	_, err := (&PackageURL{Qualifiers: map[string]string{"repository_url": "https://acme.jfrog.io/artifactory/"}}).RepositoryKey()
+	assert.ErrorContains(t, err, "repository key is missing")
+}
+
+func TestPackageURL_ArtifactoryPackageType(t *testing.T) {
+	assert.Equal(t, "go", (&PackageURL{Type: "golang"}).ArtifactoryPackageType())
+	assert.Equal(t, "gems", (&PackageURL{Type: "gem"}).ArtifactoryPackageType())
+	assert.Equal(t, "npm", (&PackageURL{Type: "npm"}).ArtifactoryPackageType())
+}
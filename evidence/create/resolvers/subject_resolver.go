@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 )
 
@@ -21,6 +22,23 @@ var ociResolver ResolverFunc = func(subject, checksum string, client artifactory
 	return resolver.Resolve(checksum)
 }
 
+// resolvePackageURL resolves a Package URL subject, using a metadata service client for the platform of the
+// server details.
+func resolvePackageURL(subject, checksum string, client artifactory.ArtifactoryServicesManager, serverDetails *config.ServerDetails) ([]string, error) {
+	if client == nil {
+		return nil, fmt.Errorf("artifactory client cannot be nil")
+	}
+	if serverDetails == nil {
+		return nil, fmt.Errorf("server details are required to resolve package URL '%s'", subject)
+	}
+	metadataClient, err := newMetadataClient(serverDetails)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metadata client: %w", err)
+	}
+	resolver := NewPurlSubjectResolver(subject, client, metadataClient)
+	return resolver.Resolve(checksum)
+}
+
 // resolvers maps protocol prefixes to their corresponding resolver functions
 var resolvers = map[string]ResolverFunc{
 	"docker": ociResolver,
@@ -29,12 +47,21 @@ var resolvers = map[string]ResolverFunc{
 
 // ResolveSubject resolves a subject to repository paths based on its protocol prefix
 // The subject should be in the format "protocol://reference" (e.g., "docker://nginx:latest")
+// or a Package URL (e.g., "pkg:npm/lodash@4.17.21"), which needs the server details of DefaultSubjectLookup.
 // If no protocol is specified or the protocol is not supported, returns the original subject
 func ResolveSubject(subject, checksum string, client artifactory.ArtifactoryServicesManager) ([]string, error) {
+	return resolveSubject(subject, checksum, client, nil)
+}
+
+func resolveSubject(subject, checksum string, client artifactory.ArtifactoryServicesManager, serverDetails *config.ServerDetails) ([]string, error) {
 	if subject == "" {
 		return nil, fmt.Errorf("subject cannot be empty")
 	}
 
+	if IsPackageURL(subject) {
+		return resolvePackageURL(subject, checksum, client, serverDetails)
+	}
+
 	split := strings.Split(subject, "://")
 	if len(split) != 2 {
 		// No protocol specified, return original subject
@@ -64,8 +91,11 @@ type SubjectLookup interface {
 }
 
 // DefaultSubjectLookup delegates to the package-level ResolveSubject function
-type DefaultSubjectLookup struct{}
+type DefaultSubjectLookup struct {
+	// ServerDetails are used to reach the metadata service when resolving Package URLs.
+	ServerDetails *config.ServerDetails
+}
 
-func (DefaultSubjectLookup) ResolveSubject(subject, checksum string, client artifactory.ArtifactoryServicesManager) ([]string, error) {
-	return ResolveSubject(subject, checksum, client)
+func (l DefaultSubjectLookup) ResolveSubject(subject, checksum string, client artifactory.ArtifactoryServicesManager) ([]string, error) {
+	return resolveSubject(subject, checksum, client, l.ServerDetails)
 }
@@ -0,0 +1,82 @@
+package create
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa/tsatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignStatement_WithTimestampAuthority(t *testing.T) {
+	keyContent, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	require.NoError(t, err)
+	t.Chdir(t.TempDir())
+	server := tsatest.NewServer(t)
+	server.Time = time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC)
+
+	c := &createEvidenceBase{key: string(keyContent)}
+	WithTimestampAuthority(server.URL)(c)
+	signed, err := c.signStatement([]byte(`{"predicateType":"test"}`))
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.Requests)
+
+	var envelope dsse.Envelope
+	require.NoError(t, json.Unmarshal(signed, &envelope))
+	require.Len(t, envelope.Signatures, 1)
+	require.NotEmpty(t, envelope.Signatures[0].Timestamp)
+
+	response, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Timestamp)
+	require.NoError(t, err)
+	signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+	require.NoError(t, err)
+	chain, err := tsa.ParseCertChain(server.ChainPEM)
+	require.NoError(t, err)
+	signedAt, err := tsa.Verify(response, signature, chain)
+	require.NoError(t, err)
+	assert.True(t, server.Time.Equal(signedAt))
+}
+
+func TestSignStatement_WithoutTimestampAuthority(t *testing.T) {
+	keyContent, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	require.NoError(t, err)
+	t.Chdir(t.TempDir())
+
+	c := &createEvidenceBase{key: string(keyContent)}
+	signed, err := c.signStatement([]byte(`{}`))
+	require.NoError(t, err)
+	assert.NotContains(t, string(signed), `"timestamp"`)
+}
+
+func TestSignStatement_TimestampAuthorityError(t *testing.T) {
+	keyContent, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	require.NoError(t, err)
+	t.Chdir(t.TempDir())
+	orig := requestTimestamp
+	requestTimestamp = func(string, []byte) ([]byte, error) { return nil, errors.New("tsa unavailable") }
+	t.Cleanup(func() { requestTimestamp = orig })
+
+	c := &createEvidenceBase{key: string(keyContent)}
+	WithTimestampAuthority("https://tsa.example.com")(c)
+	_, err = c.signStatement([]byte(`{}`))
+	assert.ErrorContains(t, err, "tsa unavailable")
+}
+
+func TestSignStatement_Keyless_PassesTimestampAuthority(t *testing.T) {
+	t.Chdir(t.TempDir())
+	t.Setenv("EVIDENCE_TSA_URL", "https://tsa.internal")
+	captured := stubKeyless(t, "token", nil)
+
+	c := &createEvidenceBase{keyless: true}
+	_, err := c.signStatement([]byte(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, "https://tsa.internal", captured.TSAURL)
+}
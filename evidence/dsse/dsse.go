@@ -17,6 +17,8 @@ type Envelope struct {
 type Signature struct {
 	KeyId string `json:"keyid"`
 	Sig   string `json:"sig"`
+	// Timestamp is an optional base64 encoded RFC 3161 TimeStampResp over the signature bytes.
+	Timestamp string `json:"timestamp,omitempty"`
 }
 
 type Erroneous struct {
@@ -0,0 +1,162 @@
+package foureyes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+// Review states that replace the earlier decision of a reviewer. Comments keep it.
+const (
+	stateApproved         = "APPROVED"
+	stateChangesRequested = "CHANGES_REQUESTED"
+	stateDismissed        = "DISMISSED"
+)
+
+// Policy requires every commit to come from a pull request approved by at least RequiredApprovals reviewers
+// other than its author. When Approvers is set, only approvals of those logins count.
+type Policy struct {
+	RequiredApprovals int      `json:"requiredApprovals" yaml:"requiredApprovals"`
+	Approvers         []string `json:"approvers,omitempty" yaml:"approvers"`
+}
+
+// Violation is a commit that does not satisfy the policy.
+type Violation struct {
+	Commit  string `json:"commit"`
+	Subject string `json:"subject,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+// Result is the outcome of evaluating the policy over a commit range.
+type Result struct {
+	Policy     Policy      `json:"policy"`
+	Commits    int         `json:"commits"`
+	Violations []Violation `json:"violations"`
+}
+
+// ParseApprovers splits a comma separated list of approver logins.
+func ParseApprovers(approvers string) []string {
+	var logins []string
+	for _, login := range strings.Split(approvers, ",") {
+		if login = strings.TrimSpace(login); login != "" {
+			logins = append(logins, login)
+		}
+	}
+	return logins
+}
+
+func (p Policy) Validate() error {
+	if p.RequiredApprovals < 1 {
+		return fmt.Errorf("the four-eyes policy requires at least 1 approval, got %d", p.RequiredApprovals)
+	}
+	return nil
+}
+
+// Evaluate checks every commit against the policy and records the outcome on the commit.
+func (p Policy) Evaluate(entries []model.GitLogEntry) *Result {
+	result := &Result{Policy: p, Commits: len(entries), Violations: []Violation{}}
+	for i := range entries {
+		check := p.check(&entries[i])
+		entries[i].FourEyes = check
+		if !check.Satisfied {
+			result.Violations = append(result.Violations, Violation{Commit: entries[i].Commit, Subject: entries[i].Subject, Reason: check.Reason})
+		}
+	}
+	return result
+}
+
+func (p Policy) check(entry *model.GitLogEntry) *model.FourEyesCheck {
+	check := &model.FourEyesCheck{Required: p.RequiredApprovals}
+	if entry.PRnumber == 0 && entry.PRauthor == "" && len(entry.PRreviewer) == 0 {
+		check.Reason = "not merged through a pull request"
+		return check
+	}
+	check.Approvals = len(p.approvers(entry))
+	if check.Approvals < p.RequiredApprovals {
+		check.Reason = fmt.Sprintf("%d of %d required approvals", check.Approvals, p.RequiredApprovals)
+		return check
+	}
+	check.Satisfied = true
+	return check
+}
+
+// approvers returns the reviewers whose latest decision approves the pull request, excluding its author.
+func (p Policy) approvers(entry *model.GitLogEntry) []string {
+	decisions := map[string]string{}
+	var reviewers []string
+	for _, review := range entry.PRreviewer {
+		switch strings.ToUpper(review.State) {
+		case stateApproved, stateChangesRequested, stateDismissed:
+		default:
+			continue
+		}
+		if _, seen := decisions[review.Reviewer]; !seen {
+			reviewers = append(reviewers, review.Reviewer)
+		}
+		decisions[review.Reviewer] = strings.ToUpper(review.State)
+	}
+	var approvers []string
+	for _, reviewer := range reviewers {
+		if decisions[reviewer] != stateApproved || isAuthor(entry, reviewer) {
+			continue
+		}
+		if len(p.Approvers) > 0 && !slices.ContainsFunc(p.Approvers, func(login string) bool { return strings.EqualFold(login, reviewer) }) {
+			continue
+		}
+		approvers = append(approvers, reviewer)
+	}
+	return approvers
+}
+
+// isAuthor compares the reviewer login with the pull request author, or with the commit author and committer
+// names when the author login was not recorded.
+func isAuthor(entry *model.GitLogEntry, reviewer string) bool {
+	if entry.PRauthor != "" {
+		return strings.EqualFold(entry.PRauthor, reviewer)
+	}
+	return strings.EqualFold(entry.Author.Name, reviewer) || strings.EqualFold(entry.Commiter.Name, reviewer)
+}
+
+// Satisfied reports whether every commit satisfies the policy.
+func (r *Result) Satisfied() bool {
+	return len(r.Violations) == 0
+}
+
+// Markdown renders the policy outcome and lists the violating commits.
+func (r *Result) Markdown() []byte {
+	var sb strings.Builder
+	status := "PASSED"
+	if !r.Satisfied() {
+		status = "FAILED"
+	}
+	sb.WriteString(fmt.Sprintf("# Four-eyes policy: %s\n\n", status))
+	sb.WriteString(fmt.Sprintf("Every commit requires %d approval(s) from a reviewer other than its author", r.Policy.RequiredApprovals))
+	if len(r.Policy.Approvers) > 0 {
+		sb.WriteString(fmt.Sprintf(", from: %s", strings.Join(r.Policy.Approvers, ", ")))
+	}
+	sb.WriteString(fmt.Sprintf(".\n\n%d of %d commits satisfy the policy.\n", r.Commits-len(r.Violations), r.Commits))
+	if r.Satisfied() {
+		return []byte(sb.String())
+	}
+	sb.WriteString("\n| Commit | Subject | Reason |\n")
+	sb.WriteString("|---|---|---|\n")
+	for _, violation := range r.Violations {
+		sb.WriteString(fmt.Sprintf("| `%s` | %s | %s |\n", utils.ShortSha(violation.Commit), utils.MarkdownCell(violation.Subject), violation.Reason))
+	}
+	return []byte(sb.String())
+}
+
+// Err describes the violations, for failing the command that evaluated the policy. It is nil when the policy is satisfied.
+func (r *Result) Err() error {
+	if r.Satisfied() {
+		return nil
+	}
+	commits := make([]string, 0, len(r.Violations))
+	for _, violation := range r.Violations {
+		commits = append(commits, fmt.Sprintf("%s (%s)", utils.ShortSha(violation.Commit), violation.Reason))
+	}
+	return fmt.Errorf("four-eyes policy violated by %d of %d commits: %s", len(r.Violations), r.Commits, strings.Join(commits, ", "))
+}
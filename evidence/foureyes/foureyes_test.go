@@ -0,0 +1,93 @@
+package foureyes
+
+import (
+	"testing"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commit(sha, prAuthor string, reviews ...vcsclient.PullRequestReviewDetails) model.GitLogEntry {
+	entry := model.GitLogEntry{Commit: sha, Subject: "subject " + sha, PRauthor: prAuthor, PRreviewer: reviews}
+	if prAuthor != "" {
+		entry.PRnumber = 7
+	}
+	return entry
+}
+
+func review(reviewer, state string) vcsclient.PullRequestReviewDetails {
+	return vcsclient.PullRequestReviewDetails{Reviewer: reviewer, State: state}
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    Policy
+		entry     model.GitLogEntry
+		approvals int
+		reason    string
+	}{
+		{"approved", Policy{RequiredApprovals: 1}, commit("c1", "dev", review("alice", "APPROVED")), 1, ""},
+		{"no pull request", Policy{RequiredApprovals: 1}, commit("c1", ""), 0, "not merged through a pull request"},
+		{"self approval", Policy{RequiredApprovals: 1}, commit("c1", "dev", review("dev", "APPROVED")), 0, "0 of 1 required approvals"},
+		{"comments do not count", Policy{RequiredApprovals: 1}, commit("c1", "dev", review("alice", "COMMENTED")), 0, "0 of 1 required approvals"},
+		{"later changes requested", Policy{RequiredApprovals: 1}, commit("c1", "dev", review("alice", "APPROVED"), review("alice", "CHANGES_REQUESTED")), 0, "0 of 1 required approvals"},
+		{"comment keeps approval", Policy{RequiredApprovals: 1}, commit("c1", "dev", review("alice", "APPROVED"), review("alice", "COMMENTED")), 1, ""},
+		{"reviewer counted once", Policy{RequiredApprovals: 2}, commit("c1", "dev", review("alice", "APPROVED"), review("alice", "APPROVED")), 1, "1 of 2 required approvals"},
+		{"two approvers", Policy{RequiredApprovals: 2}, commit("c1", "dev", review("alice", "APPROVED"), review("bob", "approved")), 2, ""},
+		{"approver list", Policy{RequiredApprovals: 1, Approvers: []string{"Bob"}}, commit("c1", "dev", review("alice", "APPROVED"), review("bob", "APPROVED")), 1, ""},
+		{"not in approver list", Policy{RequiredApprovals: 1, Approvers: []string{"carol"}}, commit("c1", "dev", review("alice", "APPROVED")), 0, "0 of 1 required approvals"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := []model.GitLogEntry{tt.entry}
+			result := tt.policy.Evaluate(entries)
+			require.NotNil(t, entries[0].FourEyes)
+			assert.Equal(t, tt.approvals, entries[0].FourEyes.Approvals)
+			assert.Equal(t, tt.policy.RequiredApprovals, entries[0].FourEyes.Required)
+			assert.Equal(t, tt.reason, entries[0].FourEyes.Reason)
+			assert.Equal(t, tt.reason == "", entries[0].FourEyes.Satisfied)
+			assert.Equal(t, tt.reason == "", result.Satisfied())
+		})
+	}
+}
+
+func TestEvaluate_AuthorFallback(t *testing.T) {
+	entry := model.GitLogEntry{Commit: "c1", PRreviewer: []vcsclient.PullRequestReviewDetails{review("jane", "APPROVED")}}
+	entry.Author.Name = "Jane"
+	result := Policy{RequiredApprovals: 1}.Evaluate([]model.GitLogEntry{entry})
+	assert.False(t, result.Satisfied())
+}
+
+func TestResult_MarkdownAndErr(t *testing.T) {
+	entries := []model.GitLogEntry{
+		commit("0123456789abcdef", "dev", review("alice", "APPROVED")),
+		commit("fedcba9876543210", ""),
+	}
+	entries[1].Subject = "hotfix | direct push"
+	result := Policy{RequiredApprovals: 1, Approvers: []string{"alice"}}.Evaluate(entries)
+
+	markdown := string(result.Markdown())
+	assert.Contains(t, markdown, "# Four-eyes policy: FAILED")
+	assert.Contains(t, markdown, "from: alice")
+	assert.Contains(t, markdown, "1 of 2 commits satisfy the policy.")
+	assert.Contains(t, markdown, "| `fedcba987654` | hotfix \\| direct push | not merged through a pull request |")
+	assert.NotContains(t, markdown, "0123456789ab")
+	assert.EqualError(t, result.Err(), "four-eyes policy violated by 1 of 2 commits: fedcba987654 (not merged through a pull request)")
+
+	satisfied := Policy{RequiredApprovals: 1}.Evaluate(entries[:1])
+	assert.Contains(t, string(satisfied.Markdown()), "# Four-eyes policy: PASSED")
+	assert.NoError(t, satisfied.Err())
+}
+
+func TestParseApprovers(t *testing.T) {
+	assert.Equal(t, []string{"alice", "bob"}, ParseApprovers(" alice, ,bob "))
+	assert.Nil(t, ParseApprovers(""))
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	assert.NoError(t, Policy{RequiredApprovals: 1}.Validate())
+	assert.EqualError(t, Policy{}.Validate(), "the four-eyes policy requires at least 1 approval, got 0")
+}
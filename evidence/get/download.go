@@ -0,0 +1,292 @@
+package get
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	evidenceutils "github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	DownloadIndexSchemaVersion = "1.0"
+	DownloadIndexFileName      = "index.json"
+	DefaultDownloadThreads     = 3
+
+	envelopeFileName   = "envelope.json"
+	attachmentsDirName = "attachments"
+	partialFileSuffix  = ".part"
+)
+
+// remoteFileReader is the part of the Artifactory client the download needs.
+type remoteFileReader interface {
+	ReadRemoteFile(readPath string) (io.ReadCloser, error)
+}
+
+// DownloadIndex maps every evidence to the files downloaded for it. File paths are relative to the
+// download directory and use forward slashes.
+type DownloadIndex struct {
+	SchemaVersion string               `json:"schemaVersion"`
+	Evidence      []DownloadedEvidence `json:"evidence"`
+}
+
+type DownloadedEvidence struct {
+	PredicateSlug string                 `json:"predicateSlug"`
+	PredicateType string                 `json:"predicateType,omitempty"`
+	DownloadPath  string                 `json:"downloadPath"`
+	Subject       map[string]any         `json:"subject,omitempty"`
+	Envelope      string                 `json:"envelope,omitempty"`
+	Attachments   []DownloadedAttachment `json:"attachments"`
+}
+
+type DownloadedAttachment struct {
+	Name         string `json:"name"`
+	Sha256       string `json:"sha256"`
+	DownloadPath string `json:"downloadPath"`
+	File         string `json:"file"`
+}
+
+type downloadTask struct {
+	remotePath     string
+	localPath      string
+	expectedSha256 string
+}
+
+// downloadEvidenceFiles downloads the files of the evidence in the get output into the download
+// directory. Each evidence gets a directory mirroring its repository path:
+//
+//	<dir>/<evidence path>/envelope.json
+//	<dir>/<evidence path>/attachments/<attachment name>
+//
+// Attachments are hashed while streaming and a checksum mismatch fails the command. The index is
+// written once every file was downloaded.
+func (g *getEvidenceBase) downloadEvidenceFiles(output []byte) error {
+	entries, err := evidenceEntries(output)
+	if err != nil {
+		return err
+	}
+	index, tasks := g.planDownload(entries)
+	if len(tasks) > 0 {
+		client, err := g.createArtifactoryClient()
+		if err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Downloading %d evidence files to %s", len(tasks), g.downloadDir))
+		if err = downloadFiles(client, tasks, g.downloadThreads); err != nil {
+			return err
+		}
+	}
+	return writeDownloadIndex(index, filepath.Join(g.downloadDir, DownloadIndexFileName))
+}
+
+func (g *getEvidenceBase) createArtifactoryClient() (remoteFileReader, error) {
+	if g.artifactoryClient != nil {
+		return g.artifactoryClient, nil
+	}
+	client, err := utils.CreateServiceManager(g.serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Artifactory client: %w", err)
+	}
+	g.artifactoryClient = client
+	return client, nil
+}
+
+// planDownload lays out the local file of every envelope and attachment. Evidence listed more than once
+// is downloaded once.
+func (g *getEvidenceBase) planDownload(entries []EvidenceEntry) (*DownloadIndex, []downloadTask) {
+	index := &DownloadIndex{SchemaVersion: DownloadIndexSchemaVersion, Evidence: []DownloadedEvidence{}}
+	var tasks []downloadTask
+	seen := map[string]bool{}
+	for i, entry := range entries {
+		if entry.DownloadPath != "" {
+			if seen[entry.DownloadPath] {
+				continue
+			}
+			seen[entry.DownloadPath] = true
+		}
+		evidenceDir := evidenceDirName(entry, i)
+		downloaded := DownloadedEvidence{
+			PredicateSlug: entry.PredicateSlug,
+			PredicateType: entry.PredicateType,
+			DownloadPath:  entry.DownloadPath,
+			Subject:       entry.Subject,
+			Attachments:   []DownloadedAttachment{},
+		}
+		if g.downloadEnvelopes && entry.DownloadPath != "" {
+			downloaded.Envelope = path.Join(evidenceDir, envelopeFileName)
+			tasks = append(tasks, downloadTask{remotePath: entry.DownloadPath, localPath: g.localPath(downloaded.Envelope)})
+		}
+		usedNames := map[string]bool{}
+		for _, attachment := range entry.Attachments {
+			if attachment.DownloadPath == "" {
+				log.Warn(fmt.Sprintf("Attachment '%s' of evidence %s has no download path, skipping it", attachment.Name, entry.DownloadPath))
+				continue
+			}
+			file := path.Join(evidenceDir, attachmentsDirName, attachmentFileName(attachment, usedNames))
+			downloaded.Attachments = append(downloaded.Attachments, DownloadedAttachment{
+				Name:         attachment.Name,
+				Sha256:       attachment.Sha256,
+				DownloadPath: attachment.DownloadPath,
+				File:         file,
+			})
+			tasks = append(tasks, downloadTask{remotePath: attachment.DownloadPath, localPath: g.localPath(file), expectedSha256: attachment.Sha256})
+		}
+		index.Evidence = append(index.Evidence, downloaded)
+	}
+	return index, tasks
+}
+
+func (g *getEvidenceBase) localPath(file string) string {
+	return filepath.Join(g.downloadDir, filepath.FromSlash(file))
+}
+
+// evidenceDirName mirrors the evidence repository path without its extension, so evidence of different
+// subjects never share a directory.
+func evidenceDirName(entry EvidenceEntry, position int) string {
+	if dir := safeRelativePath(strings.TrimSuffix(entry.DownloadPath, path.Ext(entry.DownloadPath))); dir != "" {
+		return dir
+	}
+	return fmt.Sprintf("evidence-%d", position+1)
+}
+
+// attachmentFileName keeps the attachment name, prefixed by its checksum when the evidence already has an
+// attachment of that name.
+func attachmentFileName(attachment EvidenceAttachment, usedNames map[string]bool) string {
+	name := path.Base(safeRelativePath(attachment.Name))
+	if name == "." || name == "" {
+		name = attachment.Sha256
+	}
+	if usedNames[name] {
+		name = evidenceutils.ShortSha(attachment.Sha256) + "-" + name
+	}
+	usedNames[name] = true
+	return name
+}
+
+// safeRelativePath cleans a repository path so it cannot escape the download directory.
+func safeRelativePath(repoPath string) string {
+	return strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(repoPath, "\\", "/")), "/")
+}
+
+// downloadFiles runs the tasks on up to threads workers and returns the errors of all failed tasks.
+func downloadFiles(client remoteFileReader, tasks []downloadTask, threads int) error {
+	if threads <= 0 {
+		threads = DefaultDownloadThreads
+	}
+	errs := make([]error, len(tasks))
+	positions := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < min(threads, len(tasks)); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for position := range positions {
+				errs[position] = downloadFile(client, tasks[position])
+			}
+		}()
+	}
+	for position := range tasks {
+		positions <- position
+	}
+	close(positions)
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// downloadFile streams the remote file into a temporary file while hashing it, and moves it into place
+// only when the checksum matches.
+func downloadFile(client remoteFileReader, task downloadTask) (err error) {
+	log.Debug("Downloading", task.remotePath, "to", task.localPath)
+	if err = os.MkdirAll(filepath.Dir(task.localPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", task.localPath, err)
+	}
+	reader, err := client.ReadRemoteFile(task.remotePath)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", task.remotePath, err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	partialPath := task.localPath + partialFileSuffix
+	file, err := os.Create(partialPath)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", partialPath, err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(partialPath)
+		}
+	}()
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(file, hash), reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", task.remotePath, err)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); task.expectedSha256 != "" && !strings.EqualFold(actual, task.expectedSha256) {
+		return fmt.Errorf("checksum mismatch for %s: expected sha256 %s, got %s", task.remotePath, task.expectedSha256, actual)
+	}
+	if err = os.Rename(partialPath, task.localPath); err != nil {
+		return fmt.Errorf("failed to move %s into place: %w", task.localPath, err)
+	}
+	return nil
+}
+
+func writeDownloadIndex(index *DownloadIndex, indexPath string) error {
+	if err := os.MkdirAll(filepath.Dir(indexPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create download directory: %w", err)
+	}
+	content, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal download index: %w", err)
+	}
+	if err = os.WriteFile(indexPath, content, 0o644); err != nil {
+		return fmt.Errorf("failed to write download index: %w", err)
+	}
+	log.Info("Evidence files index written to", indexPath)
+	return nil
+}
+
+// evidenceEntries returns every evidence of the get output, including the evidence of the artifacts and
+// builds of a release bundle.
+func evidenceEntries(output []byte) ([]EvidenceEntry, error) {
+	var header struct {
+		Type SubjectType `json:"type"`
+	}
+	if err := json.Unmarshal(output, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse evidence output: %w", err)
+	}
+	if header.Type != ReleaseBundleType {
+		var customEvidenceOutput CustomEvidenceOutput
+		if err := json.Unmarshal(output, &customEvidenceOutput); err != nil {
+			return nil, fmt.Errorf("failed to parse custom evidence output: %w", err)
+		}
+		return customEvidenceOutput.Result.Evidence, nil
+	}
+	var releaseBundleOutput ReleaseBundleOutput
+	if err := json.Unmarshal(output, &releaseBundleOutput); err != nil {
+		return nil, fmt.Errorf("failed to parse release bundle output: %w", err)
+	}
+	result := releaseBundleOutput.Result
+	entries := append([]EvidenceEntry{}, result.Evidence...)
+	for _, artifact := range result.Artifacts {
+		entries = append(entries, artifact.Evidence)
+	}
+	for _, build := range result.Builds {
+		entries = append(entries, build.Evidence)
+	}
+	return entries, nil
+}
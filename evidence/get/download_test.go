@@ -0,0 +1,206 @@
+package get
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRemoteFileReader struct {
+	files   map[string]string
+	delay   time.Duration
+	active  atomic.Int32
+	maxSeen atomic.Int32
+	mu      sync.Mutex
+	reads   []string
+}
+
+func (m *mockRemoteFileReader) ReadRemoteFile(readPath string) (io.ReadCloser, error) {
+	active := m.active.Add(1)
+	defer m.active.Add(-1)
+	for {
+		seen := m.maxSeen.Load()
+		if active <= seen || m.maxSeen.CompareAndSwap(seen, active) {
+			break
+		}
+	}
+	time.Sleep(m.delay)
+	m.mu.Lock()
+	m.reads = append(m.reads, readPath)
+	m.mu.Unlock()
+	content, ok := m.files[readPath]
+	if !ok {
+		return nil, fmt.Errorf("404 Not Found: %s", readPath)
+	}
+	return io.NopCloser(bytes.NewReader([]byte(content))), nil
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func customOutput(t *testing.T, entries ...EvidenceEntry) []byte {
+	output, err := json.Marshal(CustomEvidenceOutput{SchemaVersion: SchemaVersion, Type: ArtifactType, Result: CustomEvidenceResult{RepoPath: "repo/app.tgz", Evidence: entries}})
+	require.NoError(t, err)
+	return output
+}
+
+func readIndex(t *testing.T, dir string) DownloadIndex {
+	content, err := os.ReadFile(filepath.Join(dir, DownloadIndexFileName))
+	require.NoError(t, err)
+	var index DownloadIndex
+	require.NoError(t, json.Unmarshal(content, &index))
+	return index
+}
+
+func TestDownloadEvidenceFiles(t *testing.T) {
+	dir := t.TempDir()
+	client := &mockRemoteFileReader{files: map[string]string{
+		"repo/.evidence/app.tgz/scan-1.json": `{"payload":"..."}`,
+		"attachments/report.html":            "<html>report</html>",
+		"attachments/other/report.html":      "<html>other</html>",
+	}}
+	g := &getEvidenceBase{downloadDir: dir, downloadEnvelopes: true, downloadThreads: 2, artifactoryClient: client}
+
+	output := customOutput(t, EvidenceEntry{
+		PredicateSlug: "scan",
+		PredicateType: "https://example.com/scan/v1",
+		DownloadPath:  "repo/.evidence/app.tgz/scan-1.json",
+		Attachments: []EvidenceAttachment{
+			{Name: "report.html", Sha256: sha256Hex("<html>report</html>"), DownloadPath: "attachments/report.html"},
+			{Name: "report.html", Sha256: sha256Hex("<html>other</html>"), DownloadPath: "attachments/other/report.html"},
+		},
+	})
+	require.NoError(t, g.downloadEvidenceFiles(output))
+
+	index := readIndex(t, dir)
+	assert.Equal(t, DownloadIndexSchemaVersion, index.SchemaVersion)
+	require.Len(t, index.Evidence, 1)
+	evidence := index.Evidence[0]
+	assert.Equal(t, "repo/.evidence/app.tgz/scan-1/envelope.json", evidence.Envelope)
+	require.Len(t, evidence.Attachments, 2)
+	assert.Equal(t, "repo/.evidence/app.tgz/scan-1/attachments/report.html", evidence.Attachments[0].File)
+	assert.Equal(t, "repo/.evidence/app.tgz/scan-1/attachments/"+sha256Hex("<html>other</html>")[:12]+"-report.html", evidence.Attachments[1].File)
+
+	for file, expected := range map[string]string{
+		evidence.Envelope:            `{"payload":"..."}`,
+		evidence.Attachments[0].File: "<html>report</html>",
+		evidence.Attachments[1].File: "<html>other</html>",
+	} {
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+}
+
+func TestDownloadEvidenceFiles_ChecksumMismatch(t *testing.T) {
+	dir := t.TempDir()
+	client := &mockRemoteFileReader{files: map[string]string{"attachments/report.html": "tampered"}}
+	g := &getEvidenceBase{downloadDir: dir, artifactoryClient: client}
+
+	output := customOutput(t, EvidenceEntry{
+		DownloadPath: "repo/.evidence/app.tgz/scan-1.json",
+		Attachments:  []EvidenceAttachment{{Name: "report.html", Sha256: sha256Hex("original"), DownloadPath: "attachments/report.html"}},
+	})
+	err := g.downloadEvidenceFiles(output)
+	assert.ErrorContains(t, err, "checksum mismatch for attachments/report.html")
+
+	// Neither the mismatching file nor the index is left behind.
+	assert.NoFileExists(t, filepath.Join(dir, "repo", ".evidence", "app.tgz", "scan-1", "attachments", "report.html"))
+	assert.NoFileExists(t, filepath.Join(dir, "repo", ".evidence", "app.tgz", "scan-1", "attachments", "report.html"+partialFileSuffix))
+	assert.NoFileExists(t, filepath.Join(dir, DownloadIndexFileName))
+}
+
+func TestDownloadEvidenceFiles_WithoutEnvelopes(t *testing.T) {
+	dir := t.TempDir()
+	client := &mockRemoteFileReader{}
+	g := &getEvidenceBase{downloadDir: dir, artifactoryClient: client}
+
+	require.NoError(t, g.downloadEvidenceFiles(customOutput(t, EvidenceEntry{PredicateSlug: "scan", DownloadPath: "repo/.evidence/scan-1.json"})))
+	assert.Empty(t, client.reads)
+	index := readIndex(t, dir)
+	require.Len(t, index.Evidence, 1)
+	assert.Empty(t, index.Evidence[0].Envelope)
+	assert.Empty(t, index.Evidence[0].Attachments)
+}
+
+func TestDownloadFiles_BoundedParallelism(t *testing.T) {
+	dir := t.TempDir()
+	client := &mockRemoteFileReader{files: map[string]string{}, delay: 10 * time.Millisecond}
+	var tasks []downloadTask
+	for i := 0; i < 12; i++ {
+		remotePath := fmt.Sprintf("attachments/%d", i)
+		client.files[remotePath] = remotePath
+		tasks = append(tasks, downloadTask{remotePath: remotePath, localPath: filepath.Join(dir, fmt.Sprint(i)), expectedSha256: sha256Hex(remotePath)})
+	}
+	require.NoError(t, downloadFiles(client, tasks, 3))
+	assert.Len(t, client.reads, 12)
+	assert.LessOrEqual(t, client.maxSeen.Load(), int32(3))
+	assert.Greater(t, client.maxSeen.Load(), int32(1))
+}
+
+func TestDownloadFiles_ReportsEveryFailure(t *testing.T) {
+	dir := t.TempDir()
+	client := &mockRemoteFileReader{files: map[string]string{"ok": "ok"}}
+	tasks := []downloadTask{
+		{remotePath: "missing-1", localPath: filepath.Join(dir, "1")},
+		{remotePath: "ok", localPath: filepath.Join(dir, "ok")},
+		{remotePath: "missing-2", localPath: filepath.Join(dir, "2")},
+	}
+	err := downloadFiles(client, tasks, 2)
+	assert.ErrorContains(t, err, "failed to download missing-1")
+	assert.ErrorContains(t, err, "failed to download missing-2")
+	assert.FileExists(t, filepath.Join(dir, "ok"))
+}
+
+func TestEvidenceEntries_ReleaseBundle(t *testing.T) {
+	output, err := json.Marshal(ReleaseBundleOutput{
+		SchemaVersion: SchemaVersion,
+		Type:          ReleaseBundleType,
+		Result: ReleaseBundleResult{
+			Evidence:  []EvidenceEntry{{DownloadPath: "rb/evidence.json"}},
+			Artifacts: []ArtifactEvidence{{Evidence: EvidenceEntry{DownloadPath: "artifact/evidence.json"}}},
+			Builds:    []BuildEvidence{{Evidence: EvidenceEntry{DownloadPath: "build/evidence.json"}}},
+		},
+	})
+	require.NoError(t, err)
+
+	entries, err := evidenceEntries(output)
+	require.NoError(t, err)
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		paths = append(paths, entry.DownloadPath)
+	}
+	assert.Equal(t, []string{"rb/evidence.json", "artifact/evidence.json", "build/evidence.json"}, paths)
+}
+
+func TestPlanDownload_Layout(t *testing.T) {
+	g := &getEvidenceBase{downloadDir: "out", downloadEnvelopes: true}
+	index, tasks := g.planDownload([]EvidenceEntry{
+		{DownloadPath: "../../etc/evidence.json", Attachments: []EvidenceAttachment{{Name: "../passwd", Sha256: "abc", DownloadPath: "a/passwd"}}},
+		{DownloadPath: "../../etc/evidence.json"},
+		{PredicateSlug: "no-path", Attachments: []EvidenceAttachment{{Name: "", Sha256: "def", DownloadPath: "a/def"}}},
+	})
+	require.Len(t, index.Evidence, 2)
+	assert.Equal(t, "etc/evidence/envelope.json", index.Evidence[0].Envelope)
+	assert.Equal(t, "etc/evidence/attachments/passwd", index.Evidence[0].Attachments[0].File)
+	assert.Equal(t, "evidence-3/attachments/def", index.Evidence[1].Attachments[0].File)
+	assert.Empty(t, index.Evidence[1].Envelope)
+	require.Len(t, tasks, 3)
+	assert.Equal(t, filepath.Join("out", "etc", "evidence", "envelope.json"), tasks[0].localPath)
+	assert.Empty(t, tasks[0].expectedSha256)
+	assert.Equal(t, "abc", tasks[1].expectedSha256)
+}
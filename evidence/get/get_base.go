@@ -20,10 +20,14 @@ const (
 )
 
 type getEvidenceBase struct {
-	serverDetails    *config.ServerDetails
-	outputFileName   string
-	format           string
-	includePredicate bool
+	serverDetails     *config.ServerDetails
+	outputFileName    string
+	format            string
+	includePredicate  bool
+	downloadDir       string
+	downloadEnvelopes bool
+	downloadThreads   int
+	artifactoryClient remoteFileReader
 }
 
 type JsonlLine struct {
@@ -88,8 +92,10 @@ func (g *getEvidenceBase) exportEvidenceToFile(evidence []byte, outputFileName,
 		return exportEvidenceToJsonFile(evidence, outputFileName)
 	case "jsonl":
 		return exportEvidenceToJsonlFile(evidence, outputFileName)
+	case "html":
+		return exportEvidenceToHtmlFile(evidence, outputFileName)
 	default:
-		log.Error("Unsupported format. Supported formats are: json, jsonl")
+		log.Error("Unsupported format. Supported formats are: json, jsonl, html")
 		return fmt.Errorf("unsupported format: %s", format)
 	}
 }
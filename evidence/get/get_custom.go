@@ -28,8 +28,8 @@ type CustomEvidenceOutput struct {
 	Result        CustomEvidenceResult `json:"result"`
 }
 
-func NewGetEvidenceCustom(serverDetails *config.ServerDetails, subjectRepoPath, format, outputFileName string, includePredicate bool) evidence.Command {
-	return &getEvidenceCustom{
+func NewGetEvidenceCustom(serverDetails *config.ServerDetails, subjectRepoPath, format, outputFileName string, includePredicate bool, opts ...GetOption) evidence.Command {
+	g := &getEvidenceCustom{
 		getEvidenceBase: getEvidenceBase{
 			serverDetails:    serverDetails,
 			format:           format,
@@ -38,6 +38,10 @@ func NewGetEvidenceCustom(serverDetails *config.ServerDetails, subjectRepoPath,
 		},
 		subjectRepoPath: subjectRepoPath,
 	}
+	for _, opt := range opts {
+		opt(&g.getEvidenceBase)
+	}
+	return g
 }
 
 func (g *getEvidenceCustom) CommandName() string {
@@ -62,7 +66,13 @@ func (g *getEvidenceCustom) Run() error {
 		return fmt.Errorf("evidence retrieval failed: %w", err)
 	}
 
-	return g.exportEvidenceToFile(evidence, g.outputFileName, g.format)
+	if err = g.exportEvidenceToFile(evidence, g.outputFileName, g.format); err != nil {
+		return err
+	}
+	if g.downloadDir != "" {
+		return g.downloadEvidenceFiles(evidence)
+	}
+	return nil
 }
 
 func (g *getEvidenceCustom) getEvidence(onemodelClient onemodel.Manager) ([]byte, error) {
@@ -0,0 +1,153 @@
+package get
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/htmlreport"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+func exportEvidenceToHtmlFile(data []byte, outputFileName string) error {
+	if outputFileName == "" {
+		return writeEvidenceHtml(data, os.Stdout)
+	}
+
+	file, err := os.Create(outputFileName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if err = writeEvidenceHtml(data, file); err != nil {
+		return err
+	}
+	log.Info("Evidence successfully exported to file name: ", outputFileName)
+	return nil
+}
+
+// writeEvidenceHtml renders the get evidence output as a standalone HTML report.
+func writeEvidenceHtml(data []byte, w io.Writer) error {
+	var evidenceOutput map[string]any
+	if err := json.Unmarshal(data, &evidenceOutput); err != nil {
+		return fmt.Errorf("failed to parse evidence output: %w", err)
+	}
+	typeString, _ := evidenceOutput["type"].(string)
+
+	var doc htmlreport.Document
+	if SubjectType(typeString) == ReleaseBundleType {
+		var releaseBundleOutput ReleaseBundleOutput
+		if err := json.Unmarshal(data, &releaseBundleOutput); err != nil {
+			return fmt.Errorf("failed to parse release bundle output: %w", err)
+		}
+		doc = releaseBundleHtmlDocument(releaseBundleOutput.Result)
+	} else {
+		var customEvidenceOutput CustomEvidenceOutput
+		if err := json.Unmarshal(data, &customEvidenceOutput); err != nil {
+			return fmt.Errorf("failed to parse custom evidence output: %w", err)
+		}
+		doc = customEvidenceHtmlDocument(customEvidenceOutput.Result)
+	}
+	return htmlreport.Render(w, doc)
+}
+
+func customEvidenceHtmlDocument(result CustomEvidenceResult) htmlreport.Document {
+	return htmlreport.Document{
+		Title:   "Evidence Report",
+		Subject: []htmlreport.Field{{Name: "Subject repo path", Value: result.RepoPath}},
+		Sections: []htmlreport.Section{{
+			Title:    "Evidence",
+			Evidence: toHtmlEvidenceList(result.Evidence),
+		}},
+	}
+}
+
+func releaseBundleHtmlDocument(result ReleaseBundleResult) htmlreport.Document {
+	doc := htmlreport.Document{
+		Title: "Release Bundle Evidence Report",
+		Subject: []htmlreport.Field{
+			{Name: "Release bundle", Value: result.ReleaseBundle},
+			{Name: "Release bundle version", Value: result.ReleaseBundleVersion},
+		},
+		Sections: []htmlreport.Section{{
+			Title:    "Release bundle evidence",
+			Evidence: toHtmlEvidenceList(result.Evidence),
+		}},
+	}
+	for _, artifact := range result.Artifacts {
+		doc.Sections = append(doc.Sections, htmlreport.Section{
+			Title:    "Artifact: " + artifact.RepoPath,
+			Fields:   htmlreport.NonEmpty(htmlreport.Field{Name: "Package type", Value: artifact.PackageType}),
+			Evidence: []htmlreport.Evidence{toHtmlEvidence(artifact.Evidence)},
+		})
+	}
+	for _, build := range result.Builds {
+		doc.Sections = append(doc.Sections, htmlreport.Section{
+			Title:    fmt.Sprintf("Build: %s/%s", build.BuildName, build.BuildNumber),
+			Fields:   htmlreport.NonEmpty(htmlreport.Field{Name: "Started at", Value: build.StartedAt}),
+			Evidence: []htmlreport.Evidence{toHtmlEvidence(build.Evidence)},
+		})
+	}
+	return doc
+}
+
+func toHtmlEvidenceList(entries []EvidenceEntry) []htmlreport.Evidence {
+	evidence := make([]htmlreport.Evidence, 0, len(entries))
+	for _, entry := range entries {
+		evidence = append(evidence, toHtmlEvidence(entry))
+	}
+	return evidence
+}
+
+func toHtmlEvidence(entry EvidenceEntry) htmlreport.Evidence {
+	title := entry.PredicateType
+	if title == "" {
+		title = entry.PredicateSlug
+	}
+	status := htmlreport.StatusFailed
+	if entry.Verified {
+		status = htmlreport.StatusSuccess
+	}
+	evidence := htmlreport.Evidence{
+		Title:  title,
+		Status: status,
+		Fields: htmlreport.NonEmpty(
+			htmlreport.Field{Name: "Predicate type", Value: entry.PredicateType},
+			htmlreport.Field{Name: "Predicate slug", Value: entry.PredicateSlug},
+			htmlreport.Field{Name: "Download path", Value: entry.DownloadPath},
+			htmlreport.Field{Name: "Created by", Value: entry.CreatedBy},
+			htmlreport.Field{Name: "Created at", Value: entry.CreatedAt},
+		),
+	}
+	if sha256, ok := entry.Subject["sha256"].(string); ok {
+		evidence.Fields = append(evidence.Fields, htmlreport.Field{Name: "Subject sha256", Value: sha256})
+	}
+	for _, key := range sortedKeys(entry.SigningKey) {
+		evidence.Fields = append(evidence.Fields, htmlreport.Field{Name: "Signing key " + key, Value: fmt.Sprintf("%v", entry.SigningKey[key])})
+	}
+	for _, attachment := range entry.Attachments {
+		evidence.Attachments = append(evidence.Attachments, htmlreport.Attachment{
+			Name:         attachment.Name,
+			Sha256:       attachment.Sha256,
+			DownloadPath: attachment.DownloadPath,
+		})
+	}
+	if entry.Predicate != nil {
+		evidence.Predicate = entry.Predicate
+	}
+	return evidence
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
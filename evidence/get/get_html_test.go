@@ -0,0 +1,83 @@
+package get
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportEvidenceToHtmlFile_CustomEvidence(t *testing.T) {
+	data, err := json.Marshal(CustomEvidenceOutput{
+		SchemaVersion: SchemaVersion,
+		Type:          ArtifactType,
+		Result: CustomEvidenceResult{
+			RepoPath: "generic-local/app.tgz",
+			Evidence: []EvidenceEntry{{
+				PredicateType: "https://slsa.dev/provenance/v1",
+				PredicateSlug: "provenance",
+				DownloadPath:  "generic-local/.evidence/provenance.json",
+				Verified:      true,
+				SigningKey:    map[string]any{"alias": "ci-key"},
+				Subject:       map[string]any{"sha256": "abc"},
+				CreatedBy:     "ci-user",
+				CreatedAt:     "2025-01-01T00:00:00.000Z",
+				Predicate:     map[string]any{"builder": map[string]any{"id": "ci"}},
+				Attachments:   []EvidenceAttachment{{Name: "sbom.json", Sha256: "123", DownloadPath: "generic-local/sbom.json"}},
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	outputFile := path.Join(t.TempDir(), "evidence.html")
+	g := &getEvidenceBase{}
+	require.NoError(t, g.exportEvidenceToFile(data, outputFile, "html"))
+
+	content, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	out := string(content)
+	assert.Contains(t, out, "<title>Evidence Report</title>")
+	assert.Contains(t, out, "generic-local/app.tgz")
+	assert.Contains(t, out, "https://slsa.dev/provenance/v1")
+	assert.Contains(t, out, "ci-key")
+	assert.Contains(t, out, "sbom.json")
+	assert.Contains(t, out, `<span class="json-key">builder</span>`)
+	assert.Contains(t, out, `<span class="badge badge-success">success</span>`)
+}
+
+func TestExportEvidenceToHtmlFile_ReleaseBundle(t *testing.T) {
+	data, err := json.Marshal(ReleaseBundleOutput{
+		SchemaVersion: SchemaVersion,
+		Type:          ReleaseBundleType,
+		Result: ReleaseBundleResult{
+			ReleaseBundle:        "my-rb",
+			ReleaseBundleVersion: "1.0.0",
+			Evidence:             []EvidenceEntry{{PredicateSlug: "approval", CreatedBy: "qa"}},
+			Artifacts:            []ArtifactEvidence{{RepoPath: "repo/app.tgz", PackageType: "generic", Evidence: EvidenceEntry{PredicateSlug: "sbom"}}},
+			Builds:               []BuildEvidence{{BuildName: "build", BuildNumber: "7", Evidence: EvidenceEntry{PredicateSlug: "provenance"}}},
+		},
+	})
+	require.NoError(t, err)
+
+	outputFile := path.Join(t.TempDir(), "evidence.html")
+	g := &getEvidenceBase{}
+	require.NoError(t, g.exportEvidenceToFile(data, outputFile, "html"))
+
+	content, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	out := string(content)
+	assert.Contains(t, out, "<title>Release Bundle Evidence Report</title>")
+	assert.Contains(t, out, "my-rb")
+	assert.Contains(t, out, "Artifact: repo/app.tgz")
+	assert.Contains(t, out, "Build: build/7")
+	assert.Contains(t, out, "approval")
+}
+
+func TestExportEvidenceToHtmlFile_InvalidJson(t *testing.T) {
+	g := &getEvidenceBase{}
+	err := g.exportEvidenceToFile([]byte("not json"), path.Join(t.TempDir(), "evidence.html"), "html")
+	assert.ErrorContains(t, err, "failed to parse evidence output")
+}
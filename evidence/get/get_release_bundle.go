@@ -33,8 +33,8 @@ type ReleaseBundleOutput struct {
 }
 
 func NewGetEvidenceReleaseBundle(serverDetails *config.ServerDetails,
-	releaseBundle, releaseBundleVersion, project, format, outputFileName, artifactsLimit string, includePredicate bool) evidence.Command {
-	return &getEvidenceReleaseBundle{
+	releaseBundle, releaseBundleVersion, project, format, outputFileName, artifactsLimit string, includePredicate bool, opts ...GetOption) evidence.Command {
+	g := &getEvidenceReleaseBundle{
 		getEvidenceBase: getEvidenceBase{
 			serverDetails:    serverDetails,
 			outputFileName:   outputFileName,
@@ -46,6 +46,10 @@ func NewGetEvidenceReleaseBundle(serverDetails *config.ServerDetails,
 		releaseBundleVersion: releaseBundleVersion,
 		artifactsLimit:       artifactsLimit,
 	}
+	for _, opt := range opts {
+		opt(&g.getEvidenceBase)
+	}
+	return g
 }
 
 func (g *getEvidenceReleaseBundle) CommandName() string {
@@ -73,6 +77,9 @@ func (g *getEvidenceReleaseBundle) Run() error {
 		return err
 	}
 
+	if g.downloadDir != "" {
+		return g.downloadEvidenceFiles(evidenceRecords)
+	}
 	return nil
 }
 
@@ -0,0 +1,15 @@
+package get
+
+// GetOption customizes optional behaviour of the get commands shared across all subject types.
+type GetOption func(*getEvidenceBase)
+
+// WithAttachmentDownload downloads the attachments of every evidence found into dir, using up to threads
+// parallel downloads, and writes an index of the downloaded files. With includeEnvelopes the DSSE
+// envelope of each evidence is downloaded as well.
+func WithAttachmentDownload(dir string, includeEnvelopes bool, threads int) GetOption {
+	return func(g *getEvidenceBase) {
+		g.downloadDir = dir
+		g.downloadEnvelopes = includeEnvelopes
+		g.downloadThreads = threads
+	}
+}
@@ -0,0 +1,247 @@
+// Package htmlreport renders evidence reports as a single self-contained HTML page.
+// The page embeds its own styles and uses native <details> elements for collapsible
+// content, so it can be opened in a browser without network access.
+package htmlreport
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// Status values rendered as colored badges.
+const (
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+)
+
+// Document is the content of a report page.
+type Document struct {
+	Title       string
+	Status      string
+	Subject     []Field
+	Sections    []Section
+	GeneratedAt time.Time
+}
+
+// Section groups evidence, for example the evidence of a single subject in a release bundle.
+type Section struct {
+	Title    string
+	Fields   []Field
+	Evidence []Evidence
+	Results  []Result
+}
+
+// Evidence describes a single evidence entry.
+type Evidence struct {
+	Title       string
+	Status      string
+	Fields      []Field
+	Attachments []Attachment
+	Checks      []Result
+	// Predicate is rendered as collapsible JSON when set.
+	Predicate any
+	// Markdown is the predicate markdown rendered inline. Raw HTML in it is dropped.
+	Markdown string
+}
+
+type Field struct {
+	Name  string
+	Value string
+}
+
+type Attachment struct {
+	Name          string
+	Sha256        string
+	DownloadPath  string
+	Status        string
+	FailureReason string
+}
+
+// Result is a named check outcome with optional details.
+type Result struct {
+	Name    string
+	Status  string
+	Details []string
+}
+
+// Render writes the document as a standalone HTML page.
+func Render(w io.Writer, doc Document) error {
+	if doc.GeneratedAt.IsZero() {
+		doc.GeneratedAt = time.Now()
+	}
+	return pageTemplate.Execute(w, doc)
+}
+
+// NonEmpty returns the fields that have a value, preserving their order.
+func NonEmpty(fields ...Field) []Field {
+	result := make([]Field, 0, len(fields))
+	for _, field := range fields {
+		if field.Value != "" {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
+// ParseJSON decodes raw JSON for use as an Evidence predicate. Invalid JSON is returned as a string.
+func ParseJSON(raw []byte) any {
+	var value any
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+	return value
+}
+
+var pageTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"json":     renderJSON,
+	"markdown": renderMarkdown,
+	"badge":    renderBadge,
+	"time":     func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+}).Parse(pageHTML))
+
+func renderMarkdown(markdown string) template.HTML {
+	renderer := blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{
+		Flags: blackfriday.SkipHTML | blackfriday.Safelink | blackfriday.NofollowLinks | blackfriday.NoreferrerLinks | blackfriday.HrefTargetBlank,
+	})
+	// #nosec G203 -- the renderer escapes text content and SkipHTML drops raw HTML, so the output is safe to embed.
+	return template.HTML(blackfriday.Run([]byte(markdown), blackfriday.WithRenderer(renderer)))
+}
+
+func renderBadge(status string) template.HTML {
+	if status == "" {
+		return ""
+	}
+	class := "badge-failed"
+	if status == StatusSuccess {
+		class = "badge-success"
+	}
+	// #nosec G203 -- the status is escaped.
+	return template.HTML(fmt.Sprintf(`<span class="badge %s">%s</span>`, class, template.HTMLEscapeString(status)))
+}
+
+// renderJSON renders a decoded JSON value as nested collapsible lists. Only the top level is expanded.
+func renderJSON(value any) template.HTML {
+	var b strings.Builder
+	b.WriteString(`<div class="json">`)
+	writeJSONValue(&b, "predicate", value, 0)
+	b.WriteString(`</div>`)
+	// #nosec G203 -- every key and value is escaped in writeJSONValue.
+	return template.HTML(b.String())
+}
+
+func writeJSONValue(b *strings.Builder, key string, value any, depth int) {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		openCollapsible(b, key, fmt.Sprintf("{%d}", len(v)), depth)
+		for _, k := range keys {
+			writeJSONValue(b, k, v[k], depth+1)
+		}
+		b.WriteString("</ul></details></li>")
+	case []any:
+		openCollapsible(b, key, fmt.Sprintf("[%d]", len(v)), depth)
+		for i, item := range v {
+			writeJSONValue(b, fmt.Sprintf("%d", i), item, depth+1)
+		}
+		b.WriteString("</ul></details></li>")
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			encoded = []byte(fmt.Sprintf("%v", v))
+		}
+		fmt.Fprintf(b, `<li><span class="json-key">%s</span>: <span class="json-%s">%s</span></li>`,
+			template.HTMLEscapeString(key), jsonKind(v), template.HTMLEscapeString(string(encoded)))
+	}
+}
+
+func openCollapsible(b *strings.Builder, key, size string, depth int) {
+	open := ""
+	if depth == 0 {
+		open = " open"
+	}
+	fmt.Fprintf(b, `<li><details%s><summary><span class="json-key">%s</span> <span class="json-size">%s</span></summary><ul>`,
+		open, template.HTMLEscapeString(key), size)
+}
+
+func jsonKind(value any) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64, json.Number:
+		return "number"
+	case bool:
+		return "bool"
+	default:
+		return "null"
+	}
+}
+
+const pageHTML = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2rem; color: #1f2328; background: #fff; }
+h1 { font-size: 1.6rem; } h2 { font-size: 1.3rem; border-bottom: 1px solid #d0d7de; padding-bottom: .3rem; } h3 { font-size: 1.1rem; margin: 0 0 .5rem; }
+table.fields { border-collapse: collapse; margin: .5rem 0; } table.fields th, table.fields td { text-align: left; padding: .2rem .8rem .2rem 0; vertical-align: top; }
+table.fields th { color: #57606a; font-weight: 600; white-space: nowrap; }
+table.grid { border-collapse: collapse; margin: .5rem 0; } table.grid th, table.grid td { border: 1px solid #d0d7de; padding: .3rem .6rem; text-align: left; }
+code, .json { font-family: ui-monospace, SFMono-Regular, Menlo, Consolas, monospace; font-size: .85rem; word-break: break-all; }
+.evidence { border: 1px solid #d0d7de; border-radius: 6px; padding: 1rem; margin: 1rem 0; }
+.badge { display: inline-block; padding: 0 .5rem; border-radius: 1rem; font-size: .8rem; font-weight: 600; }
+.badge-success { background: #dafbe1; color: #1a7f37; } .badge-failed { background: #ffebe9; color: #cf222e; }
+.markdown { border-left: 3px solid #d0d7de; padding-left: 1rem; margin: .5rem 0; }
+.json ul { list-style: none; padding-left: 1.2rem; margin: 0; } .json > li, .json { list-style: none; }
+.json summary { cursor: pointer; } .json-key { color: #0550ae; } .json-string { color: #0a3069; } .json-number, .json-bool, .json-null { color: #953800; } .json-size { color: #57606a; }
+footer { margin-top: 2rem; color: #57606a; font-size: .8rem; }
+</style>
+</head>
+<body>
+<h1>{{.Title}} {{badge .Status}}</h1>
+{{if .Subject}}<table class="fields">{{range .Subject}}<tr><th>{{.Name}}</th><td><code>{{.Value}}</code></td></tr>{{end}}</table>{{end}}
+{{range .Sections}}
+<section>
+{{if .Title}}<h2>{{.Title}}</h2>{{end}}
+{{if .Fields}}<table class="fields">{{range .Fields}}<tr><th>{{.Name}}</th><td><code>{{.Value}}</code></td></tr>{{end}}</table>{{end}}
+{{if .Results}}
+<table class="grid"><tr><th>Check</th><th>Status</th><th>Details</th></tr>
+{{range .Results}}<tr><td>{{.Name}}</td><td>{{badge .Status}}</td><td>{{range .Details}}{{.}}<br>{{end}}</td></tr>{{end}}
+</table>
+{{end}}
+{{range .Evidence}}
+<div class="evidence">
+<h3>{{.Title}} {{badge .Status}}</h3>
+<table class="fields">{{range .Fields}}<tr><th>{{.Name}}</th><td><code>{{.Value}}</code></td></tr>{{end}}</table>
+{{if .Checks}}
+<table class="grid"><tr><th>Check</th><th>Status</th><th>Details</th></tr>
+{{range .Checks}}<tr><td>{{.Name}}</td><td>{{badge .Status}}</td><td>{{range .Details}}{{.}}<br>{{end}}</td></tr>{{end}}
+</table>
+{{end}}
+{{if .Attachments}}
+<table class="grid"><tr><th>Attachment</th><th>Sha256</th><th>Download path</th><th>Status</th></tr>
+{{range .Attachments}}<tr><td>{{.Name}}</td><td><code>{{.Sha256}}</code></td><td><code>{{.DownloadPath}}</code></td><td>{{badge .Status}}{{if .FailureReason}} {{.FailureReason}}{{end}}</td></tr>{{end}}
+</table>
+{{end}}
+{{if .Markdown}}<div class="markdown">{{markdown .Markdown}}</div>{{end}}
+{{if .Predicate}}{{json .Predicate}}{{end}}
+</div>
+{{end}}
+</section>
+{{end}}
+<footer>Generated {{time .GeneratedAt}}</footer>
+</body>
+</html>
+`
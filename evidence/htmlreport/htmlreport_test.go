@@ -0,0 +1,83 @@
+package htmlreport
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, doc Document) string {
+	var out bytes.Buffer
+	require.NoError(t, Render(&out, doc))
+	return out.String()
+}
+
+func TestRender(t *testing.T) {
+	out := render(t, Document{
+		Title:       "Evidence Verification Report",
+		Status:      StatusFailed,
+		Subject:     []Field{{Name: "Subject path", Value: "generic-local/app.tgz"}},
+		GeneratedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Sections: []Section{{
+			Title:   "Evidence",
+			Results: []Result{{Name: "required-predicate-type", Status: StatusFailed, Details: []string{"missing"}}},
+			Evidence: []Evidence{{
+				Title:       "https://slsa.dev/provenance/v1",
+				Status:      StatusSuccess,
+				Fields:      []Field{{Name: "Key fingerprint", Value: "abc="}},
+				Checks:      []Result{{Name: "sha256", Status: StatusSuccess}},
+				Attachments: []Attachment{{Name: "sbom.json", Sha256: "123", Status: StatusSuccess}},
+				Predicate:   ParseJSON([]byte(`{"builder":{"id":"ci"},"steps":[1,true,null]}`)),
+				Markdown:    "# Build summary\n\n**passed**",
+			}},
+		}},
+	})
+
+	assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	assert.Contains(t, out, "<title>Evidence Verification Report</title>")
+	assert.Contains(t, out, `<span class="badge badge-failed">failed</span>`)
+	assert.Contains(t, out, "generic-local/app.tgz")
+	assert.Contains(t, out, "required-predicate-type")
+	assert.Contains(t, out, "sbom.json")
+	assert.Contains(t, out, "<h1>Build summary</h1>")
+	assert.Contains(t, out, "<strong>passed</strong>")
+	assert.Contains(t, out, `<details open><summary><span class="json-key">predicate</span> <span class="json-size">{2}</span></summary>`)
+	assert.Contains(t, out, `<span class="json-key">id</span>: <span class="json-string">&#34;ci&#34;</span>`)
+	assert.Contains(t, out, `<span class="json-size">[3]</span>`)
+	assert.Contains(t, out, `<span class="json-bool">true</span>`)
+	assert.Contains(t, out, "Generated 2025-01-02T03:04:05Z")
+	// Self-contained: no external scripts, stylesheets or images
+	assert.NotContains(t, out, "<script")
+	assert.NotContains(t, out, "<link")
+	assert.NotContains(t, out, "src=")
+}
+
+func TestRender_EscapesContent(t *testing.T) {
+	out := render(t, Document{
+		Title: "<b>title</b>",
+		Sections: []Section{{Evidence: []Evidence{{
+			Title:     "<script>alert(1)</script>",
+			Predicate: map[string]any{"<img>": "<script>x</script>"},
+			Markdown:  "hello <script>alert(1)</script> [link](javascript:alert(1))",
+		}}}},
+	})
+
+	assert.NotContains(t, out, "<script>")
+	assert.NotContains(t, out, "<img>")
+	assert.NotContains(t, out, "javascript:")
+	assert.Contains(t, out, "&lt;script&gt;alert(1)&lt;/script&gt;")
+}
+
+func TestNonEmpty(t *testing.T) {
+	fields := NonEmpty(Field{Name: "a", Value: "1"}, Field{Name: "b"}, Field{Name: "c", Value: "3"})
+	assert.Equal(t, []Field{{Name: "a", Value: "1"}, {Name: "c", Value: "3"}}, fields)
+}
+
+func TestParseJSON(t *testing.T) {
+	assert.Equal(t, map[string]any{"a": float64(1)}, ParseJSON([]byte(`{"a":1}`)))
+	assert.Equal(t, "not json", ParseJSON([]byte("not json")))
+}
@@ -0,0 +1,54 @@
+package integrations
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cicontext"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const CiContextName = "ci-context"
+
+// ciContextIntegration records the CI run the command runs in, read from the platform environment variables.
+type ciContextIntegration struct {
+	getenv cicontext.Getenv
+}
+
+func NewCiContext() Integration {
+	return &ciContextIntegration{getenv: os.Getenv}
+}
+
+func (c *ciContextIntegration) Name() string {
+	return CiContextName
+}
+
+func (c *ciContextIntegration) Flags() []string {
+	return nil
+}
+
+func (c *ciContextIntegration) ValidateFlags(flagReader FlagReader) error {
+	return rejectPredicateFlags(flagReader, CiContextName)
+}
+
+func (c *ciContextIntegration) Resolve(_ *Request) (*Result, error) {
+	predicate, err := cicontext.Detect(c.getenv)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	log.Info("Recording", predicate.Platform, "run", predicate.RunId)
+	predicateJson, err := json.Marshal(predicate)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &Result{Predicate: predicateJson, PredicateType: cicontext.PredicateType, Markdown: predicate.Markdown()}, nil
+}
+
+func (c *ciContextIntegration) DefaultPredicateType() string {
+	return cicontext.PredicateType
+}
+
+func (c *ciContextIntegration) ProviderId() string {
+	return ""
+}
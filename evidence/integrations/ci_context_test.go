@@ -0,0 +1,35 @@
+package integrations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cicontext"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCiContext_ValidateFlags(t *testing.T) {
+	integration := NewCiContext()
+	assert.NoError(t, integration.ValidateFlags(flagValues{}))
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.PredicateType: "t"}), "--predicate-type cannot be used together with --integration ci-context")
+}
+
+func TestCiContext_Resolve(t *testing.T) {
+	env := map[string]string{"GITLAB_CI": "true", "CI_PIPELINE_ID": "456", "CI_COMMIT_SHA": "def"}
+	integration := &ciContextIntegration{getenv: func(key string) string { return env[key] }}
+
+	result, err := integration.Resolve(&Request{})
+	require.NoError(t, err)
+	assert.Equal(t, cicontext.PredicateType, result.PredicateType)
+	var predicate cicontext.Predicate
+	require.NoError(t, json.Unmarshal(result.Predicate, &predicate))
+	assert.Equal(t, cicontext.PlatformGitLabCI, predicate.Platform)
+	assert.Equal(t, "def", predicate.Commit)
+	assert.Contains(t, string(result.Markdown), "# CI run context: gitlab-ci")
+
+	env = nil
+	_, err = integration.Resolve(&Request{})
+	assert.ErrorContains(t, err, "no supported CI platform detected")
+}
@@ -0,0 +1,150 @@
+package integrations
+
+import (
+	"sort"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	evdConfig "github.com/jfrog/jfrog-cli-evidence/evidence/config"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// FlagReader exposes the create command flags an integration validates.
+type FlagReader interface {
+	GetStringFlagValue(flagName string) string
+	GetBoolFlagValue(flagName string) bool
+	IsFlagSet(flagName string) bool
+}
+
+// Request carries the subject context an integration may need to generate its evidence.
+type Request struct {
+	// Flags gives access to the create command flags, including the ones owned by the integration.
+	Flags   FlagReader
+	Project string
+	// BuildInfo is the published build-info, set only when evidence is created for a build.
+	BuildInfo *buildinfo.BuildInfo
+	// GitCommits lists the commits since the previous build, set only when evidence is created for a build.
+	GitCommits func() ([]model.GitLogEntry, error)
+}
+
+// Result is the evidence content generated by an integration. Either Statement, a complete in-toto
+// statement whose subject is replaced by the evidence subject, or Predicate is set.
+type Result struct {
+	Statement     []byte
+	Predicate     []byte
+	PredicateType string
+	// Markdown is used as the evidence markdown unless the user provides --markdown.
+	Markdown []byte
+}
+
+// Integration generates evidence content from an external system instead of a user supplied predicate.
+type Integration interface {
+	// Name is the value selecting the integration in --integration.
+	Name() string
+	// Flags lists flags owned by the integration; they are rejected unless the integration is selected.
+	Flags() []string
+	// ValidateFlags checks the create command flags when the integration is selected.
+	ValidateFlags(flags FlagReader) error
+	// Resolve generates the statement or predicate of the evidence.
+	Resolve(request *Request) (*Result, error)
+	// DefaultPredicateType is used when the result does not specify a predicate type.
+	DefaultPredicateType() string
+	// ProviderId is recorded as the evidence provider, empty to keep the user supplied value.
+	ProviderId() string
+}
+
+// AttachmentProvider is implemented by integrations that can attach their raw inputs to the evidence.
+// The returned local file goes through the regular --attach-local flow; cleanup removes it afterwards.
+type AttachmentProvider interface {
+	// AttachmentRequested reports whether the flags ask for the attachment.
+	AttachmentRequested(flags FlagReader) bool
+	Attachment(request *Request) (path string, cleanup func(), err error)
+}
+
+// SubjectDigestProvider is implemented by integrations that know the sha256 of the artifact they describe,
+// so a docker:// or oci:// subject can be resolved without --subject-sha256.
+type SubjectDigestProvider interface {
+	SubjectDigest(request *Request) (string, error)
+}
+
+// validateAttachFlag checks that an integration attachment requested by attachFlag can be uploaded:
+// it is added to the user supplied attachments and needs an Artifactory temp path.
+func validateAttachFlag(flagReader FlagReader, attachFlag string) error {
+	if !flagReader.GetBoolFlagValue(attachFlag) {
+		return nil
+	}
+	if flagReader.GetStringFlagValue(flags.AttachArtifactoryTempPath) == "" && evdConfig.ResolveAttachmentArtifactoryTempPath() == "" {
+		return errorutils.CheckErrorf("--%s is required with --%s (or set %s / %s)", flags.AttachArtifactoryTempPath, attachFlag, evdConfig.EnvAttachmentArtifactoryTempPath, evdConfig.KeyAttachmentArtifactoryTempPath)
+	}
+	return nil
+}
+
+var registry = newRegistry(
+	NewSonar(nil),
+	NewSlsaProvenance(),
+	NewJUnit(),
+	NewSarif(),
+	NewVulnScan(),
+	NewCiContext(),
+	NewJira(),
+)
+
+func newRegistry(integrations ...Integration) map[string]Integration {
+	byName := make(map[string]Integration, len(integrations))
+	for _, integration := range integrations {
+		byName[integration.Name()] = integration
+	}
+	return byName
+}
+
+// Get returns the integration registered under name, case-insensitively.
+func Get(name string) (Integration, bool) {
+	integration, ok := registry[strings.ToLower(name)]
+	return integration, ok
+}
+
+// Names returns the names of all registered integrations, sorted.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// AttachmentRequested reports whether the integration registered under name will provide an attachment.
+func AttachmentRequested(name string, flagReader FlagReader) bool {
+	integration, ok := Get(name)
+	if !ok {
+		return false
+	}
+	provider, ok := integration.(AttachmentProvider)
+	return ok && provider.AttachmentRequested(flagReader)
+}
+
+// ValidateFlags checks that the selected integration exists, that flags owned by other integrations are
+// not used and lets the selected integration validate its own flags. An empty name selects no integration.
+func ValidateFlags(name string, flagReader FlagReader) error {
+	selected, ok := Get(name)
+	if name != "" && !ok {
+		return errorutils.CheckErrorf("integration %s does not exist, supported integrations: %s", name, strings.Join(Names(), ", "))
+	}
+	for _, integrationName := range Names() {
+		integration := registry[integrationName]
+		if ok && integration == selected {
+			continue
+		}
+		for _, flag := range integration.Flags() {
+			if flagReader.GetStringFlagValue(flag) != "" || flagReader.GetBoolFlagValue(flag) {
+				return errorutils.CheckErrorf("--%s can be used only with --integration %s", flag, integration.Name())
+			}
+		}
+	}
+	if !ok {
+		return nil
+	}
+	return selected.ValidateFlags(flagReader)
+}
@@ -0,0 +1,73 @@
+package integrations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type flagValues map[string]string
+
+func (f flagValues) GetStringFlagValue(flagName string) string { return f[flagName] }
+
+func (f flagValues) GetBoolFlagValue(flagName string) bool { return f[flagName] == "true" }
+
+func (f flagValues) IsFlagSet(flagName string) bool {
+	_, ok := f[flagName]
+	return ok
+}
+
+func TestGet(t *testing.T) {
+	integration, ok := Get("SLSA-Provenance")
+	require.True(t, ok)
+	assert.Equal(t, SlsaProvenanceName, integration.Name())
+
+	_, ok = Get("unknown")
+	assert.False(t, ok)
+	_, ok = Get("")
+	assert.False(t, ok)
+	assert.Equal(t, []string{CiContextName, JiraName, JUnitName, SarifName, SlsaProvenanceName, SonarName, VulnScanName}, Names())
+}
+
+func TestIntegrationFlagHelpListsEveryIntegration(t *testing.T) {
+	var description string
+	for _, flag := range flags.GetCommandFlags(flags.CreateEvidence) {
+		if flag.GetName() == flags.Integration {
+			description = flag.GetDescription()
+		}
+	}
+	_, supported, found := strings.Cut(description, "Supported: ")
+	require.True(t, found)
+	supported, _, _ = strings.Cut(supported, ".")
+	for _, name := range Names() {
+		assert.Contains(t, supported, "'"+name+"'", "integration %s is missing from the supported list", name)
+	}
+}
+
+func TestValidateFlags(t *testing.T) {
+	assert.NoError(t, ValidateFlags("", flagValues{}))
+
+	err := ValidateFlags("jenkins", flagValues{})
+	assert.ErrorContains(t, err, "integration jenkins does not exist, supported integrations: ci-context, jira, junit, sarif, slsa-provenance, sonar, vuln-scan")
+
+	err = ValidateFlags("", flagValues{flags.ProvenanceTarget: "artifacts"})
+	assert.ErrorContains(t, err, "--provenance-target can be used only with --integration slsa-provenance")
+
+	assert.NoError(t, ValidateFlags(SlsaProvenanceName, flagValues{flags.BuildName: "b", flags.ProvenanceTarget: "artifacts"}))
+}
+
+type fakeResolver struct{ statement []byte }
+
+func (f *fakeResolver) ResolveStatement() ([]byte, error) { return f.statement, nil }
+
+func TestSonar_Resolve(t *testing.T) {
+	integration := NewSonar(&fakeResolver{statement: []byte(`{"predicateType":"x"}`)})
+	result, err := integration.Resolve(nil)
+	require.NoError(t, err)
+	assert.Equal(t, `{"predicateType":"x"}`, string(result.Statement))
+	assert.Equal(t, "sonar", integration.ProviderId())
+	assert.Equal(t, sonarPredicateType, integration.DefaultPredicateType())
+}
@@ -0,0 +1,99 @@
+package integrations
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/jira"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	JiraName     = "jira"
+	jiraUrlEnv   = "JIRA_URL"
+	jiraUserEnv  = "JIRA_USER"
+	jiraTokenEnv = "JIRA_TOKEN"
+)
+
+// jiraIntegration links the commits since the previous build to the tickets their messages reference.
+type jiraIntegration struct {
+	getenv func(string) string
+}
+
+func NewJira() Integration {
+	return &jiraIntegration{getenv: os.Getenv}
+}
+
+func (j *jiraIntegration) Name() string {
+	return JiraName
+}
+
+func (j *jiraIntegration) Flags() []string {
+	return []string{flags.JiraUrl, flags.JiraTicketPattern, flags.JiraApproverField}
+}
+
+func (j *jiraIntegration) ValidateFlags(flagReader FlagReader) error {
+	if flagReader.GetStringFlagValue(flags.BuildName) == "" {
+		return errorutils.CheckErrorf("--%s %s requires a build subject: --%s and --%s", flags.Integration, JiraName, flags.BuildName, flags.BuildNumber)
+	}
+	if flagReader.IsFlagSet(flags.TypeFlag) {
+		return errorutils.CheckErrorf("--%s %s cannot be used together with --%s", flags.Integration, JiraName, flags.TypeFlag)
+	}
+	if err := rejectPredicateFlags(flagReader, JiraName); err != nil {
+		return err
+	}
+	if j.url(flagReader) == "" {
+		return errorutils.CheckErrorf("--%s or the %s environment variable is required with --%s %s", flags.JiraUrl, jiraUrlEnv, flags.Integration, JiraName)
+	}
+	_, err := jira.CompileTicketPattern(flagReader.GetStringFlagValue(flags.JiraTicketPattern))
+	return errorutils.CheckError(err)
+}
+
+func (j *jiraIntegration) Resolve(request *Request) (*Result, error) {
+	if request == nil || request.Flags == nil || request.GitCommits == nil {
+		return nil, errorutils.CheckErrorf("--%s %s requires a published build-info", flags.Integration, JiraName)
+	}
+	pattern, err := jira.CompileTicketPattern(request.Flags.GetStringFlagValue(flags.JiraTicketPattern))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	entries, err := request.GitCommits()
+	if err != nil {
+		return nil, err
+	}
+	jiraUrl := j.url(request.Flags)
+	client, err := jira.NewClient(jiraUrl, j.getenv(jiraUserEnv), j.getenv(jiraTokenEnv), request.Flags.GetStringFlagValue(flags.JiraApproverField))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	log.Info("Linking", len(entries), "commits to", jiraUrl, "tickets")
+	predicate, err := jira.Link(entries, pattern, client, jiraUrl)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if predicate.Result == jira.ResultFailed {
+		log.Warn(len(predicate.UnlinkedCommits), "commits reference no ticket and", len(predicate.MissingTickets), "tickets were not found")
+	}
+	predicateJson, err := json.Marshal(predicate)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &Result{Predicate: predicateJson, PredicateType: jira.PredicateType, Markdown: predicate.Markdown()}, nil
+}
+
+func (j *jiraIntegration) DefaultPredicateType() string {
+	return jira.PredicateType
+}
+
+func (j *jiraIntegration) ProviderId() string {
+	return ""
+}
+
+func (j *jiraIntegration) url(flagReader FlagReader) string {
+	if jiraUrl := flagReader.GetStringFlagValue(flags.JiraUrl); jiraUrl != "" {
+		return jiraUrl
+	}
+	return j.getenv(jiraUrlEnv)
+}
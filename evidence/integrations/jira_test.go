@@ -0,0 +1,76 @@
+package integrations
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/jira"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJira_ValidateFlags(t *testing.T) {
+	env := map[string]string{}
+	integration := &jiraIntegration{getenv: func(key string) string { return env[key] }}
+	build := flagValues{flags.BuildName: "b", flags.BuildNumber: "1", flags.JiraUrl: "https://jira.example.com"}
+	assert.NoError(t, integration.ValidateFlags(build))
+
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.JiraUrl: "https://jira.example.com"}), "--integration jira requires a build subject")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.BuildName: "b"}), "--jira-url or the JIRA_URL environment variable is required with --integration jira")
+	env[jiraUrlEnv] = "https://jira.example.com"
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.BuildName: "b"}))
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.JiraTicketPattern: "("}), "invalid ticket pattern")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.Predicate: "p.json"}), "--predicate cannot be used together with --integration jira")
+
+	assert.ErrorContains(t, ValidateFlags(SarifName, flagValues{flags.SarifFile: "r.sarif", flags.JiraUrl: "u"}), "--jira-url can be used only with --integration jira")
+}
+
+func TestJira_Resolve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer pat", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/rest/api/2/issue/PROJ-1":
+			_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{"status":{"name":"Done"},"issuetype":{"name":"Story"},"customfield_1":[{"displayName":"Jane"}]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	env := map[string]string{jiraTokenEnv: "pat"}
+	integration := &jiraIntegration{getenv: func(key string) string { return env[key] }}
+	request := &Request{
+		Flags: flagValues{flags.JiraUrl: server.URL, flags.JiraApproverField: "customfield_1"},
+		GitCommits: func() ([]model.GitLogEntry, error) {
+			return []model.GitLogEntry{{Commit: "c2", Subject: "Cleanup"}, {Commit: "c1", Subject: "PROJ-1: add login"}}, nil
+		},
+	}
+
+	result, err := integration.Resolve(request)
+	require.NoError(t, err)
+	assert.Equal(t, jira.PredicateType, result.PredicateType)
+	var predicate jira.Predicate
+	require.NoError(t, json.Unmarshal(result.Predicate, &predicate))
+	assert.Equal(t, jira.ResultFailed, predicate.Result)
+	require.Len(t, predicate.Tickets, 1)
+	assert.Equal(t, jira.Ticket{Key: "PROJ-1", Url: server.URL + "/browse/PROJ-1", Found: true, Status: "Done", Type: "Story", Approver: "Jane", Commits: []string{"c1"}}, predicate.Tickets[0])
+	assert.Equal(t, []string{"c2"}, predicate.UnlinkedCommits)
+	assert.Contains(t, string(result.Markdown), "# Ticket linkage: FAILED")
+}
+
+func TestJira_Resolve_Errors(t *testing.T) {
+	integration := &jiraIntegration{getenv: func(string) string { return "" }}
+	_, err := integration.Resolve(&Request{Flags: flagValues{}})
+	assert.ErrorContains(t, err, "--integration jira requires a published build-info")
+
+	_, err = integration.Resolve(&Request{
+		Flags:      flagValues{flags.JiraUrl: "https://jira.example.com"},
+		GitCommits: func() ([]model.GitLogEntry, error) { return nil, errors.New("no previous build") },
+	})
+	assert.EqualError(t, err, "no previous build")
+}
@@ -0,0 +1,125 @@
+package integrations
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/junit"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	JUnitName                  = "junit"
+	junitReportsArchive        = "junit-reports.zip"
+	junitReportsTempDirPattern = "junit-reports-"
+)
+
+// junitIntegration aggregates JUnit/xUnit XML reports into an in-toto test-result predicate.
+type junitIntegration struct{}
+
+func NewJUnit() Integration {
+	return &junitIntegration{}
+}
+
+func (j *junitIntegration) Name() string {
+	return JUnitName
+}
+
+func (j *junitIntegration) Flags() []string {
+	return []string{flags.JUnitReports, flags.JUnitAttachReports}
+}
+
+func (j *junitIntegration) ValidateFlags(flagReader FlagReader) error {
+	if flagReader.GetStringFlagValue(flags.JUnitReports) == "" {
+		return errorutils.CheckErrorf("--%s is required with --%s %s", flags.JUnitReports, flags.Integration, JUnitName)
+	}
+	if err := rejectPredicateFlags(flagReader, JUnitName); err != nil {
+		return err
+	}
+	return validateAttachFlag(flagReader, flags.JUnitAttachReports)
+}
+
+func (j *junitIntegration) Resolve(request *Request) (*Result, error) {
+	reports, err := j.reports(request)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Aggregating", len(reports), "JUnit reports")
+	predicate, err := junit.ParseFiles(reports)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	predicateJson, err := json.Marshal(predicate)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &Result{Predicate: predicateJson, PredicateType: junit.PredicateType, Markdown: predicate.Markdown()}, nil
+}
+
+func (j *junitIntegration) AttachmentRequested(flagReader FlagReader) bool {
+	return flagReader.GetBoolFlagValue(flags.JUnitAttachReports)
+}
+
+// Attachment archives the raw reports when --junit-attach-reports is set.
+func (j *junitIntegration) Attachment(request *Request) (string, func(), error) {
+	if request == nil || request.Flags == nil || !j.AttachmentRequested(request.Flags) {
+		return "", nil, nil
+	}
+	reports, err := j.reports(request)
+	if err != nil {
+		return "", nil, err
+	}
+	dir, err := os.MkdirTemp("", junitReportsTempDirPattern)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	cleanup := func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warn("Failed to remove temporary JUnit reports archive:", err)
+		}
+	}
+	archivePath := filepath.Join(dir, junitReportsArchive)
+	if err = utils.ZipFiles(archivePath, reportEntries(reports)); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return archivePath, cleanup, nil
+}
+
+func (j *junitIntegration) DefaultPredicateType() string {
+	return junit.PredicateType
+}
+
+func (j *junitIntegration) ProviderId() string {
+	return ""
+}
+
+func (j *junitIntegration) reports(request *Request) ([]string, error) {
+	if request == nil || request.Flags == nil {
+		return nil, errorutils.CheckErrorf("--%s is required with --%s %s", flags.JUnitReports, flags.Integration, JUnitName)
+	}
+	pattern := request.Flags.GetStringFlagValue(flags.JUnitReports)
+	reports, err := utils.ExpandGlob(pattern)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(reports) == 0 {
+		return nil, errorutils.CheckErrorf("no JUnit reports match '%s'", pattern)
+	}
+	return reports, nil
+}
+
+// reportEntries names each report in the archive after its path, without volume name or leading separators.
+func reportEntries(reports []string) []utils.ZipEntry {
+	entries := make([]utils.ZipEntry, 0, len(reports))
+	for _, report := range reports {
+		name := strings.TrimLeft(filepath.ToSlash(strings.TrimPrefix(report, filepath.VolumeName(report))), "/")
+		entries = append(entries, utils.ZipEntry{Path: report, Name: name})
+	}
+	return entries
+}
@@ -0,0 +1,93 @@
+package integrations
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/junit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeJUnitReports(t *testing.T) string {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "a", "b"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a", "TEST-one.xml"),
+		[]byte(`<testsuite name="one"><testcase classname="pkg.One" name="ok"/></testsuite>`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a", "b", "TEST-two.xml"),
+		[]byte(`<testsuites><testsuite name="two"><testcase classname="pkg.Two" name="broken"><failure/></testcase><testcase name="later"><skipped/></testcase></testsuite></testsuites>`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a", "notes.txt"), []byte("not a report"), 0o644))
+	return dir
+}
+
+func TestJUnit_ValidateFlags(t *testing.T) {
+	integration := NewJUnit()
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{}), "--junit-reports is required with --integration junit")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.JUnitReports: "*.xml", flags.Predicate: "p.json"}), "--predicate cannot be used together with --integration junit")
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.JUnitReports: "*.xml"}))
+
+	attach := flagValues{flags.JUnitReports: "*.xml", flags.JUnitAttachReports: "true", flags.AttachArtifactoryTempPath: "repo/tmp"}
+	assert.NoError(t, integration.ValidateFlags(attach))
+	attach = flagValues{flags.JUnitReports: "*.xml", flags.JUnitAttachReports: "true", flags.AttachLocal: "f.zip", flags.AttachArtifactoryTempPath: "repo/tmp/"}
+	assert.NoError(t, integration.ValidateFlags(attach))
+}
+
+func TestValidateFlags_JUnitFlagsWithoutIntegration(t *testing.T) {
+	err := ValidateFlags("", flagValues{flags.JUnitAttachReports: "true"})
+	assert.ErrorContains(t, err, "--junit-attach-reports can be used only with --integration junit")
+}
+
+func TestJUnit_Resolve(t *testing.T) {
+	dir := writeJUnitReports(t)
+	integration := NewJUnit()
+	result, err := integration.Resolve(&Request{Flags: flagValues{flags.JUnitReports: filepath.Join(dir, "**", "*.xml")}})
+	require.NoError(t, err)
+	assert.Equal(t, junit.PredicateType, result.PredicateType)
+	assert.Nil(t, result.Statement)
+
+	var predicate junit.Predicate
+	require.NoError(t, json.Unmarshal(result.Predicate, &predicate))
+	assert.Equal(t, junit.ResultFailed, predicate.Result)
+	assert.Equal(t, []string{"pkg.Two.broken"}, predicate.FailedTests)
+	assert.Len(t, predicate.Configuration, 2)
+	assert.Equal(t, []junit.Suite{
+		{Name: "one", Tests: 1, Passed: 1},
+		{Name: "two", Tests: 2, Failed: 1, Skipped: 1},
+	}, predicate.Suites)
+	assert.Contains(t, string(result.Markdown), "| **Total** | **3** | **1** | **1** | **1** |")
+
+	_, err = integration.Resolve(&Request{Flags: flagValues{flags.JUnitReports: filepath.Join(dir, "*.xml")}})
+	assert.ErrorContains(t, err, "no JUnit reports match")
+}
+
+func TestJUnit_Attachment(t *testing.T) {
+	dir := writeJUnitReports(t)
+	provider, ok := NewJUnit().(AttachmentProvider)
+	require.True(t, ok)
+
+	path, cleanup, err := provider.Attachment(&Request{Flags: flagValues{flags.JUnitReports: filepath.Join(dir, "**", "*.xml")}})
+	require.NoError(t, err)
+	assert.Empty(t, path)
+	assert.Nil(t, cleanup)
+
+	path, cleanup, err = provider.Attachment(&Request{Flags: flagValues{
+		flags.JUnitReports:       filepath.Join(dir, "**", "*.xml"),
+		flags.JUnitAttachReports: "true",
+	}})
+	require.NoError(t, err)
+	require.NotNil(t, cleanup)
+	assert.Equal(t, junitReportsArchive, filepath.Base(path))
+
+	archive, err := zip.OpenReader(path)
+	require.NoError(t, err)
+	assert.Len(t, archive.File, 2)
+	require.NoError(t, archive.Close())
+
+	cleanup()
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
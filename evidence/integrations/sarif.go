@@ -0,0 +1,83 @@
+package integrations
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sarif"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const SarifName = "sarif"
+
+// sarifIntegration summarises the results of any static-analysis tool producing SARIF into a code scanning predicate.
+type sarifIntegration struct{}
+
+func NewSarif() Integration {
+	return &sarifIntegration{}
+}
+
+func (s *sarifIntegration) Name() string {
+	return SarifName
+}
+
+func (s *sarifIntegration) Flags() []string {
+	return []string{flags.SarifFile, flags.SarifThresholds, flags.SarifAttach}
+}
+
+func (s *sarifIntegration) ValidateFlags(flagReader FlagReader) error {
+	if flagReader.GetStringFlagValue(flags.SarifFile) == "" {
+		return errorutils.CheckErrorf("--%s is required with --%s %s", flags.SarifFile, flags.Integration, SarifName)
+	}
+	if err := rejectPredicateFlags(flagReader, SarifName); err != nil {
+		return err
+	}
+	if _, err := sarif.ParseThresholds(flagReader.GetStringFlagValue(flags.SarifThresholds)); err != nil {
+		return errorutils.CheckErrorf("invalid --%s: %s", flags.SarifThresholds, err.Error())
+	}
+	return validateAttachFlag(flagReader, flags.SarifAttach)
+}
+
+func (s *sarifIntegration) Resolve(request *Request) (*Result, error) {
+	if request == nil || request.Flags == nil {
+		return nil, errorutils.CheckErrorf("--%s is required with --%s %s", flags.SarifFile, flags.Integration, SarifName)
+	}
+	thresholds, err := sarif.ParseThresholds(request.Flags.GetStringFlagValue(flags.SarifThresholds))
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid --%s: %s", flags.SarifThresholds, err.Error())
+	}
+	predicate, err := sarif.ParseFile(request.Flags.GetStringFlagValue(flags.SarifFile), thresholds)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	predicateJson, err := json.Marshal(predicate)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &Result{Predicate: predicateJson, PredicateType: sarif.PredicateType, Markdown: predicate.Markdown()}, nil
+}
+
+func (s *sarifIntegration) AttachmentRequested(flagReader FlagReader) bool {
+	return flagReader.GetBoolFlagValue(flags.SarifAttach)
+}
+
+// Attachment returns the original SARIF file when --sarif-attach is set.
+func (s *sarifIntegration) Attachment(request *Request) (string, func(), error) {
+	if request == nil || request.Flags == nil || !s.AttachmentRequested(request.Flags) {
+		return "", nil, nil
+	}
+	path := request.Flags.GetStringFlagValue(flags.SarifFile)
+	if _, err := os.Stat(path); err != nil {
+		return "", nil, errorutils.CheckErrorf("failed to read --%s file '%s': %v", flags.SarifFile, path, err)
+	}
+	return path, nil, nil
+}
+
+func (s *sarifIntegration) DefaultPredicateType() string {
+	return sarif.PredicateType
+}
+
+func (s *sarifIntegration) ProviderId() string {
+	return ""
+}
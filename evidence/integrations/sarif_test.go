@@ -0,0 +1,58 @@
+package integrations
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sarif"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSarifFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "results.sarif")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":"2.1.0","runs":[{"tool":{"driver":{"name":"gosec"}},"results":[{"ruleId":"G101","level":"error"},{"ruleId":"G104"}]}]}`), 0o644))
+	return path
+}
+
+func TestSarif_ValidateFlags(t *testing.T) {
+	integration := NewSarif()
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{}), "--sarif-file is required with --integration sarif")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.SarifFile: "r.sarif", flags.PredicateType: "t"}), "--predicate-type cannot be used together with --integration sarif")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.SarifFile: "r.sarif", flags.SarifThresholds: "high=1"}), "invalid --sarif-thresholds")
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.SarifFile: "r.sarif", flags.SarifAttach: "true", flags.AttachArtifactoryPath: "repo/f", flags.AttachArtifactoryTempPath: "repo/tmp/"}))
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.SarifFile: "r.sarif", flags.SarifThresholds: "error=0"}))
+}
+
+func TestSarif_Resolve(t *testing.T) {
+	path := writeSarifFile(t)
+	result, err := NewSarif().Resolve(&Request{Flags: flagValues{flags.SarifFile: path, flags.SarifThresholds: "error=0,warning=5"}})
+	require.NoError(t, err)
+	assert.Equal(t, sarif.PredicateType, result.PredicateType)
+
+	var predicate sarif.Predicate
+	require.NoError(t, json.Unmarshal(result.Predicate, &predicate))
+	assert.Equal(t, sarif.ResultFailed, predicate.Result)
+	assert.Equal(t, map[string]int{sarif.LevelError: 0, sarif.LevelWarning: 5}, predicate.Thresholds)
+	assert.Equal(t, 1, predicate.Summary[sarif.LevelError])
+	assert.Equal(t, 1, predicate.Summary[sarif.LevelWarning])
+	assert.Contains(t, string(result.Markdown), "# Code scanning: FAILED")
+}
+
+func TestSarif_Attachment(t *testing.T) {
+	path := writeSarifFile(t)
+	provider, ok := NewSarif().(AttachmentProvider)
+	require.True(t, ok)
+
+	attachment, _, err := provider.Attachment(&Request{Flags: flagValues{flags.SarifFile: path}})
+	require.NoError(t, err)
+	assert.Empty(t, attachment)
+
+	attachment, cleanup, err := provider.Attachment(&Request{Flags: flagValues{flags.SarifFile: path, flags.SarifAttach: "true"}})
+	require.NoError(t, err)
+	assert.Equal(t, path, attachment)
+	assert.Nil(t, cleanup, "the user supplied SARIF file must not be removed")
+}
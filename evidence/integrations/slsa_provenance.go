@@ -0,0 +1,73 @@
+package integrations
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/slsa"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const SlsaProvenanceName = "slsa-provenance"
+
+// Subjects SLSA provenance generated from build-info can be attached to.
+const (
+	ProvenanceTargetBuild     = "build"
+	ProvenanceTargetArtifacts = "artifacts"
+)
+
+// slsaProvenanceIntegration generates SLSA v1 provenance from the published build-info.
+type slsaProvenanceIntegration struct{}
+
+func NewSlsaProvenance() Integration {
+	return &slsaProvenanceIntegration{}
+}
+
+func (s *slsaProvenanceIntegration) Name() string {
+	return SlsaProvenanceName
+}
+
+func (s *slsaProvenanceIntegration) Flags() []string {
+	return []string{flags.ProvenanceTarget}
+}
+
+func (s *slsaProvenanceIntegration) ValidateFlags(flagReader FlagReader) error {
+	if flagReader.GetStringFlagValue(flags.BuildName) == "" {
+		return errorutils.CheckErrorf("--%s %s requires a build subject: --%s and --%s", flags.Integration, SlsaProvenanceName, flags.BuildName, flags.BuildNumber)
+	}
+	if flagReader.IsFlagSet(flags.TypeFlag) {
+		return errorutils.CheckErrorf("--%s %s cannot be used together with --%s", flags.Integration, SlsaProvenanceName, flags.TypeFlag)
+	}
+	if err := rejectPredicateFlags(flagReader, SlsaProvenanceName); err != nil {
+		return err
+	}
+	switch target := flagReader.GetStringFlagValue(flags.ProvenanceTarget); strings.ToLower(target) {
+	case "", ProvenanceTargetBuild, ProvenanceTargetArtifacts:
+		return nil
+	default:
+		return errorutils.CheckErrorf("provenance target %s is not supported, expected %s or %s", target, ProvenanceTargetBuild, ProvenanceTargetArtifacts)
+	}
+}
+
+func (s *slsaProvenanceIntegration) Resolve(request *Request) (*Result, error) {
+	if request == nil || request.BuildInfo == nil {
+		return nil, errorutils.CheckErrorf("--%s %s requires a published build-info", flags.Integration, SlsaProvenanceName)
+	}
+	provenance, err := slsa.FromBuildInfo(request.BuildInfo, request.Project)
+	if err != nil {
+		return nil, err
+	}
+	predicate, err := provenance.Marshal()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &Result{Predicate: predicate, PredicateType: slsa.PredicateType}, nil
+}
+
+func (s *slsaProvenanceIntegration) DefaultPredicateType() string {
+	return slsa.PredicateType
+}
+
+func (s *slsaProvenanceIntegration) ProviderId() string {
+	return ""
+}
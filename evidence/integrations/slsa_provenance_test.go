@@ -0,0 +1,54 @@
+package integrations
+
+import (
+	"encoding/json"
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/slsa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlsaProvenance_ValidateFlags(t *testing.T) {
+	integration := NewSlsaProvenance()
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.BuildNumber: "1"}))
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.ProvenanceTarget: "Artifacts"}))
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.ProvenanceTarget: ProvenanceTargetBuild}))
+
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{}), "--integration slsa-provenance requires a build subject")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.TypeFlag: "gh-commiter"}), "--integration slsa-provenance cannot be used together with --type")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.Predicate: "p.json"}), "--predicate cannot be used together with --integration slsa-provenance")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.PredicateType: "t"}), "--predicate-type cannot be used together with --integration slsa-provenance")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.BuildName: "b", flags.ProvenanceTarget: "modules"}), "provenance target modules is not supported")
+
+	assert.ErrorContains(t, ValidateFlags(SarifName, flagValues{flags.SarifFile: "r.sarif", flags.ProvenanceTarget: "build"}), "--provenance-target can be used only with --integration slsa-provenance")
+}
+
+func TestSlsaProvenance_Resolve(t *testing.T) {
+	integration := NewSlsaProvenance()
+	buildInfo := &buildinfo.BuildInfo{
+		Name:       "my-build",
+		Number:     "42",
+		Started:    "2025-01-15T10:00:00.000+0000",
+		Properties: buildinfo.Env{"buildInfo.env.TOKEN": "s3cr3t"},
+	}
+
+	result, err := integration.Resolve(&Request{BuildInfo: buildInfo, Project: "my-project"})
+	require.NoError(t, err)
+	assert.Equal(t, slsa.PredicateType, result.PredicateType)
+	assert.Equal(t, slsa.PredicateType, integration.DefaultPredicateType())
+	assert.Nil(t, result.Statement)
+	assert.Empty(t, integration.ProviderId())
+	var provenance slsa.Provenance
+	require.NoError(t, json.Unmarshal(result.Predicate, &provenance))
+	assert.Equal(t, "my-build", provenance.BuildDefinition.ExternalParameters.BuildName)
+	assert.Equal(t, "my-project", provenance.BuildDefinition.ExternalParameters.Project)
+	assert.NotContains(t, string(result.Predicate), "s3cr3t")
+
+	_, err = integration.Resolve(&Request{})
+	assert.ErrorContains(t, err, "--integration slsa-provenance requires a published build-info")
+	_, err = integration.Resolve(nil)
+	assert.ErrorContains(t, err, "requires a published build-info")
+}
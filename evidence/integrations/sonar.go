@@ -0,0 +1,87 @@
+package integrations
+
+import (
+	"os"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sonar"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	SonarName          = "sonar"
+	sonarProviderId    = "sonar"
+	sonarPredicateType = "https://jfrog.com/evidence/sonar/v1"
+)
+
+// sonarIntegration fetches the in-toto statement SonarQube generated for the last analysis.
+type sonarIntegration struct {
+	resolver sonar.StatementResolver
+}
+
+// NewSonar returns the SonarQube integration. A nil resolver uses the report-task.txt of the last analysis.
+func NewSonar(resolver sonar.StatementResolver) Integration {
+	return &sonarIntegration{resolver: resolver}
+}
+
+func (s *sonarIntegration) Name() string {
+	return SonarName
+}
+
+func (s *sonarIntegration) Flags() []string {
+	return nil
+}
+
+func (s *sonarIntegration) ValidateFlags(flagReader FlagReader) error {
+	if err := validateSonarQubeRequirements(); err != nil {
+		return err
+	}
+	return rejectPredicateFlags(flagReader, SonarName)
+}
+
+func (s *sonarIntegration) Resolve(_ *Request) (*Result, error) {
+	resolver := s.resolver
+	if resolver == nil {
+		resolver = sonar.NewStatementResolver()
+	}
+	statement, err := resolver.ResolveStatement()
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Statement: statement}, nil
+}
+
+func (s *sonarIntegration) DefaultPredicateType() string {
+	return sonarPredicateType
+}
+
+func (s *sonarIntegration) ProviderId() string {
+	return sonarProviderId
+}
+
+func validateSonarQubeRequirements() error {
+	// Check if SonarQube token is present
+	if os.Getenv("SONAR_TOKEN") == "" && os.Getenv("SONARQUBE_TOKEN") == "" {
+		return errorutils.CheckErrorf("SonarQube token is required when using --%s %s. Please set SONAR_TOKEN or SONARQUBE_TOKEN environment variable", flags.Integration, SonarName)
+	}
+
+	// Check if report-task.txt exists using the detector or config
+	reportPath := sonar.GetReportTaskPath()
+	if reportPath == "" {
+		return errorutils.CheckErrorf("SonarQube report-task.txt file not found. Please ensure SonarQube analysis has been completed or configure a custom path in evidence config")
+	}
+	log.Info("Found SonarQube task report:", reportPath)
+
+	return nil
+}
+
+// rejectPredicateFlags fails when a predicate is supplied for an integration that generates its own.
+func rejectPredicateFlags(flagReader FlagReader, integrationName string) error {
+	for _, flag := range []string{flags.Predicate, flags.PredicateType} {
+		if flagReader.IsFlagSet(flag) && flagReader.GetStringFlagValue(flag) != "" {
+			return errorutils.CheckErrorf("--%s cannot be used together with --%s %s", flag, flags.Integration, integrationName)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package integrations
+
+import (
+	"encoding/json"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/vulns"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const VulnScanName = "vuln-scan"
+
+// vulnScanIntegration normalises Trivy and Grype JSON reports into an in-toto vulns predicate.
+type vulnScanIntegration struct{}
+
+func NewVulnScan() Integration {
+	return &vulnScanIntegration{}
+}
+
+func (v *vulnScanIntegration) Name() string {
+	return VulnScanName
+}
+
+func (v *vulnScanIntegration) Flags() []string {
+	return []string{flags.ScanReport}
+}
+
+func (v *vulnScanIntegration) ValidateFlags(flagReader FlagReader) error {
+	if flagReader.GetStringFlagValue(flags.ScanReport) == "" {
+		return errorutils.CheckErrorf("--%s is required with --%s %s", flags.ScanReport, flags.Integration, VulnScanName)
+	}
+	return rejectPredicateFlags(flagReader, VulnScanName)
+}
+
+func (v *vulnScanIntegration) Resolve(request *Request) (*Result, error) {
+	report, err := v.report(request)
+	if err != nil {
+		return nil, err
+	}
+	predicate := report.Predicate
+	log.Info("Found", len(predicate.Scanner.Result), "vulnerabilities in", predicate.Scanner.Name, "report")
+	predicateJson, err := json.Marshal(predicate)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &Result{Predicate: predicateJson, PredicateType: vulns.PredicateType, Markdown: predicate.Markdown(report.Target)}, nil
+}
+
+// SubjectDigest returns the digest of the scanned image, used to resolve docker:// and oci:// subjects.
+func (v *vulnScanIntegration) SubjectDigest(request *Request) (string, error) {
+	report, err := v.report(request)
+	if err != nil {
+		return "", err
+	}
+	return report.Digest, nil
+}
+
+func (v *vulnScanIntegration) DefaultPredicateType() string {
+	return vulns.PredicateType
+}
+
+func (v *vulnScanIntegration) ProviderId() string {
+	return ""
+}
+
+func (v *vulnScanIntegration) report(request *Request) (*vulns.Report, error) {
+	if request == nil || request.Flags == nil {
+		return nil, errorutils.CheckErrorf("--%s is required with --%s %s", flags.ScanReport, flags.Integration, VulnScanName)
+	}
+	report, err := vulns.ParseFile(request.Flags.GetStringFlagValue(flags.ScanReport))
+	return report, errorutils.CheckError(err)
+}
@@ -0,0 +1,48 @@
+package integrations
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/cli/command/flags"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/vulns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVulnScan_ValidateFlags(t *testing.T) {
+	integration := NewVulnScan()
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{}), "--scan-report is required with --integration vuln-scan")
+	assert.ErrorContains(t, integration.ValidateFlags(flagValues{flags.ScanReport: "r.json", flags.Predicate: "p.json"}), "--predicate cannot be used together with --integration vuln-scan")
+	assert.NoError(t, integration.ValidateFlags(flagValues{flags.ScanReport: "r.json"}))
+	assert.ErrorContains(t, ValidateFlags("", flagValues{flags.ScanReport: "r.json"}), "--scan-report can be used only with --integration vuln-scan")
+}
+
+func TestVulnScan_Resolve(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"SchemaVersion": 2, "ArtifactName": "app:1.0", "Trivy": {"Version": "0.51.1"},
+  "Metadata": {"RepoDigests": ["app@sha256:abcd"]},
+  "Results": [{"Vulnerabilities": [{"VulnerabilityID": "CVE-2024-0001", "PkgName": "openssl", "Severity": "HIGH"}]}]}`), 0o644))
+	request := &Request{Flags: flagValues{flags.ScanReport: path}}
+
+	integration := NewVulnScan()
+	result, err := integration.Resolve(request)
+	require.NoError(t, err)
+	assert.Equal(t, vulns.PredicateType, result.PredicateType)
+	var predicate vulns.Predicate
+	require.NoError(t, json.Unmarshal(result.Predicate, &predicate))
+	assert.Equal(t, "pkg:github/aquasecurity/trivy@0.51.1", predicate.Scanner.Uri)
+	assert.Equal(t, "CVE-2024-0001", predicate.Scanner.Result[0].Id)
+	assert.Contains(t, string(result.Markdown), "**Target:** `app:1.0`")
+
+	provider, ok := integration.(SubjectDigestProvider)
+	require.True(t, ok)
+	digest, err := provider.SubjectDigest(request)
+	require.NoError(t, err)
+	assert.Equal(t, "abcd", digest)
+
+	_, err = integration.Resolve(&Request{Flags: flagValues{flags.ScanReport: filepath.Join(t.TempDir(), "missing.json")}})
+	assert.ErrorContains(t, err, "failed to read scan report")
+}
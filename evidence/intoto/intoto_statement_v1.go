@@ -19,6 +19,7 @@ type Statement struct {
 	PredicateType string               `json:"predicateType"`
 	Predicate     json.RawMessage      `json:"predicate"`
 	CreatedAt     string               `json:"createdAt"`
+	ExpiresAt     string               `json:"expiresAt,omitempty"`
 	CreatedBy     string               `json:"createdBy"`
 	Markdown      string               `json:"markdown,omitempty"`
 	Stage         string               `json:"stage,omitempty"`
@@ -63,6 +64,25 @@ func (s *Statement) SetStage(stage string) {
 	s.Stage = stage
 }
 
+// SetExpiresAt records the time after which the evidence is no longer considered valid.
+func (s *Statement) SetExpiresAt(expiresAt time.Time) {
+	s.ExpiresAt = FormatTime(expiresAt)
+}
+
+// CreatedAtTime returns the statement creation time.
+func (s *Statement) CreatedAtTime() (time.Time, error) {
+	createdAt, err := time.Parse(timeLayout, s.CreatedAt)
+	if err != nil {
+		return time.Time{}, errorutils.CheckError(err)
+	}
+	return createdAt, nil
+}
+
+// FormatTime formats t in UTC using the statement timestamp layout.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
+
 func (s *Statement) SetAttachments(attachments []Attachment) {
 	s.Attachments = attachments
 }
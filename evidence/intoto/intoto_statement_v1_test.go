@@ -2,6 +2,7 @@ package intoto
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -42,3 +43,18 @@ func TestMarshal(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, marsheld)
 }
+
+func TestSetExpiresAt(t *testing.T) {
+	st := NewStatement([]byte(`{}`), "https://example.com/pentest/v1", "")
+	createdAt, err := st.CreatedAtTime()
+	assert.NoError(t, err)
+	st.SetExpiresAt(createdAt.Add(90 * 24 * time.Hour))
+
+	expiresAt, err := time.Parse(timeLayout, st.ExpiresAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 90*24*time.Hour, expiresAt.Sub(createdAt))
+
+	marshaled, err := st.Marshal()
+	assert.NoError(t, err)
+	assert.Contains(t, string(marshaled), `"expiresAt":"`+st.ExpiresAt+`"`)
+}
@@ -0,0 +1,153 @@
+package jira
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ErrIssueNotFound is returned when the tracker does not know the issue, or the user may not see it.
+var ErrIssueNotFound = errors.New("issue not found")
+
+// Issue holds the issue fields recorded in the predicate.
+type Issue struct {
+	Key      string
+	Summary  string
+	Status   string
+	Type     string
+	Approver string
+}
+
+// Client reads issues from a Jira-compatible REST API.
+type Client interface {
+	GetIssue(key string) (*Issue, error)
+}
+
+type httpClient struct {
+	baseURL       string
+	authorization string
+	approverField string
+	client        *jfroghttpclient.JfrogHttpClient
+}
+
+// NewClient returns a client for the Jira REST API v2 at jiraURL. With a user the token is sent as basic
+// auth (Jira Cloud API tokens), otherwise as a bearer token (Data Center personal access tokens).
+// approverField names the issue field holding the approver, typically a user picker custom field.
+func NewClient(jiraURL, user, token, approverField string) (Client, error) {
+	cli, err := jfroghttpclient.JfrogClientBuilder().Build()
+	if err != nil {
+		return nil, err
+	}
+	authorization := ""
+	switch {
+	case user != "":
+		authorization = "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+token))
+	case token != "":
+		authorization = "Bearer " + token
+	}
+	return &httpClient{baseURL: strings.TrimRight(jiraURL, "/"), authorization: authorization, approverField: approverField, client: cli}, nil
+}
+
+type issueResponse struct {
+	Key    string                     `json:"key"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+type namedField struct {
+	Name string `json:"name"`
+}
+
+type userField struct {
+	DisplayName  string `json:"displayName"`
+	Name         string `json:"name"`
+	EmailAddress string `json:"emailAddress"`
+	AccountId    string `json:"accountId"`
+}
+
+func (c *httpClient) GetIssue(key string) (*Issue, error) {
+	fields := []string{"summary", "status", "issuetype"}
+	if c.approverField != "" {
+		fields = append(fields, c.approverField)
+	}
+	issueURL := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=%s", c.baseURL, url.PathEscape(key), url.QueryEscape(strings.Join(fields, ",")))
+	details := httputils.HttpClientDetails{Headers: map[string]string{"Accept": "application/json"}}
+	if c.authorization != "" {
+		details.Headers["Authorization"] = c.authorization
+	}
+	resp, body, _, err := c.client.SendGet(issueURL, true, &details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	log.Debug("HTTP GET response for", issueURL, "status:", resp.StatusCode)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrIssueNotFound
+	}
+	if !utils.IsHttpStatusSuccessful(resp.StatusCode) {
+		return nil, fmt.Errorf("failed to get issue %s: status %d: %s", key, resp.StatusCode, string(body))
+	}
+	var response issueResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse issue %s: %w", key, err)
+	}
+	issue := &Issue{Key: response.Key}
+	if issue.Key == "" {
+		issue.Key = key
+	}
+	_ = json.Unmarshal(response.Fields["summary"], &issue.Summary)
+	var named namedField
+	if json.Unmarshal(response.Fields["status"], &named) == nil {
+		issue.Status = named.Name
+	}
+	named = namedField{}
+	if json.Unmarshal(response.Fields["issuetype"], &named) == nil {
+		issue.Type = named.Name
+	}
+	if c.approverField != "" {
+		issue.Approver = approverName(response.Fields[c.approverField])
+	}
+	return issue, nil
+}
+
+// approverName reads a user, a list of users or a plain value from the approver field.
+func approverName(field json.RawMessage) string {
+	if len(field) == 0 || string(field) == "null" {
+		return ""
+	}
+	var users []userField
+	if json.Unmarshal(field, &users) == nil {
+		names := make([]string, 0, len(users))
+		for _, user := range users {
+			if name := user.name(); name != "" {
+				names = append(names, name)
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	var user userField
+	if json.Unmarshal(field, &user) == nil {
+		return user.name()
+	}
+	var value string
+	if json.Unmarshal(field, &value) == nil {
+		return value
+	}
+	return strings.Trim(string(field), `"`)
+}
+
+func (u userField) name() string {
+	for _, name := range []string{u.DisplayName, u.Name, u.EmailAddress, u.AccountId} {
+		if name != "" {
+			return name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssue(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/PROJ-1", r.URL.Path)
+		assert.Equal(t, "summary,status,issuetype,customfield_100", r.URL.Query().Get("fields"))
+		assert.Equal(t, "Bearer pat", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"key":"PROJ-1","fields":{"summary":"Add login","status":{"name":"Done"},"issuetype":{"name":"Story"},"customfield_100":{"displayName":"Jane Doe","name":"jdoe"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL+"/", "", "pat", "customfield_100")
+	require.NoError(t, err)
+	issue, err := client.GetIssue("PROJ-1")
+	require.NoError(t, err)
+	assert.Equal(t, &Issue{Key: "PROJ-1", Summary: "Add login", Status: "Done", Type: "Story", Approver: "Jane Doe"}, issue)
+}
+
+func TestGetIssue_BasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, token, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "me@example.com", user)
+		assert.Equal(t, "api-token", token)
+		assert.Equal(t, "summary,status,issuetype", r.URL.Query().Get("fields"))
+		_, _ = w.Write([]byte(`{"key":"PROJ-2","fields":{"status":{"name":"In Review"},"issuetype":{"name":"Bug"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "me@example.com", "api-token", "")
+	require.NoError(t, err)
+	issue, err := client.GetIssue("PROJ-2")
+	require.NoError(t, err)
+	assert.Equal(t, &Issue{Key: "PROJ-2", Status: "In Review", Type: "Bug"}, issue)
+}
+
+func TestGetIssue_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "", "", "")
+	require.NoError(t, err)
+	_, err = client.GetIssue("PROJ-404")
+	assert.ErrorIs(t, err, ErrIssueNotFound)
+}
+
+func TestGetIssue_Unauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"errorMessages":["unauthorized"]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "", "bad", "")
+	require.NoError(t, err)
+	_, err = client.GetIssue("PROJ-1")
+	assert.ErrorContains(t, err, "failed to get issue PROJ-1: status 401")
+}
+
+func TestApproverName(t *testing.T) {
+	assert.Equal(t, "", approverName(nil))
+	assert.Equal(t, "", approverName([]byte("null")))
+	assert.Equal(t, "jdoe", approverName([]byte(`{"name":"jdoe"}`)))
+	assert.Equal(t, "A, b@example.com", approverName([]byte(`[{"displayName":"A"},{"emailAddress":"b@example.com"}]`)))
+	assert.Equal(t, "release-board", approverName([]byte(`"release-board"`)))
+	assert.Equal(t, "42", approverName([]byte(`42`)))
+}
@@ -0,0 +1,175 @@
+package jira
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+const (
+	// PredicateType is the issue-tracker linkage predicate type.
+	PredicateType = "https://jfrog.com/evidence/jira/v1"
+
+	// DefaultTicketPattern matches Jira issue keys such as PROJ-123.
+	DefaultTicketPattern = `\b[A-Z][A-Z0-9_]+-[1-9][0-9]*\b`
+
+	ResultPassed = "PASSED"
+	ResultFailed = "FAILED"
+)
+
+// Predicate links the commits of a change to the tickets they reference. The result fails when a commit
+// references no ticket or references a ticket the tracker does not know.
+type Predicate struct {
+	Result          string   `json:"result"`
+	TicketPattern   string   `json:"ticketPattern"`
+	Tickets         []Ticket `json:"tickets"`
+	Commits         []Commit `json:"commits"`
+	UnlinkedCommits []string `json:"unlinkedCommits"`
+	MissingTickets  []string `json:"missingTickets"`
+}
+
+// Ticket is a referenced ticket with its tracker state and the commits referencing it.
+type Ticket struct {
+	Key      string   `json:"key"`
+	Url      string   `json:"url,omitempty"`
+	Found    bool     `json:"found"`
+	Summary  string   `json:"summary,omitempty"`
+	Status   string   `json:"status,omitempty"`
+	Type     string   `json:"type,omitempty"`
+	Approver string   `json:"approver,omitempty"`
+	Commits  []string `json:"commits"`
+}
+
+// Commit is a commit of the range with the tickets its subject or body references.
+type Commit struct {
+	Commit  string   `json:"commit"`
+	Subject string   `json:"subject"`
+	Author  string   `json:"author,omitempty"`
+	Tickets []string `json:"tickets"`
+}
+
+// CompileTicketPattern compiles pattern, or DefaultTicketPattern when empty. When the pattern has a capture
+// group, the first group is the ticket key.
+func CompileTicketPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		pattern = DefaultTicketPattern
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ticket pattern '%s': %w", pattern, err)
+	}
+	return compiled, nil
+}
+
+// TicketKeys returns the distinct ticket keys referenced by message, in order of appearance.
+func TicketKeys(pattern *regexp.Regexp, message string) []string {
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, match := range pattern.FindAllStringSubmatch(message, -1) {
+		key := match[0]
+		if len(match) > 1 {
+			key = match[1]
+		}
+		if key != "" && !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Link looks up the tickets referenced by the commits and builds the linkage predicate. browseURL, when
+// set, is the tracker base URL used to link tickets.
+func Link(entries []model.GitLogEntry, pattern *regexp.Regexp, client Client, browseURL string) (*Predicate, error) {
+	predicate := &Predicate{
+		Result:          ResultPassed,
+		TicketPattern:   pattern.String(),
+		Tickets:         []Ticket{},
+		Commits:         []Commit{},
+		UnlinkedCommits: []string{},
+		MissingTickets:  []string{},
+	}
+	tickets := map[string]*Ticket{}
+	for _, entry := range entries {
+		commit := Commit{Commit: entry.Commit, Subject: entry.Subject, Author: entry.Author.Name, Tickets: TicketKeys(pattern, entry.Subject+"\n"+entry.Body)}
+		predicate.Commits = append(predicate.Commits, commit)
+		if len(commit.Tickets) == 0 {
+			predicate.UnlinkedCommits = append(predicate.UnlinkedCommits, entry.Commit)
+			continue
+		}
+		for _, key := range commit.Tickets {
+			ticket, ok := tickets[key]
+			if !ok {
+				ticket = &Ticket{Key: key, Commits: []string{}}
+				tickets[key] = ticket
+			}
+			ticket.Commits = append(ticket.Commits, entry.Commit)
+		}
+	}
+
+	keys := make([]string, 0, len(tickets))
+	for key := range tickets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		ticket := tickets[key]
+		if browseURL != "" {
+			ticket.Url = strings.TrimRight(browseURL, "/") + "/browse/" + key
+		}
+		issue, err := client.GetIssue(key)
+		switch {
+		case errors.Is(err, ErrIssueNotFound):
+			predicate.MissingTickets = append(predicate.MissingTickets, key)
+		case err != nil:
+			return nil, err
+		default:
+			ticket.Found = true
+			ticket.Summary = issue.Summary
+			ticket.Status = issue.Status
+			ticket.Type = issue.Type
+			ticket.Approver = issue.Approver
+		}
+		predicate.Tickets = append(predicate.Tickets, *ticket)
+	}
+	if len(predicate.UnlinkedCommits) > 0 || len(predicate.MissingTickets) > 0 {
+		predicate.Result = ResultFailed
+	}
+	return predicate, nil
+}
+
+// Markdown renders the tickets and the commits without a ticket.
+func (p *Predicate) Markdown() []byte {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Ticket linkage: %s\n\n", p.Result))
+	sb.WriteString(fmt.Sprintf("%d commits, %d tickets, %d commits without a ticket, %d unknown tickets.\n\n", len(p.Commits), len(p.Tickets), len(p.UnlinkedCommits), len(p.MissingTickets)))
+	if len(p.Tickets) > 0 {
+		sb.WriteString("| Ticket | Type | Status | Approver | Commits |\n")
+		sb.WriteString("|---|---|---|---|---:|\n")
+		for _, ticket := range p.Tickets {
+			key := ticket.Key
+			if ticket.Url != "" {
+				key = fmt.Sprintf("[%s](%s)", ticket.Key, ticket.Url)
+			}
+			status := utils.MarkdownCell(ticket.Status)
+			if !ticket.Found {
+				status = "**not found**"
+			}
+			sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %d |\n", key, utils.MarkdownCell(ticket.Type), status, utils.MarkdownCell(ticket.Approver), len(ticket.Commits)))
+		}
+	}
+	if len(p.UnlinkedCommits) > 0 {
+		sb.WriteString("\n## Commits without a ticket\n\n")
+		for _, commit := range p.Commits {
+			if len(commit.Tickets) == 0 {
+				sb.WriteString(fmt.Sprintf("- `%s` %s\n", utils.ShortSha(commit.Commit), commit.Subject))
+			}
+		}
+	}
+	return []byte(sb.String())
+}
@@ -0,0 +1,99 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient map[string]*Issue
+
+func (f fakeClient) GetIssue(key string) (*Issue, error) {
+	if issue, ok := f[key]; ok {
+		return issue, nil
+	}
+	return nil, ErrIssueNotFound
+}
+
+func commitEntry(sha, subject string) model.GitLogEntry {
+	entry := model.GitLogEntry{Commit: sha, Subject: subject}
+	entry.Author.Name = "dev"
+	return entry
+}
+
+func TestTicketKeys(t *testing.T) {
+	pattern, err := CompileTicketPattern("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PROJ-1", "OPS_2-30"}, TicketKeys(pattern, "PROJ-1 fix, see OPS_2-30 and PROJ-1"))
+	assert.Empty(t, TicketKeys(pattern, "bump proj-1 and PROJ-0"))
+
+	pattern, err = CompileTicketPattern(`\[#(\d+)\]`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"12"}, TicketKeys(pattern, "[#12] fix"))
+
+	_, err = CompileTicketPattern("(")
+	assert.ErrorContains(t, err, "invalid ticket pattern '('")
+}
+
+func TestLink(t *testing.T) {
+	pattern, err := CompileTicketPattern("")
+	require.NoError(t, err)
+	client := fakeClient{"PROJ-1": {Key: "PROJ-1", Summary: "Login", Status: "Done", Type: "Story", Approver: "Jane"}}
+	entries := []model.GitLogEntry{
+		commitEntry("c3", "PROJ-1 PROJ-9 follow-up"),
+		commitEntry("c2", "Fix typo"),
+		commitEntry("c1", "PROJ-1 add login"),
+	}
+
+	predicate, err := Link(entries, pattern, client, "https://jira.example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, &Predicate{
+		Result:        ResultFailed,
+		TicketPattern: DefaultTicketPattern,
+		Tickets: []Ticket{
+			{Key: "PROJ-1", Url: "https://jira.example.com/browse/PROJ-1", Found: true, Summary: "Login", Status: "Done", Type: "Story", Approver: "Jane", Commits: []string{"c3", "c1"}},
+			{Key: "PROJ-9", Url: "https://jira.example.com/browse/PROJ-9", Commits: []string{"c3"}},
+		},
+		Commits: []Commit{
+			{Commit: "c3", Subject: "PROJ-1 PROJ-9 follow-up", Author: "dev", Tickets: []string{"PROJ-1", "PROJ-9"}},
+			{Commit: "c2", Subject: "Fix typo", Author: "dev", Tickets: []string{}},
+			{Commit: "c1", Subject: "PROJ-1 add login", Author: "dev", Tickets: []string{"PROJ-1"}},
+		},
+		UnlinkedCommits: []string{"c2"},
+		MissingTickets:  []string{"PROJ-9"},
+	}, predicate)
+
+	markdown := string(predicate.Markdown())
+	assert.Contains(t, markdown, "# Ticket linkage: FAILED")
+	assert.Contains(t, markdown, "| [PROJ-1](https://jira.example.com/browse/PROJ-1) | Story | Done | Jane | 2 |")
+	assert.Contains(t, markdown, "| [PROJ-9](https://jira.example.com/browse/PROJ-9) |  | **not found** |  | 1 |")
+	assert.Contains(t, markdown, "- `c2` Fix typo")
+}
+
+func TestLink_TicketInBody(t *testing.T) {
+	pattern, err := CompileTicketPattern("")
+	require.NoError(t, err)
+	entry := commitEntry("c1", "Add login")
+	entry.Body = "Refs: PROJ-1"
+	predicate, err := Link([]model.GitLogEntry{entry}, pattern, fakeClient{"PROJ-1": {Key: "PROJ-1"}}, "")
+	require.NoError(t, err)
+	assert.Equal(t, ResultPassed, predicate.Result)
+	assert.Equal(t, []string{"PROJ-1"}, predicate.Commits[0].Tickets)
+	assert.Empty(t, predicate.UnlinkedCommits)
+}
+
+func TestLink_Passed(t *testing.T) {
+	pattern, err := CompileTicketPattern("")
+	require.NoError(t, err)
+	predicate, err := Link([]model.GitLogEntry{commitEntry("c1", "PROJ-1 add login")}, pattern, fakeClient{"PROJ-1": {Key: "PROJ-1"}}, "")
+	require.NoError(t, err)
+	assert.Equal(t, ResultPassed, predicate.Result)
+	assert.Empty(t, predicate.Tickets[0].Url)
+	assert.NotContains(t, string(predicate.Markdown()), "Commits without a ticket")
+
+	predicate, err = Link(nil, pattern, fakeClient{}, "")
+	require.NoError(t, err)
+	assert.Equal(t, ResultPassed, predicate.Result)
+}
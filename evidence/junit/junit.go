@@ -0,0 +1,188 @@
+package junit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+const (
+	// PredicateType is the in-toto test-result predicate type.
+	PredicateType = "https://in-toto.io/attestation/test-result/v0.1"
+
+	ResultPassed = "PASSED"
+	ResultFailed = "FAILED"
+)
+
+// Predicate is the in-toto test-result predicate, extended with per suite counts.
+type Predicate struct {
+	Result        string               `json:"result"`
+	Configuration []ResourceDescriptor `json:"configuration,omitempty"`
+	FailedTests   []string             `json:"failedTests"`
+	Suites        []Suite              `json:"suites"`
+}
+
+// ResourceDescriptor identifies a parsed report file.
+type ResourceDescriptor struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Suite holds the aggregated outcome of a test suite.
+type Suite struct {
+	Name    string `json:"name"`
+	Tests   int    `json:"tests"`
+	Passed  int    `json:"passed"`
+	Failed  int    `json:"failed"`
+	Skipped int    `json:"skipped"`
+}
+
+type xmlSuites struct {
+	Suites []xmlSuite `xml:"testsuite"`
+}
+
+type xmlSuite struct {
+	Name   string        `xml:"name,attr"`
+	Cases  []xmlTestCase `xml:"testcase"`
+	Suites []xmlSuite    `xml:"testsuite"`
+}
+
+type xmlTestCase struct {
+	Name      string    `xml:"name,attr"`
+	ClassName string    `xml:"classname,attr"`
+	Failures  []xmlNode `xml:"failure"`
+	Errors    []xmlNode `xml:"error"`
+	Skipped   *xmlNode  `xml:"skipped"`
+}
+
+type xmlNode struct{}
+
+// ParseFiles parses JUnit/xUnit XML reports and aggregates them into a test-result predicate.
+func ParseFiles(paths []string) (*Predicate, error) {
+	predicate := &Predicate{Result: ResultPassed, FailedTests: []string{}, Suites: []Suite{}}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JUnit report '%s': %w", path, err)
+		}
+		suites, failedTests, err := Parse(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JUnit report '%s': %w", path, err)
+		}
+		digest := sha256.Sum256(content)
+		predicate.Configuration = append(predicate.Configuration, ResourceDescriptor{
+			Name:   filepath.ToSlash(path),
+			Digest: map[string]string{"sha256": hex.EncodeToString(digest[:])},
+		})
+		predicate.Suites = append(predicate.Suites, suites...)
+		predicate.FailedTests = append(predicate.FailedTests, failedTests...)
+	}
+	if len(predicate.FailedTests) > 0 {
+		predicate.Result = ResultFailed
+	}
+	return predicate, nil
+}
+
+// Parse reads a single report, rooted either at <testsuites> or at <testsuite>, and returns its
+// suites and the names of the failed tests. Errors count as failures.
+func Parse(content []byte) ([]Suite, []string, error) {
+	var suites []xmlSuite
+	switch root, err := rootElement(content); {
+	case err != nil:
+		return nil, nil, err
+	case root == "testsuites":
+		var parsed xmlSuites
+		if err = xml.Unmarshal(content, &parsed); err != nil {
+			return nil, nil, err
+		}
+		suites = parsed.Suites
+	case root == "testsuite":
+		var parsed xmlSuite
+		if err = xml.Unmarshal(content, &parsed); err != nil {
+			return nil, nil, err
+		}
+		suites = []xmlSuite{parsed}
+	default:
+		return nil, nil, fmt.Errorf("unexpected root element <%s>, expected <testsuites> or <testsuite>", root)
+	}
+
+	var result []Suite
+	var failedTests []string
+	var collect func(suite xmlSuite)
+	collect = func(suite xmlSuite) {
+		summary := Suite{Name: suite.Name}
+		for _, testCase := range suite.Cases {
+			summary.Tests++
+			switch {
+			case len(testCase.Failures) > 0 || len(testCase.Errors) > 0:
+				summary.Failed++
+				failedTests = append(failedTests, testCase.fullName())
+			case testCase.Skipped != nil:
+				summary.Skipped++
+			default:
+				summary.Passed++
+			}
+		}
+		if summary.Tests > 0 || len(suite.Suites) == 0 {
+			result = append(result, summary)
+		}
+		for _, nested := range suite.Suites {
+			collect(nested)
+		}
+	}
+	for _, suite := range suites {
+		collect(suite)
+	}
+	return result, failedTests, nil
+}
+
+func (t xmlTestCase) fullName() string {
+	if t.ClassName == "" {
+		return t.Name
+	}
+	return t.ClassName + "." + t.Name
+}
+
+func rootElement(content []byte) (string, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("failed to parse XML: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+// Markdown renders a summary of the test results.
+func (p *Predicate) Markdown() []byte {
+	var total Suite
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Test results: %s\n\n", p.Result))
+	sb.WriteString("| Suite | Tests | Passed | Failed | Skipped |\n")
+	sb.WriteString("|---|---:|---:|---:|---:|\n")
+	for _, suite := range p.Suites {
+		sb.WriteString(fmt.Sprintf("| %s | %d | %d | %d | %d |\n", utils.MarkdownCell(suite.Name), suite.Tests, suite.Passed, suite.Failed, suite.Skipped))
+		total.Tests += suite.Tests
+		total.Passed += suite.Passed
+		total.Failed += suite.Failed
+		total.Skipped += suite.Skipped
+	}
+	sb.WriteString(fmt.Sprintf("| **Total** | **%d** | **%d** | **%d** | **%d** |\n", total.Tests, total.Passed, total.Failed, total.Skipped))
+	if len(p.FailedTests) > 0 {
+		sb.WriteString("\n## Failed tests\n\n")
+		for _, name := range p.FailedTests {
+			sb.WriteString(fmt.Sprintf("- `%s`\n", name))
+		}
+	}
+	return []byte(sb.String())
+}
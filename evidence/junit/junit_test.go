@@ -0,0 +1,88 @@
+package junit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSuitesReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="com.example.ApiTest" tests="3">
+    <testcase classname="com.example.ApiTest" name="get"/>
+    <testcase classname="com.example.ApiTest" name="post"><failure message="expected 201">stack</failure></testcase>
+    <testcase classname="com.example.ApiTest" name="put"><skipped/></testcase>
+  </testsuite>
+  <testsuite name="com.example.DbTest">
+    <testcase classname="com.example.DbTest" name="connect"><error type="IOException"/></testcase>
+  </testsuite>
+</testsuites>`
+
+const testSuiteReport = `<testsuite name="unit">
+  <testcase name="adds"/>
+  <testcase name="subtracts"/>
+</testsuite>`
+
+func TestParse(t *testing.T) {
+	suites, failed, err := Parse([]byte(testSuitesReport))
+	require.NoError(t, err)
+	assert.Equal(t, []Suite{
+		{Name: "com.example.ApiTest", Tests: 3, Passed: 1, Failed: 1, Skipped: 1},
+		{Name: "com.example.DbTest", Tests: 1, Failed: 1},
+	}, suites)
+	assert.Equal(t, []string{"com.example.ApiTest.post", "com.example.DbTest.connect"}, failed)
+
+	suites, failed, err = Parse([]byte(testSuiteReport))
+	require.NoError(t, err)
+	assert.Equal(t, []Suite{{Name: "unit", Tests: 2, Passed: 2}}, suites)
+	assert.Empty(t, failed)
+}
+
+func TestParse_NestedSuites(t *testing.T) {
+	suites, _, err := Parse([]byte(`<testsuites><testsuite name="outer"><testsuite name="inner"><testcase name="a"/></testsuite></testsuite></testsuites>`))
+	require.NoError(t, err)
+	assert.Equal(t, []Suite{{Name: "inner", Tests: 1, Passed: 1}}, suites)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, _, err := Parse([]byte(`<html></html>`))
+	assert.ErrorContains(t, err, "unexpected root element <html>")
+
+	_, _, err = Parse([]byte(`not xml`))
+	assert.ErrorContains(t, err, "failed to parse XML")
+}
+
+func TestParseFiles(t *testing.T) {
+	dir := t.TempDir()
+	failing := filepath.Join(dir, "TEST-api.xml")
+	passing := filepath.Join(dir, "TEST-unit.xml")
+	require.NoError(t, os.WriteFile(failing, []byte(testSuitesReport), 0600))
+	require.NoError(t, os.WriteFile(passing, []byte(testSuiteReport), 0600))
+
+	predicate, err := ParseFiles([]string{passing})
+	require.NoError(t, err)
+	assert.Equal(t, ResultPassed, predicate.Result)
+	assert.Empty(t, predicate.FailedTests)
+	require.Len(t, predicate.Configuration, 1)
+	assert.Equal(t, filepath.ToSlash(passing), predicate.Configuration[0].Name)
+	assert.Len(t, predicate.Configuration[0].Digest["sha256"], 64)
+
+	predicate, err = ParseFiles([]string{failing, passing})
+	require.NoError(t, err)
+	assert.Equal(t, ResultFailed, predicate.Result)
+	assert.Len(t, predicate.Suites, 3)
+	assert.Len(t, predicate.FailedTests, 2)
+
+	markdown := string(predicate.Markdown())
+	assert.Contains(t, markdown, "# Test results: FAILED")
+	assert.Contains(t, markdown, "| com.example.ApiTest | 3 | 1 | 1 | 1 |")
+	assert.Contains(t, markdown, "| **Total** | **6** | **3** | **2** | **1** |")
+	assert.Contains(t, markdown, "- `com.example.DbTest.connect`")
+
+	require.NoError(t, os.WriteFile(failing, []byte("<html/>"), 0600))
+	_, err = ParseFiles([]string{failing})
+	assert.ErrorContains(t, err, "invalid JUnit report")
+}
@@ -0,0 +1,131 @@
+package mdtemplate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+// funcs returns the helper functions available to templates, in addition to the text/template builtins.
+func funcs() template.FuncMap {
+	return template.FuncMap{
+		"toJson":       toJson,
+		"toPrettyJson": toPrettyJson,
+		"get":          get,
+		"default":      defaultValue,
+		"join":         join,
+		"upper":        func(value any) string { return strings.ToUpper(toString(value)) },
+		"lower":        func(value any) string { return strings.ToLower(toString(value)) },
+		"trim":         func(value any) string { return strings.TrimSpace(toString(value)) },
+		"replace":      func(old, new string, value any) string { return strings.ReplaceAll(toString(value), old, new) },
+		"hasPrefix":    func(prefix string, value any) bool { return strings.HasPrefix(toString(value), prefix) },
+		"contains":     func(substr string, value any) bool { return strings.Contains(toString(value), substr) },
+		"truncate":     truncate,
+		"shortSha":     func(sha any) string { return utils.ShortSha(toString(sha)) },
+		"cell":         cell,
+		"add":          func(a, b int) int { return a + b },
+		"list":         func(values ...any) []any { return values },
+		"date":         date,
+	}
+}
+
+func toJson(value any) (string, error) {
+	content, err := json.Marshal(value)
+	return string(content), err
+}
+
+func toPrettyJson(value any) (string, error) {
+	content, err := json.MarshalIndent(value, "", "  ")
+	return string(content), err
+}
+
+// get walks a dot separated path of map keys and slice indexes, returning nil when an element is missing.
+func get(value any, path string) any {
+	if path == "" {
+		return value
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch current := value.(type) {
+		case map[string]any:
+			value = current[key]
+		case []any:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(current) {
+				return nil
+			}
+			value = current[index]
+		default:
+			return nil
+		}
+	}
+	return value
+}
+
+// defaultValue returns value, or fallback when value is nil, empty or zero.
+func defaultValue(fallback, value any) any {
+	if value == nil {
+		return fallback
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		if reflected.Len() == 0 {
+			return fallback
+		}
+	default:
+		if reflected.IsZero() {
+			return fallback
+		}
+	}
+	return value
+}
+
+func join(separator string, values any) string {
+	reflected := reflect.ValueOf(values)
+	if values == nil || (reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array) {
+		return toString(values)
+	}
+	parts := make([]string, 0, reflected.Len())
+	for i := 0; i < reflected.Len(); i++ {
+		parts = append(parts, fmt.Sprint(reflected.Index(i).Interface()))
+	}
+	return strings.Join(parts, separator)
+}
+
+// toString formats a value for text, rendering nil as an empty string.
+func toString(value any) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+func truncate(length int, value any) string {
+	s := toString(value)
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+	return string(runes[:length])
+}
+
+// cell makes a value safe to place in a markdown table cell.
+func cell(value any) string {
+	return utils.MarkdownCell(toString(value))
+}
+
+// date reformats an RFC 3339 timestamp with a Go time layout, leaving other values unchanged.
+func date(layout string, value any) string {
+	s := toString(value)
+	parsed, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return parsed.UTC().Format(layout)
+}
@@ -0,0 +1,106 @@
+package mdtemplate
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"text/template"
+)
+
+//go:embed templates/*.md.tmpl
+var builtinTemplates embed.FS
+
+// builtinTemplateFiles maps the predicate types the CLI generates to their default template.
+var builtinTemplateFiles = map[string]string{
+	"https://jfrog.com/evidence/sonar/v1":         "sonar.md.tmpl",
+	"https://sonar.com/evidence/sonarqube/v1":     "sonar.md.tmpl",
+	"https://jfrog.com/evidence/gh-commiter/v1":   "committer.md.tmpl",
+	"https://jfrog.com/evidence/gl-committer/v1":  "committer.md.tmpl",
+	"https://jfrog.com/evidence/git-committer/v1": "committer.md.tmpl",
+	"https://slsa.dev/provenance/v1":              "slsa-provenance.md.tmpl",
+}
+
+// Data is what a template is rendered against.
+type Data struct {
+	// Predicate is the predicate JSON decoded into maps, slices and scalars.
+	Predicate     any
+	PredicateType string
+	Subject       Subject
+	Stage         string
+	CreatedBy     string
+	CreatedAt     string
+	ExpiresAt     string
+}
+
+// Subject identifies the evidence subject.
+type Subject struct {
+	Path   string
+	Sha256 string
+}
+
+type statement struct {
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+	CreatedAt     string          `json:"createdAt"`
+	ExpiresAt     string          `json:"expiresAt"`
+	CreatedBy     string          `json:"createdBy"`
+	Stage         string          `json:"stage"`
+	Subject       []struct {
+		Digest struct {
+			Sha256 string `json:"sha256"`
+		} `json:"digest"`
+	} `json:"subject"`
+}
+
+// DataFromStatement builds the template data from an in-toto statement and the path of its subject.
+func DataFromStatement(statementJson []byte, subjectPath string) (*Data, error) {
+	var parsed statement
+	if err := json.Unmarshal(statementJson, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse statement: %w", err)
+	}
+	data := &Data{
+		PredicateType: parsed.PredicateType,
+		Subject:       Subject{Path: subjectPath},
+		Stage:         parsed.Stage,
+		CreatedBy:     parsed.CreatedBy,
+		CreatedAt:     parsed.CreatedAt,
+		ExpiresAt:     parsed.ExpiresAt,
+	}
+	if len(parsed.Subject) > 0 {
+		data.Subject.Sha256 = parsed.Subject[0].Digest.Sha256
+	}
+	if len(parsed.Predicate) > 0 {
+		// Numbers are kept as json.Number so identifiers and large counts render as written.
+		decoder := json.NewDecoder(bytes.NewReader(parsed.Predicate))
+		decoder.UseNumber()
+		if err := decoder.Decode(&data.Predicate); err != nil {
+			return nil, fmt.Errorf("failed to parse predicate: %w", err)
+		}
+	}
+	return data, nil
+}
+
+// Builtin returns the default template shipped for predicateType, if any.
+func Builtin(predicateType string) ([]byte, bool) {
+	file, ok := builtinTemplateFiles[predicateType]
+	if !ok {
+		return nil, false
+	}
+	content, err := builtinTemplates.ReadFile("templates/" + file)
+	return content, err == nil
+}
+
+// Render executes the text/template source against data, with the helper functions of funcs. Predicate
+// fields missing from the JSON render as "<no value>"; use the get and default helpers for optional fields.
+func Render(name string, source []byte, data *Data) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(funcs()).Parse(string(source))
+	if err != nil {
+		return nil, fmt.Errorf("invalid markdown template %s: %w", name, err)
+	}
+	var out bytes.Buffer
+	if err = tmpl.Execute(&out, data); err != nil {
+		return nil, fmt.Errorf("failed to render markdown template %s: %w", name, err)
+	}
+	return out.Bytes(), nil
+}
@@ -0,0 +1,138 @@
+package mdtemplate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jfrog/froggit-go/vcsclient"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func statementWith(t *testing.T, predicateType string, predicate any) []byte {
+	predicateJson, err := json.Marshal(predicate)
+	require.NoError(t, err)
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v1",
+		"subject":       []any{map[string]any{"digest": map[string]any{"sha256": "abc123"}}},
+		"predicateType": predicateType,
+		"predicate":     json.RawMessage(predicateJson),
+		"createdAt":     "2026-10-18T10:00:00Z",
+		"createdBy":     "ci-user",
+		"stage":         "QA",
+	})
+	require.NoError(t, err)
+	return statement
+}
+
+func render(t *testing.T, source string, statement []byte) string {
+	data, err := DataFromStatement(statement, "generic-local/app.tgz")
+	require.NoError(t, err)
+	markdown, err := Render("test", []byte(source), data)
+	require.NoError(t, err)
+	return string(markdown)
+}
+
+func TestDataFromStatement(t *testing.T) {
+	data, err := DataFromStatement(statementWith(t, "https://example.com/v1", map[string]any{"count": 12345678901234567}), "repo/a.bin")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/v1", data.PredicateType)
+	assert.Equal(t, Subject{Path: "repo/a.bin", Sha256: "abc123"}, data.Subject)
+	assert.Equal(t, "QA", data.Stage)
+	assert.Equal(t, "ci-user", data.CreatedBy)
+	assert.Equal(t, json.Number("12345678901234567"), get(data.Predicate, "count"))
+
+	_, err = DataFromStatement([]byte("{"), "")
+	assert.ErrorContains(t, err, "failed to parse statement")
+}
+
+func TestRender_Helpers(t *testing.T) {
+	statement := statementWith(t, "https://example.com/v1", map[string]any{
+		"tool":     map[string]any{"name": "scanner | pro", "version": "1.2"},
+		"findings": []any{"a", "b"},
+		"empty":    "",
+		"sha":      "0123456789abcdef0123",
+		"scanned":  "2026-10-17T08:30:00+02:00",
+	})
+	source := `{{ cell (get .Predicate "tool.name") }} {{ get .Predicate "tool.version" }}
+{{ join ", " (get .Predicate "findings") }} {{ get .Predicate "findings.1" }} [{{ get .Predicate "findings.9" }}]
+{{ default "none" (get .Predicate "empty") }} {{ default "none" (get .Predicate "missing") }}
+{{ shortSha (get .Predicate "sha") }} {{ upper .Stage }} {{ date "2006-01-02 15:04" (get .Predicate "scanned") }}
+{{ .Subject.Path }}@{{ .Subject.Sha256 }} {{ toJson (get .Predicate "findings") }} {{ add 1 (len (get .Predicate "findings")) }}`
+	assert.Equal(t, `scanner \| pro 1.2
+a, b b [<no value>]
+none none
+0123456789ab QA 2026-10-17 06:30
+generic-local/app.tgz@abc123 ["a","b"] 3`, render(t, source, statement))
+}
+
+func TestRender_Errors(t *testing.T) {
+	data := &Data{}
+	_, err := Render("broken.tmpl", []byte("{{ if }}"), data)
+	assert.ErrorContains(t, err, "invalid markdown template broken.tmpl")
+	_, err = Render("exec.tmpl", []byte("{{ template \"missing\" }}"), data)
+	assert.ErrorContains(t, err, "failed to render markdown template exec.tmpl")
+}
+
+func TestBuiltin_Committer(t *testing.T) {
+	entry := model.GitLogEntry{Commit: "0123456789abcdef", Subject: "Fix | login", PRnumber: 42}
+	entry.Author.Name = "dev"
+	entry.PRreviewer = []vcsclient.PullRequestReviewDetails{{Reviewer: "alice", State: "APPROVED"}, {Reviewer: "bob", State: "COMMENTED"}}
+	unreviewed := model.GitLogEntry{Commit: "fedcba9876543210", Subject: "Direct push"}
+
+	for _, predicateType := range []string{"https://jfrog.com/evidence/gh-commiter/v1", "https://jfrog.com/evidence/gl-committer/v1", "https://jfrog.com/evidence/git-committer/v1"} {
+		source, ok := Builtin(predicateType)
+		require.True(t, ok, predicateType)
+		markdown := render(t, string(source), statementWith(t, predicateType, []model.GitLogEntry{entry, unreviewed}))
+		assert.Contains(t, markdown, "2 commits on `generic-local/app.tgz`.")
+		assert.Contains(t, markdown, "| `0123456789ab` | Fix \\| login | dev | #42 | alice (approved), bob (commented) |\n")
+		assert.Contains(t, markdown, "| `fedcba987654` | Direct push |  |  |  |\n")
+	}
+}
+
+func TestBuiltin_SlsaProvenance(t *testing.T) {
+	source, ok := Builtin("https://slsa.dev/provenance/v1")
+	require.True(t, ok)
+	predicate := map[string]any{
+		"buildDefinition": map[string]any{
+			"externalParameters": map[string]any{
+				"buildName":   "my-build",
+				"buildNumber": "42",
+				"vcs":         []any{map[string]any{"url": "https://github.com/acme/app.git", "revision": "0123456789abcdef", "branch": "main"}},
+			},
+			"resolvedDependencies": []any{map[string]any{"name": "lib.jar"}},
+		},
+		"runDetails": map[string]any{
+			"builder":  map[string]any{"id": "https://jfrog.com/evidence/builders/generic"},
+			"metadata": map[string]any{"startedOn": "2026-10-18T09:00:00Z"},
+		},
+	}
+	markdown := render(t, string(source), statementWith(t, "https://slsa.dev/provenance/v1", predicate))
+	assert.Contains(t, markdown, "| Build | my-build 42 |\n")
+	assert.NotContains(t, markdown, "| Project |")
+	assert.Contains(t, markdown, "| Builder | https://jfrog.com/evidence/builders/generic |\n")
+	assert.Contains(t, markdown, "| Started | 2026-10-18 09:00:00 UTC |\n")
+	assert.Contains(t, markdown, "| Source | https://github.com/acme/app.git (main) @ `0123456789ab` |\n")
+	assert.Contains(t, markdown, "1 resolved dependencies.")
+}
+
+func TestBuiltin_Sonar(t *testing.T) {
+	source, ok := Builtin("https://jfrog.com/evidence/sonar/v1")
+	require.True(t, ok)
+	predicate := map[string]any{"gates": []any{map[string]any{
+		"status":     "ERROR",
+		"conditions": []any{map[string]any{"metricKey": "new_coverage", "comparator": "LT", "errorThreshold": "80", "actualValue": "65.2", "status": "ERROR"}},
+	}}}
+	markdown := render(t, string(source), statementWith(t, "https://jfrog.com/evidence/sonar/v1", predicate))
+	assert.Contains(t, markdown, "## Quality gate: ERROR")
+	assert.Contains(t, markdown, "| new_coverage | LT | 80 | 65.2 | ERROR |\n")
+
+	markdown = render(t, string(source), statementWith(t, "https://jfrog.com/evidence/sonar/v1", map[string]any{"projectStatus": "OK"}))
+	assert.Contains(t, markdown, "\"projectStatus\": \"OK\"")
+}
+
+func TestBuiltin_Unknown(t *testing.T) {
+	_, ok := Builtin("https://example.com/v1")
+	assert.False(t, ok)
+}
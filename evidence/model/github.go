@@ -11,6 +11,7 @@ type GitLogEntry struct {
 	AbbreviatedParent string `json:"abbreviated_parent,omitempty"`
 	Subject           string `json:"subject,omitempty"`
 	SanitizedSubject  string `json:"sanitized_subject_line,omitempty"`
+	Body              string `json:"body,omitempty"`
 	Author            struct {
 		Name  string `json:"name,omitempty"`
 		Email string `json:"email,omitempty"`
@@ -22,4 +23,27 @@ type GitLogEntry struct {
 		Date  string `json:"date,omitempty"`
 	} `json:"commiter,omitempty"`
 	PRreviewer []vcsclient.PullRequestReviewDetails `json:"pr_reviewer,omitempty"`
+	PRnumber   int64                                `json:"pr_number,omitempty"`
+	PRauthor   string                               `json:"pr_author,omitempty"`
+	Signature  *GitSignature                        `json:"signature,omitempty"`
+	FourEyes   *FourEyesCheck                       `json:"four_eyes,omitempty"`
+}
+
+// FourEyesCheck records whether a commit satisfies the four-eyes policy evidence was created with.
+type FourEyesCheck struct {
+	Satisfied bool   `json:"satisfied"`
+	Approvals int    `json:"approvals"`
+	Required  int    `json:"required"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// GitSignature describes the GPG, SSH or X.509 signature of a commit read from a local clone.
+type GitSignature struct {
+	Signed bool   `json:"signed"`
+	Format string `json:"format,omitempty"`
+	// Status is the result of verifying the signature with the git configuration of the clone.
+	Status      string `json:"status,omitempty"`
+	Key         string `json:"key,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Signer      string `json:"signer,omitempty"`
 }
@@ -1,12 +1,16 @@
 package model
 
 import (
+	"encoding/base64"
+	"fmt"
+
 	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/sigstore"
 	"github.com/sigstore/sigstore-go/pkg/bundle"
 	"github.com/sigstore/sigstore-go/pkg/verify"
 )
 
-const SchemaVersion = "1.2"
+const SchemaVersion = "1.9"
 
 type VerificationResponse struct {
 	// Update the schemaVersion value when this structure is updated.
@@ -14,11 +18,18 @@ type VerificationResponse struct {
 	Subject                   Subject                 `json:"subject"`
 	EvidenceVerifications     *[]EvidenceVerification `json:"evidenceVerifications"`
 	OverallVerificationStatus VerificationStatus      `json:"overallVerificationStatus"`
+	// SubjectContentVerificationStatus compares the downloaded subject content with the reported sha256.
+	// It is set by deep verification only.
+	SubjectContentVerificationStatus VerificationStatus  `json:"subjectContentVerificationStatus,omitempty"`
+	PolicyVerification               *PolicyVerification `json:"policyVerification,omitempty"`
+	AsOf                             string              `json:"asOf,omitempty"`
 }
 
 type Subject struct {
 	Path   string `json:"path"`
 	Sha256 string `json:"sha256"`
+	// ComputedSha256 is the digest of the subject content downloaded by a deep verification.
+	ComputedSha256 string `json:"computedSha256,omitempty"`
 }
 
 type EvidenceVerification struct {
@@ -28,32 +39,103 @@ type EvidenceVerification struct {
 	PredicateType           string                     `json:"predicateType"`
 	CreatedBy               string                     `json:"createdBy"`
 	CreatedAt               string                     `json:"createdAt"`
+	SigningKeyAlias         string                     `json:"signingKeyAlias,omitempty"`
 	VerificationResult      EvidenceVerificationResult `json:"verificationResult"`
 	AttachmentsVerification []AttachmentVerification   `json:"attachmentsVerification,omitempty"`
 	DsseEnvelope            *dsse.Envelope             `json:"dsseEnvelope,omitempty"`
 	SigstoreBundle          *bundle.Bundle             `json:"sigstoreBundle,omitempty"`
 }
 
+// StatementPayload returns the in-toto statement signed in the DSSE envelope or the Sigstore bundle.
+func (e *EvidenceVerification) StatementPayload() ([]byte, error) {
+	switch {
+	case e.DsseEnvelope != nil:
+		payload, err := base64.StdEncoding.DecodeString(e.DsseEnvelope.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode DSSE payload: %w", err)
+		}
+		return payload, nil
+	case e.SigstoreBundle != nil:
+		envelope, err := sigstore.GetDSSEEnvelope(e.SigstoreBundle)
+		if err != nil {
+			return nil, err
+		}
+		return envelope.Payload, nil
+	default:
+		return nil, fmt.Errorf("no statement available")
+	}
+}
+
 type EvidenceVerificationResult struct {
 	Sha256VerificationStatus         VerificationStatus         `json:"sha256VerificationStatus,omitempty"`
 	SignaturesVerificationStatus     VerificationStatus         `json:"signaturesVerificationStatus,omitempty"`
 	SigstoreBundleVerificationStatus VerificationStatus         `json:"sigstoreBundleVerificationStatus,omitempty"`
 	KeySource                        string                     `json:"keySource,omitempty"`
 	KeyFingerprint                   string                     `json:"keyFingerprint,omitempty"`
+	KeyId                            string                     `json:"keyId,omitempty"`
 	SigstoreBundleVerificationResult *verify.VerificationResult `json:"sigstoreBundleVerificationResult,omitempty"`
 	AttachmentsVerificationStatus    VerificationStatus         `json:"attachmentsVerificationStatus,omitempty"`
+	AssertionsVerificationStatus     VerificationStatus         `json:"assertionsVerificationStatus,omitempty"`
+	AssertionResults                 []AssertionResult          `json:"assertionResults,omitempty"`
+	SchemaVerificationStatus         VerificationStatus         `json:"schemaVerificationStatus,omitempty"`
+	SchemaViolations                 []string                   `json:"schemaViolations,omitempty"`
+	TimestampVerificationStatus      VerificationStatus         `json:"timestampVerificationStatus,omitempty"`
+	SignedAt                         string                     `json:"signedAt,omitempty"`
+	ExpiryVerificationStatus         VerificationStatus         `json:"expiryVerificationStatus,omitempty"`
+	ExpiresAt                        string                     `json:"expiresAt,omitempty"`
 	FailureReason                    string                     `json:"failureReason,omitempty"`
 }
 
+// HasFailure reports whether any of the performed verification checks failed.
+func (r *EvidenceVerificationResult) HasFailure() bool {
+	return r.SignaturesVerificationStatus == Failed ||
+		r.Sha256VerificationStatus == Failed ||
+		r.SigstoreBundleVerificationStatus == Failed ||
+		r.AttachmentsVerificationStatus == Failed ||
+		r.AssertionsVerificationStatus == Failed ||
+		r.SchemaVerificationStatus == Failed ||
+		r.TimestampVerificationStatus == Failed ||
+		r.ExpiryVerificationStatus == Failed
+}
+
+// AssertionResult is the outcome of evaluating a single CEL assertion against the evidence statement.
+type AssertionResult struct {
+	Expression string             `json:"expression"`
+	Status     VerificationStatus `json:"status"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// PolicyVerification holds the outcome of evaluating a verification policy against the subject evidence.
+type PolicyVerification struct {
+	PolicyPath  string             `json:"policyPath"`
+	Status      VerificationStatus `json:"status"`
+	RuleResults []PolicyRuleResult `json:"ruleResults"`
+}
+
+type PolicyRuleResult struct {
+	Rule          string             `json:"rule"`
+	PredicateType string             `json:"predicateType,omitempty"`
+	Status        VerificationStatus `json:"status"`
+	RequiredCount int                `json:"requiredCount,omitempty"`
+	MatchedCount  int                `json:"matchedCount"`
+	Message       string             `json:"message,omitempty"`
+	Violations    []string           `json:"violations,omitempty"`
+}
+
 type AttachmentVerification struct {
 	Name               string             `json:"name"`
 	ExpectedSha256     string             `json:"expectedSha256,omitempty"`
 	ActualSha256       string             `json:"actualSha256,omitempty"`
+	ComputedSha256     string             `json:"computedSha256,omitempty"`
 	DownloadPath       string             `json:"downloadPath,omitempty"`
 	VerificationStatus VerificationStatus `json:"verificationStatus"`
 	FailureReason      string             `json:"failureReason,omitempty"`
 }
 
+// ArtifactoryKeySource is the keySource of evidence verified with the public key Artifactory stores under
+// its signingKeyAlias.
+const ArtifactoryKeySource = "Artifactory Key"
+
 type VerificationStatus string
 
 const (
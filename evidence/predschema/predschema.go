@@ -0,0 +1,108 @@
+package predschema
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// contextDelimiter separates the path segments gojsonschema reports, so they can be escaped into a JSON pointer.
+const contextDelimiter = "\x00"
+
+// Schema is a compiled JSON Schema predicates are validated against.
+type Schema struct {
+	name   string
+	schema *gojsonschema.Schema
+}
+
+// Violation is a predicate value that does not match the schema, located by its JSON pointer.
+type Violation struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+// ValidationError lists the violations of a predicate that does not match its schema.
+type ValidationError struct {
+	Schema     string
+	Violations []Violation
+}
+
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("predicate does not match schema %s:", e.Schema))
+	for _, violation := range e.Violations {
+		sb.WriteString("\n  " + violation.String())
+	}
+	return sb.String()
+}
+
+func (v Violation) String() string {
+	pointer := v.Pointer
+	if pointer == "" {
+		pointer = "(root)"
+	}
+	return pointer + ": " + v.Message
+}
+
+// Load compiles the JSON Schema file at path. Relative $ref are resolved against the file location.
+func Load(path string) (*Schema, error) {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema path '%s': %w", path, err)
+	}
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader("file://" + filepath.ToSlash(absolutePath)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load predicate schema '%s': %w", path, err)
+	}
+	return &Schema{name: filepath.Base(path), schema: schema}, nil
+}
+
+// Compile compiles an in-memory JSON Schema document.
+func Compile(name string, content []byte) (*Schema, error) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load predicate schema '%s': %w", name, err)
+	}
+	return &Schema{name: name, schema: schema}, nil
+}
+
+// Name identifies the schema in error messages.
+func (s *Schema) Name() string {
+	return s.name
+}
+
+// Validate checks the predicate JSON against the schema and returns a *ValidationError when it does not match.
+func (s *Schema) Validate(predicate []byte) error {
+	result, err := s.schema.Validate(gojsonschema.NewBytesLoader(predicate))
+	if err != nil {
+		return fmt.Errorf("failed to validate predicate against schema %s: %w", s.name, err)
+	}
+	if result.Valid() {
+		return nil
+	}
+	violations := make([]Violation, 0, len(result.Errors()))
+	for _, resultError := range result.Errors() {
+		violations = append(violations, Violation{Pointer: jsonPointer(resultError.Context()), Message: resultError.Description()})
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Pointer < violations[j].Pointer
+	})
+	return &ValidationError{Schema: s.name, Violations: violations}
+}
+
+// jsonPointer converts the gojsonschema context, rooted at "(root)", to an RFC 6901 JSON pointer.
+func jsonPointer(context *gojsonschema.JsonContext) string {
+	if context == nil {
+		return ""
+	}
+	segments := strings.Split(context.String(contextDelimiter), contextDelimiter)
+	var sb strings.Builder
+	for _, segment := range segments[1:] {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
@@ -0,0 +1,110 @@
+package predschema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const findingsSchema = `{
+  "type": "object",
+  "required": ["findings"],
+  "properties": {
+    "findings": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {"severity": {"type": "string"}}
+      }
+    },
+    "a/b~c": {"type": "integer"}
+  }
+}`
+
+func TestValidate_Valid(t *testing.T) {
+	schema, err := Compile("findings.json", []byte(findingsSchema))
+	require.NoError(t, err)
+	assert.Equal(t, "findings.json", schema.Name())
+	assert.NoError(t, schema.Validate([]byte(`{"findings":[{"severity":"high"}]}`)))
+}
+
+func TestValidate_Violations(t *testing.T) {
+	schema, err := Compile("findings.json", []byte(findingsSchema))
+	require.NoError(t, err)
+
+	err = schema.Validate([]byte(`{"findings":[{"severity":3}],"a/b~c":"x"}`))
+	var validationError *ValidationError
+	require.ErrorAs(t, err, &validationError)
+	assert.Equal(t, "findings.json", validationError.Schema)
+	require.Len(t, validationError.Violations, 2)
+	assert.Equal(t, "/a~1b~0c", validationError.Violations[0].Pointer)
+	assert.Equal(t, "/findings/0/severity", validationError.Violations[1].Pointer)
+	assert.Contains(t, err.Error(), "predicate does not match schema findings.json:\n  /a~1b~0c: ")
+}
+
+func TestValidate_RootViolation(t *testing.T) {
+	schema, err := Compile("findings.json", []byte(findingsSchema))
+	require.NoError(t, err)
+
+	err = schema.Validate([]byte(`{}`))
+	var validationError *ValidationError
+	require.ErrorAs(t, err, &validationError)
+	require.Len(t, validationError.Violations, 1)
+	assert.Empty(t, validationError.Violations[0].Pointer)
+	assert.Contains(t, validationError.Violations[0].String(), "(root): ")
+}
+
+func TestCompile_InvalidSchema(t *testing.T) {
+	_, err := Compile("broken.json", []byte(`{"type": 5}`))
+	assert.ErrorContains(t, err, "failed to load predicate schema 'broken.json'")
+}
+
+func TestLoad_ResolvesRelativeRefs(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "severity.json"), []byte(`{"type":"string","enum":["low","high"]}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "schema.json"), []byte(`{"properties":{"severity":{"$ref":"severity.json"}}}`), 0644))
+
+	schema, err := Load(filepath.Join(dir, "schema.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "schema.json", schema.Name())
+	assert.NoError(t, schema.Validate([]byte(`{"severity":"low"}`)))
+	assert.Error(t, schema.Validate([]byte(`{"severity":"medium"}`)))
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorContains(t, err, "failed to load predicate schema")
+}
+
+func TestRegistry_Lookup(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schema.json")
+	require.NoError(t, os.WriteFile(path, []byte(findingsSchema), 0644))
+	registry := NewRegistry(map[string]string{"https://example.com/findings/v1": path})
+	assert.Equal(t, 1, registry.Len())
+
+	schema, err := registry.Lookup("https://example.com/findings/v1")
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	// The compiled schema is cached, so removing the file does not matter anymore.
+	require.NoError(t, os.Remove(path))
+	cached, err := registry.Lookup("https://example.com/findings/v1")
+	require.NoError(t, err)
+	assert.Same(t, schema, cached)
+
+	schema, err = registry.Lookup("https://example.com/other/v1")
+	assert.NoError(t, err)
+	assert.Nil(t, schema)
+}
+
+func TestRegistry_Nil(t *testing.T) {
+	var registry *Registry
+	assert.Equal(t, 0, registry.Len())
+	schema, err := registry.Lookup("any")
+	assert.NoError(t, err)
+	assert.Nil(t, schema)
+}
@@ -0,0 +1,41 @@
+package predschema
+
+// Registry maps predicate types to the JSON Schema files their predicates must match. Schemas are
+// compiled on first use.
+type Registry struct {
+	paths   map[string]string
+	schemas map[string]*Schema
+}
+
+// NewRegistry returns a registry of the schema file path of each predicate type.
+func NewRegistry(paths map[string]string) *Registry {
+	return &Registry{paths: paths, schemas: map[string]*Schema{}}
+}
+
+// Len returns the number of registered predicate types.
+func (r *Registry) Len() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.paths)
+}
+
+// Lookup returns the schema registered for predicateType, or nil when there is none.
+func (r *Registry) Lookup(predicateType string) (*Schema, error) {
+	if r == nil {
+		return nil, nil
+	}
+	if schema, ok := r.schemas[predicateType]; ok {
+		return schema, nil
+	}
+	path, ok := r.paths[predicateType]
+	if !ok {
+		return nil, nil
+	}
+	schema, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	r.schemas[predicateType] = schema
+	return schema, nil
+}
@@ -0,0 +1,285 @@
+package sarif
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+const (
+	// PredicateType is the predicate type of code scanning evidence generated from SARIF.
+	PredicateType = "https://jfrog.com/evidence/code-scanning/v1"
+
+	ResultPassed = "PASSED"
+	ResultFailed = "FAILED"
+)
+
+// SARIF result levels, ordered from the most to the least severe.
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+	LevelNote    = "note"
+	LevelNone    = "none"
+)
+
+var levels = []string{LevelError, LevelWarning, LevelNote, LevelNone}
+
+// Predicate summarises the results of a SARIF log by tool, rule and level.
+type Predicate struct {
+	Result string `json:"result"`
+	// Thresholds is the maximum number of results allowed per level; Result is FAILED when one is exceeded.
+	Thresholds map[string]int     `json:"thresholds,omitempty"`
+	Source     ResourceDescriptor `json:"source"`
+	Summary    map[string]int     `json:"summary"`
+	Tools      []Tool             `json:"tools"`
+}
+
+// ResourceDescriptor identifies the parsed SARIF file.
+type ResourceDescriptor struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Tool holds the results reported by one analysis tool.
+type Tool struct {
+	Name    string         `json:"name"`
+	Version string         `json:"version,omitempty"`
+	Summary map[string]int `json:"summary"`
+	Rules   []Rule         `json:"rules"`
+}
+
+// Rule holds the number of results reported for a rule at a given level.
+type Rule struct {
+	Id    string `json:"id"`
+	Level string `json:"level"`
+	Count int    `json:"count"`
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool struct {
+		Driver struct {
+			Name            string      `json:"name"`
+			Version         string      `json:"version"`
+			SemanticVersion string      `json:"semanticVersion"`
+			Rules           []sarifRule `json:"rules"`
+		} `json:"driver"`
+	} `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifRule struct {
+	Id                   string `json:"id"`
+	DefaultConfiguration struct {
+		Level string `json:"level"`
+	} `json:"defaultConfiguration"`
+}
+
+type sarifResult struct {
+	RuleId    string `json:"ruleId"`
+	RuleIndex *int   `json:"ruleIndex"`
+	Rule      *struct {
+		Id    string `json:"id"`
+		Index *int   `json:"index"`
+	} `json:"rule"`
+	Level string `json:"level"`
+}
+
+// ParseThresholds parses a comma separated list of level=count pairs, for example "error=0,warning=10".
+func ParseThresholds(value string) (map[string]int, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	thresholds := make(map[string]int)
+	for _, pair := range strings.Split(value, ",") {
+		level, count, found := strings.Cut(strings.TrimSpace(pair), "=")
+		level = strings.ToLower(strings.TrimSpace(level))
+		if !found || !isLevel(level) {
+			return nil, fmt.Errorf("invalid threshold '%s', expected <level>=<count> where level is one of: %s", pair, strings.Join(levels, ", "))
+		}
+		allowed, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || allowed < 0 {
+			return nil, fmt.Errorf("invalid threshold '%s', count must be a non-negative number", pair)
+		}
+		thresholds[level] = allowed
+	}
+	return thresholds, nil
+}
+
+// ParseFile parses a SARIF log and summarises it, applying the thresholds to compute the result.
+func ParseFile(path string, thresholds map[string]int) (*Predicate, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SARIF file '%s': %w", path, err)
+	}
+	predicate, err := Parse(content, thresholds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SARIF file '%s': %w", path, err)
+	}
+	digest := sha256.Sum256(content)
+	predicate.Source = ResourceDescriptor{
+		Name:   filepath.Base(path),
+		Digest: map[string]string{"sha256": hex.EncodeToString(digest[:])},
+	}
+	return predicate, nil
+}
+
+// Parse summarises a SARIF log. A result without a level takes the default level of its rule,
+// and "warning" when the rule has none, as defined by the SARIF specification.
+func Parse(content []byte, thresholds map[string]int) (*Predicate, error) {
+	var log sarifLog
+	if err := json.Unmarshal(content, &log); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	if log.Runs == nil {
+		return nil, fmt.Errorf("missing runs")
+	}
+	predicate := &Predicate{Result: ResultPassed, Thresholds: thresholds, Summary: newSummary(), Tools: []Tool{}}
+	for _, run := range log.Runs {
+		tool := Tool{Name: run.Tool.Driver.Name, Version: run.Tool.Driver.Version, Summary: newSummary(), Rules: []Rule{}}
+		if tool.Version == "" {
+			tool.Version = run.Tool.Driver.SemanticVersion
+		}
+		counts := make(map[Rule]int)
+		for _, result := range run.Results {
+			ruleId, rule := run.rule(result)
+			level := strings.ToLower(result.Level)
+			if level == "" && rule != nil {
+				level = strings.ToLower(rule.DefaultConfiguration.Level)
+			}
+			if !isLevel(level) {
+				level = LevelWarning
+			}
+			counts[Rule{Id: ruleId, Level: level}]++
+			tool.Summary[level]++
+			predicate.Summary[level]++
+		}
+		for rule, count := range counts {
+			rule.Count = count
+			tool.Rules = append(tool.Rules, rule)
+		}
+		sortRules(tool.Rules)
+		predicate.Tools = append(predicate.Tools, tool)
+	}
+	for level, allowed := range thresholds {
+		if predicate.Summary[level] > allowed {
+			predicate.Result = ResultFailed
+		}
+	}
+	return predicate, nil
+}
+
+// rule returns the id and the descriptor of the rule a result refers to, either by id or by index.
+func (r sarifRun) rule(result sarifResult) (string, *sarifRule) {
+	ruleId, index := result.RuleId, result.RuleIndex
+	if result.Rule != nil {
+		if ruleId == "" {
+			ruleId = result.Rule.Id
+		}
+		if index == nil {
+			index = result.Rule.Index
+		}
+	}
+	rules := r.Tool.Driver.Rules
+	if index != nil && *index >= 0 && *index < len(rules) {
+		if ruleId == "" {
+			ruleId = rules[*index].Id
+		}
+		return ruleId, &rules[*index]
+	}
+	for i := range rules {
+		if rules[i].Id == ruleId {
+			return ruleId, &rules[i]
+		}
+	}
+	return ruleId, nil
+}
+
+func newSummary() map[string]int {
+	summary := make(map[string]int, len(levels))
+	for _, level := range levels {
+		summary[level] = 0
+	}
+	return summary
+}
+
+func isLevel(level string) bool {
+	for _, known := range levels {
+		if level == known {
+			return true
+		}
+	}
+	return false
+}
+
+func levelRank(level string) int {
+	for i, known := range levels {
+		if level == known {
+			return i
+		}
+	}
+	return len(levels)
+}
+
+// sortRules orders rules by level, most severe first, then by count and id.
+func sortRules(rules []Rule) {
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].Level != rules[j].Level {
+			return levelRank(rules[i].Level) < levelRank(rules[j].Level)
+		}
+		if rules[i].Count != rules[j].Count {
+			return rules[i].Count > rules[j].Count
+		}
+		return rules[i].Id < rules[j].Id
+	})
+}
+
+// Markdown renders a summary of the scan results.
+func (p *Predicate) Markdown() []byte {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# Code scanning: %s\n\n", p.Result))
+	sb.WriteString("| Tool | Error | Warning | Note | None |\n")
+	sb.WriteString("|---|---:|---:|---:|---:|\n")
+	for _, tool := range p.Tools {
+		name := tool.Name
+		if tool.Version != "" {
+			name += " " + tool.Version
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %d | %d | %d | %d |\n", utils.MarkdownCell(name), tool.Summary[LevelError], tool.Summary[LevelWarning], tool.Summary[LevelNote], tool.Summary[LevelNone]))
+	}
+	sb.WriteString(fmt.Sprintf("| **Total** | **%d** | **%d** | **%d** | **%d** |\n", p.Summary[LevelError], p.Summary[LevelWarning], p.Summary[LevelNote], p.Summary[LevelNone]))
+	if len(p.Thresholds) > 0 {
+		sb.WriteString("\n## Thresholds\n\n")
+		sb.WriteString("| Level | Allowed | Found |\n")
+		sb.WriteString("|---|---:|---:|\n")
+		for _, level := range levels {
+			if allowed, ok := p.Thresholds[level]; ok {
+				sb.WriteString(fmt.Sprintf("| %s | %d | %d |\n", level, allowed, p.Summary[level]))
+			}
+		}
+	}
+	for _, tool := range p.Tools {
+		if len(tool.Rules) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\n## %s rules\n\n", utils.MarkdownCell(tool.Name)))
+		sb.WriteString("| Rule | Level | Results |\n")
+		sb.WriteString("|---|---|---:|\n")
+		for _, rule := range tool.Rules {
+			sb.WriteString(fmt.Sprintf("| %s | %s | %d |\n", utils.MarkdownCell(rule.Id), rule.Level, rule.Count))
+		}
+	}
+	return []byte(sb.String())
+}
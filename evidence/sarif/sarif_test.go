@@ -0,0 +1,114 @@
+package sarif
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLog = `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "Semgrep", "semanticVersion": "1.50.0", "rules": [
+        {"id": "go.sql-injection", "defaultConfiguration": {"level": "error"}},
+        {"id": "go.weak-hash"}
+      ]}},
+      "results": [
+        {"ruleId": "go.sql-injection"},
+        {"ruleId": "go.sql-injection"},
+        {"ruleIndex": 1},
+        {"ruleId": "go.weak-hash", "level": "note"}
+      ]
+    },
+    {
+      "tool": {"driver": {"name": "gosec", "version": "2.18.2"}},
+      "results": [
+        {"rule": {"id": "G104"}, "level": "warning"}
+      ]
+    }
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	predicate, err := Parse([]byte(testLog), nil)
+	require.NoError(t, err)
+	assert.Equal(t, ResultPassed, predicate.Result)
+	assert.Equal(t, map[string]int{LevelError: 2, LevelWarning: 2, LevelNote: 1, LevelNone: 0}, predicate.Summary)
+	require.Len(t, predicate.Tools, 2)
+
+	semgrep := predicate.Tools[0]
+	assert.Equal(t, "Semgrep", semgrep.Name)
+	assert.Equal(t, "1.50.0", semgrep.Version)
+	assert.Equal(t, []Rule{
+		{Id: "go.sql-injection", Level: LevelError, Count: 2},
+		{Id: "go.weak-hash", Level: LevelWarning, Count: 1},
+		{Id: "go.weak-hash", Level: LevelNote, Count: 1},
+	}, semgrep.Rules)
+
+	gosec := predicate.Tools[1]
+	assert.Equal(t, "2.18.2", gosec.Version)
+	assert.Equal(t, []Rule{{Id: "G104", Level: LevelWarning, Count: 1}}, gosec.Rules)
+}
+
+func TestParse_Thresholds(t *testing.T) {
+	predicate, err := Parse([]byte(testLog), map[string]int{LevelError: 2, LevelWarning: 5})
+	require.NoError(t, err)
+	assert.Equal(t, ResultPassed, predicate.Result)
+
+	predicate, err = Parse([]byte(testLog), map[string]int{LevelError: 1})
+	require.NoError(t, err)
+	assert.Equal(t, ResultFailed, predicate.Result)
+	assert.Equal(t, map[string]int{LevelError: 1}, predicate.Thresholds)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse([]byte("not json"), nil)
+	assert.ErrorContains(t, err, "failed to parse JSON")
+	_, err = Parse([]byte(`{"version": "2.1.0"}`), nil)
+	assert.ErrorContains(t, err, "missing runs")
+}
+
+func TestParseThresholds(t *testing.T) {
+	thresholds, err := ParseThresholds(" Error=0, warning=10 ")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{LevelError: 0, LevelWarning: 10}, thresholds)
+
+	thresholds, err = ParseThresholds("")
+	require.NoError(t, err)
+	assert.Nil(t, thresholds)
+
+	_, err = ParseThresholds("critical=1")
+	assert.ErrorContains(t, err, "invalid threshold 'critical=1'")
+	_, err = ParseThresholds("error=-1")
+	assert.ErrorContains(t, err, "count must be a non-negative number")
+	_, err = ParseThresholds("error")
+	assert.ErrorContains(t, err, "expected <level>=<count>")
+}
+
+func TestParseFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.sarif")
+	require.NoError(t, os.WriteFile(path, []byte(testLog), 0o644))
+	predicate, err := ParseFile(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "results.sarif", predicate.Source.Name)
+	assert.Len(t, predicate.Source.Digest["sha256"], 64)
+
+	_, err = ParseFile(filepath.Join(t.TempDir(), "missing.sarif"), nil)
+	assert.ErrorContains(t, err, "failed to read SARIF file")
+}
+
+func TestMarkdown(t *testing.T) {
+	predicate, err := Parse([]byte(testLog), map[string]int{LevelError: 0})
+	require.NoError(t, err)
+	markdown := string(predicate.Markdown())
+	assert.Contains(t, markdown, "# Code scanning: FAILED")
+	assert.Contains(t, markdown, "| Semgrep 1.50.0 | 2 | 1 | 1 | 0 |")
+	assert.Contains(t, markdown, "| **Total** | **2** | **2** | **1** | **0** |")
+	assert.Contains(t, markdown, "| error | 0 | 2 |")
+	assert.Contains(t, markdown, "| go.sql-injection | error | 2 |")
+	assert.Contains(t, markdown, "## gosec rules")
+}
@@ -0,0 +1,335 @@
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+const (
+	// PredicateTypeAuto makes the predicate type follow the detected SBOM format and version.
+	PredicateTypeAuto = "auto"
+	// ReferencePredicateType is the predicate type of a reference to an SBOM stored as an attachment.
+	ReferencePredicateType = "https://jfrog.com/evidence/sbom-reference/v1"
+
+	FormatCycloneDX = "CycloneDX"
+	FormatSPDX      = "SPDX"
+
+	cycloneDXPredicateType = "https://cyclonedx.org/bom/v"
+	spdxPredicateType      = "https://spdx.dev/Document/v"
+)
+
+var (
+	cycloneDXVersions = []string{"1.2", "1.3", "1.4", "1.5", "1.6"}
+	spdxVersions      = []string{"2.2", "2.3"}
+)
+
+// Document is a parsed and validated SBOM.
+type Document struct {
+	Format        string
+	Version       string
+	PredicateType string
+	Summary       Summary
+	Sha256        string
+	Size          int
+}
+
+// Summary holds the figures shown in the generated markdown.
+type Summary struct {
+	Name       string         `json:"name,omitempty"`
+	Components int            `json:"components"`
+	Licenses   map[string]int `json:"licenses"`
+	// Dependencies are the direct dependencies of the described component, as name@version.
+	Dependencies []string `json:"dependencies"`
+}
+
+// Reference is the predicate recorded instead of the SBOM when the SBOM is stored as an attachment.
+type Reference struct {
+	PredicateType string            `json:"predicateType"`
+	Format        string            `json:"format"`
+	SpecVersion   string            `json:"specVersion"`
+	Name          string            `json:"name"`
+	Digest        map[string]string `json:"digest"`
+	Size          int               `json:"size"`
+	Summary       Summary           `json:"summary"`
+}
+
+// Parse detects the format and version of a CycloneDX or SPDX JSON document and validates it.
+func Parse(content []byte) (*Document, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return nil, fmt.Errorf("the predicate is not a JSON document, only CycloneDX and SPDX JSON are supported: %w", err)
+	}
+	var document *Document
+	var err error
+	switch {
+	case fields["bomFormat"] != nil:
+		document, err = parseCycloneDX(content)
+	case fields["spdxVersion"] != nil:
+		document, err = parseSPDX(content)
+	default:
+		return nil, fmt.Errorf("unrecognized predicate format, expected a CycloneDX (bomFormat) or SPDX (spdxVersion) document")
+	}
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(content)
+	document.Sha256 = hex.EncodeToString(digest[:])
+	document.Size = len(content)
+	return document, nil
+}
+
+// Reference returns the predicate referencing the document stored as an attachment named name.
+func (d *Document) Reference(name string) ([]byte, error) {
+	return json.Marshal(Reference{
+		PredicateType: d.PredicateType,
+		Format:        d.Format,
+		SpecVersion:   d.Version,
+		Name:          name,
+		Digest:        map[string]string{"sha256": d.Sha256},
+		Size:          d.Size,
+		Summary:       d.Summary,
+	})
+}
+
+type cycloneDXDocument struct {
+	BomFormat   string `json:"bomFormat"`
+	SpecVersion string `json:"specVersion"`
+	Metadata    struct {
+		Component *cycloneDXComponent `json:"component"`
+	} `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+	Dependencies []struct {
+		Ref       string   `json:"ref"`
+		DependsOn []string `json:"dependsOn"`
+	} `json:"dependencies"`
+}
+
+type cycloneDXComponent struct {
+	BomRef   string `json:"bom-ref"`
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Licenses []struct {
+		License *struct {
+			Id   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"license"`
+		Expression string `json:"expression"`
+	} `json:"licenses"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+func parseCycloneDX(content []byte) (*Document, error) {
+	var bom cycloneDXDocument
+	if err := json.Unmarshal(content, &bom); err != nil {
+		return nil, fmt.Errorf("invalid CycloneDX document: %w", err)
+	}
+	var problems []string
+	if bom.BomFormat != FormatCycloneDX {
+		problems = append(problems, fmt.Sprintf("bomFormat must be '%s', got '%s'", FormatCycloneDX, bom.BomFormat))
+	}
+	if !slices.Contains(cycloneDXVersions, bom.SpecVersion) {
+		problems = append(problems, fmt.Sprintf("unsupported specVersion '%s', supported: %s", bom.SpecVersion, strings.Join(cycloneDXVersions, ", ")))
+	}
+
+	summary := Summary{Licenses: map[string]int{}, Dependencies: []string{}}
+	names := map[string]string{}
+	var walk func(path string, components []cycloneDXComponent)
+	walk = func(path string, components []cycloneDXComponent) {
+		for i, component := range components {
+			componentPath := fmt.Sprintf("%s[%d]", path, i)
+			if component.Name == "" {
+				problems = append(problems, componentPath+": missing name")
+			}
+			if component.Type == "" {
+				problems = append(problems, componentPath+": missing type")
+			}
+			summary.Components++
+			for _, license := range component.Licenses {
+				switch {
+				case license.Expression != "":
+					summary.Licenses[license.Expression]++
+				case license.License != nil && license.License.Id != "":
+					summary.Licenses[license.License.Id]++
+				case license.License != nil && license.License.Name != "":
+					summary.Licenses[license.License.Name]++
+				}
+			}
+			if component.BomRef != "" {
+				names[component.BomRef] = nameAndVersion(component.Name, component.Version)
+			}
+			walk(componentPath+".components", component.Components)
+		}
+	}
+	walk("components", bom.Components)
+	if len(problems) > 0 {
+		return nil, validationError(FormatCycloneDX, problems)
+	}
+
+	root := bom.Metadata.Component
+	if root != nil {
+		summary.Name = nameAndVersion(root.Name, root.Version)
+		for _, dependency := range bom.Dependencies {
+			if root.BomRef == "" || dependency.Ref != root.BomRef {
+				continue
+			}
+			for _, ref := range dependency.DependsOn {
+				summary.Dependencies = append(summary.Dependencies, orDefault(names[ref], ref))
+			}
+		}
+	}
+	return &Document{Format: FormatCycloneDX, Version: bom.SpecVersion, PredicateType: cycloneDXPredicateType + bom.SpecVersion, Summary: summary}, nil
+}
+
+type spdxDocument struct {
+	SpdxVersion string `json:"spdxVersion"`
+	SPDXID      string `json:"SPDXID"`
+	Name        string `json:"name"`
+	DataLicense string `json:"dataLicense"`
+	Packages    []struct {
+		SPDXID           string `json:"SPDXID"`
+		Name             string `json:"name"`
+		VersionInfo      string `json:"versionInfo"`
+		LicenseConcluded string `json:"licenseConcluded"`
+		LicenseDeclared  string `json:"licenseDeclared"`
+	} `json:"packages"`
+	DocumentDescribes []string `json:"documentDescribes"`
+	Relationships     []struct {
+		Element string `json:"spdxElementId"`
+		Type    string `json:"relationshipType"`
+		Related string `json:"relatedSpdxElement"`
+	} `json:"relationships"`
+}
+
+const spdxDocumentId = "SPDXRef-DOCUMENT"
+
+func parseSPDX(content []byte) (*Document, error) {
+	var doc spdxDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid SPDX document: %w", err)
+	}
+	var problems []string
+	version := strings.TrimPrefix(doc.SpdxVersion, "SPDX-")
+	if !strings.HasPrefix(doc.SpdxVersion, "SPDX-") || !slices.Contains(spdxVersions, version) {
+		problems = append(problems, fmt.Sprintf("unsupported spdxVersion '%s', supported: SPDX-%s", doc.SpdxVersion, strings.Join(spdxVersions, ", SPDX-")))
+	}
+	if doc.SPDXID != spdxDocumentId {
+		problems = append(problems, fmt.Sprintf("SPDXID must be '%s', got '%s'", spdxDocumentId, doc.SPDXID))
+	}
+	if doc.Name == "" {
+		problems = append(problems, "missing name")
+	}
+	if doc.DataLicense == "" {
+		problems = append(problems, "missing dataLicense")
+	}
+
+	summary := Summary{Name: doc.Name, Licenses: map[string]int{}, Dependencies: []string{}}
+	names := map[string]string{}
+	for i, pkg := range doc.Packages {
+		if pkg.SPDXID == "" {
+			problems = append(problems, fmt.Sprintf("packages[%d]: missing SPDXID", i))
+		}
+		if pkg.Name == "" {
+			problems = append(problems, fmt.Sprintf("packages[%d]: missing name", i))
+		}
+		summary.Components++
+		if license := spdxLicense(pkg.LicenseConcluded, pkg.LicenseDeclared); license != "" {
+			summary.Licenses[license]++
+		}
+		names[pkg.SPDXID] = nameAndVersion(pkg.Name, pkg.VersionInfo)
+	}
+	if len(problems) > 0 {
+		return nil, validationError(FormatSPDX, problems)
+	}
+
+	// The described packages are the roots; their direct dependencies are the top-level dependencies.
+	roots := map[string]bool{}
+	for _, id := range doc.DocumentDescribes {
+		roots[id] = true
+	}
+	for _, relationship := range doc.Relationships {
+		if relationship.Element == spdxDocumentId && relationship.Type == "DESCRIBES" {
+			roots[relationship.Related] = true
+		}
+	}
+	for _, relationship := range doc.Relationships {
+		switch {
+		case relationship.Type == "DEPENDS_ON" && roots[relationship.Element]:
+			summary.Dependencies = append(summary.Dependencies, orDefault(names[relationship.Related], relationship.Related))
+		case relationship.Type == "DEPENDENCY_OF" && roots[relationship.Related]:
+			summary.Dependencies = append(summary.Dependencies, orDefault(names[relationship.Element], relationship.Element))
+		}
+	}
+	return &Document{Format: FormatSPDX, Version: version, PredicateType: spdxPredicateType + version, Summary: summary}, nil
+}
+
+func spdxLicense(concluded, declared string) string {
+	for _, license := range []string{concluded, declared} {
+		if license != "" && license != "NOASSERTION" && license != "NONE" {
+			return license
+		}
+	}
+	return ""
+}
+
+// Markdown renders the component count, the licenses and the top-level dependencies.
+func (d *Document) Markdown() []byte {
+	var sb strings.Builder
+	title := "SBOM"
+	if d.Summary.Name != "" {
+		title += ": " + d.Summary.Name
+	}
+	sb.WriteString(fmt.Sprintf("# %s\n\n", title))
+	sb.WriteString(fmt.Sprintf("**Format:** %s %s  \n", d.Format, d.Version))
+	sb.WriteString(fmt.Sprintf("**Components:** %d\n", d.Summary.Components))
+
+	if len(d.Summary.Licenses) > 0 {
+		licenses := make([]string, 0, len(d.Summary.Licenses))
+		for license := range d.Summary.Licenses {
+			licenses = append(licenses, license)
+		}
+		sort.Slice(licenses, func(i, j int) bool {
+			if d.Summary.Licenses[licenses[i]] != d.Summary.Licenses[licenses[j]] {
+				return d.Summary.Licenses[licenses[i]] > d.Summary.Licenses[licenses[j]]
+			}
+			return licenses[i] < licenses[j]
+		})
+		sb.WriteString("\n## Licenses\n\n| License | Components |\n|---|---:|\n")
+		for _, license := range licenses {
+			sb.WriteString(fmt.Sprintf("| %s | %d |\n", utils.MarkdownCell(license), d.Summary.Licenses[license]))
+		}
+	}
+	if len(d.Summary.Dependencies) > 0 {
+		sb.WriteString("\n## Top-level dependencies\n\n")
+		for _, dependency := range d.Summary.Dependencies {
+			sb.WriteString(fmt.Sprintf("- `%s`\n", dependency))
+		}
+	}
+	return []byte(sb.String())
+}
+
+func validationError(format string, problems []string) error {
+	return errors.New("invalid " + format + " document:\n  - " + strings.Join(problems, "\n  - "))
+}
+
+func nameAndVersion(name, version string) string {
+	if version == "" {
+		return name
+	}
+	return name + "@" + version
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,125 @@
+package sbom
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cycloneDXDocumentJson = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "metadata": {"component": {"bom-ref": "app", "type": "application", "name": "app", "version": "1.0.0"}},
+  "components": [
+    {"bom-ref": "lib-a", "type": "library", "name": "lib-a", "version": "2.1.0", "licenses": [{"license": {"id": "MIT"}}],
+     "components": [{"type": "library", "name": "lib-a-core", "licenses": [{"license": {"name": "Custom"}}]}]},
+    {"bom-ref": "lib-b", "type": "library", "name": "lib-b", "version": "0.3.0", "licenses": [{"expression": "Apache-2.0 OR MIT"}]},
+    {"bom-ref": "lib-c", "type": "library", "name": "lib-c", "licenses": [{"license": {"id": "MIT"}}]}
+  ],
+  "dependencies": [
+    {"ref": "app", "dependsOn": ["lib-a", "lib-b", "unknown-ref"]},
+    {"ref": "lib-a", "dependsOn": ["lib-c"]}
+  ]
+}`
+
+const spdxDocumentJson = `{
+  "spdxVersion": "SPDX-2.3",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "app-sbom",
+  "dataLicense": "CC0-1.0",
+  "documentDescribes": ["SPDXRef-app"],
+  "packages": [
+    {"SPDXID": "SPDXRef-app", "name": "app", "versionInfo": "1.0.0", "licenseConcluded": "NOASSERTION"},
+    {"SPDXID": "SPDXRef-a", "name": "lib-a", "versionInfo": "2.1.0", "licenseConcluded": "MIT"},
+    {"SPDXID": "SPDXRef-b", "name": "lib-b", "licenseConcluded": "NOASSERTION", "licenseDeclared": "Apache-2.0"},
+    {"SPDXID": "SPDXRef-c", "name": "lib-c", "licenseConcluded": "MIT"}
+  ],
+  "relationships": [
+    {"spdxElementId": "SPDXRef-app", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-a"},
+    {"spdxElementId": "SPDXRef-b", "relationshipType": "DEPENDENCY_OF", "relatedSpdxElement": "SPDXRef-app"},
+    {"spdxElementId": "SPDXRef-a", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-c"}
+  ]
+}`
+
+func TestParse_CycloneDX(t *testing.T) {
+	document, err := Parse([]byte(cycloneDXDocumentJson))
+	require.NoError(t, err)
+	assert.Equal(t, FormatCycloneDX, document.Format)
+	assert.Equal(t, "1.5", document.Version)
+	assert.Equal(t, "https://cyclonedx.org/bom/v1.5", document.PredicateType)
+	assert.Equal(t, Summary{
+		Name:         "app@1.0.0",
+		Components:   4,
+		Licenses:     map[string]int{"MIT": 2, "Custom": 1, "Apache-2.0 OR MIT": 1},
+		Dependencies: []string{"lib-a@2.1.0", "lib-b@0.3.0", "unknown-ref"},
+	}, document.Summary)
+	assert.Len(t, document.Sha256, 64)
+	assert.Equal(t, len(cycloneDXDocumentJson), document.Size)
+}
+
+func TestParse_SPDX(t *testing.T) {
+	document, err := Parse([]byte(spdxDocumentJson))
+	require.NoError(t, err)
+	assert.Equal(t, FormatSPDX, document.Format)
+	assert.Equal(t, "2.3", document.Version)
+	assert.Equal(t, "https://spdx.dev/Document/v2.3", document.PredicateType)
+	assert.Equal(t, Summary{
+		Name:         "app-sbom",
+		Components:   4,
+		Licenses:     map[string]int{"MIT": 2, "Apache-2.0": 1},
+		Dependencies: []string{"lib-a@2.1.0", "lib-b"},
+	}, document.Summary)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{name: "not json", content: "<bom/>", expected: []string{"only CycloneDX and SPDX JSON are supported"}},
+		{name: "unknown format", content: `{"predicate": {}}`, expected: []string{"unrecognized predicate format"}},
+		{name: "cyclonedx", content: `{"bomFormat": "CycloneDx", "specVersion": "2.0", "components": [{"name": "a"}, {"type": "library", "components": [{"type": "file"}]}]}`, expected: []string{
+			"invalid CycloneDX document", "bomFormat must be 'CycloneDX'", "unsupported specVersion '2.0'",
+			"components[0]: missing type", "components[1]: missing name", "components[1].components[0]: missing name",
+		}},
+		{name: "spdx", content: `{"spdxVersion": "SPDX-3.0", "SPDXID": "x", "packages": [{"name": "a"}]}`, expected: []string{
+			"invalid SPDX document", "unsupported spdxVersion 'SPDX-3.0'", "SPDXID must be 'SPDXRef-DOCUMENT'", "missing name", "missing dataLicense", "packages[0]: missing SPDXID",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.content))
+			require.Error(t, err)
+			for _, expected := range tt.expected {
+				assert.Contains(t, err.Error(), expected)
+			}
+		})
+	}
+}
+
+func TestReference(t *testing.T) {
+	document, err := Parse([]byte(spdxDocumentJson))
+	require.NoError(t, err)
+	referenceJson, err := document.Reference("sbom.spdx.json")
+	require.NoError(t, err)
+	var reference Reference
+	require.NoError(t, json.Unmarshal(referenceJson, &reference))
+	assert.Equal(t, "https://spdx.dev/Document/v2.3", reference.PredicateType)
+	assert.Equal(t, "sbom.spdx.json", reference.Name)
+	assert.Equal(t, document.Sha256, reference.Digest["sha256"])
+	assert.Equal(t, document.Size, reference.Size)
+	assert.Equal(t, 4, reference.Summary.Components)
+}
+
+func TestMarkdown(t *testing.T) {
+	document, err := Parse([]byte(cycloneDXDocumentJson))
+	require.NoError(t, err)
+	markdown := string(document.Markdown())
+	assert.Contains(t, markdown, "# SBOM: app@1.0.0")
+	assert.Contains(t, markdown, "**Components:** 4")
+	assert.Contains(t, markdown, "| MIT | 2 |\n| Apache-2.0 OR MIT | 1 |\n| Custom | 1 |")
+	assert.Contains(t, markdown, "- `lib-a@2.1.0`")
+}
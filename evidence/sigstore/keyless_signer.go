@@ -0,0 +1,149 @@
+package sigstore
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/sign"
+)
+
+const (
+	DefaultFulcioURL = "https://fulcio.sigstore.dev"
+	DefaultRekorURL  = "https://rekor.sigstore.dev"
+
+	githubTokenRequestURLEnv   = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	githubTokenRequestTokenEnv = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+	sigstoreAudience           = "sigstore"
+
+	keylessRequestTimeout = 30 * time.Second
+)
+
+// KeylessOptions holds the endpoints and identity used for keyless (Fulcio + Rekor) signing.
+type KeylessOptions struct {
+	FulcioURL     string
+	RekorURL      string
+	IdentityToken string
+	// TSAURL optionally adds an RFC 3161 timestamp from this timestamp authority to the bundle.
+	TSAURL string
+}
+
+// newTransparencyLogs builds the transparency logs the signed bundle is recorded in.
+// It is a variable so tests can replace the Rekor client.
+var newTransparencyLogs = func(rekorURL string) []sign.Transparency {
+	return []sign.Transparency{sign.NewRekor(&sign.RekorOptions{BaseURL: rekorURL})}
+}
+
+// newCertificateProvider builds the Fulcio client used to obtain the short-lived signing certificate.
+var newCertificateProvider = func(fulcioURL string) sign.CertificateProvider {
+	return sign.NewFulcio(&sign.FulcioOptions{BaseURL: fulcioURL})
+}
+
+// SignStatementKeyless signs the in-toto statement with an ephemeral key, requests a
+// Fulcio certificate bound to the OIDC identity and records the signature in Rekor.
+// The returned bundle contains the DSSE envelope, certificate and transparency log entry.
+func SignStatementKeyless(statement []byte, payloadType string, opts KeylessOptions) (*bundle.Bundle, error) {
+	if opts.IdentityToken == "" {
+		return nil, errorutils.CheckErrorf("keyless signing requires an OIDC identity token")
+	}
+	fulcioURL := opts.FulcioURL
+	if fulcioURL == "" {
+		fulcioURL = DefaultFulcioURL
+	}
+	rekorURL := opts.RekorURL
+	if rekorURL == "" {
+		rekorURL = DefaultRekorURL
+	}
+
+	keypair, err := sign.NewEphemeralKeypair(nil)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to create ephemeral key pair: %s", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*keylessRequestTimeout)
+	defer cancel()
+
+	log.Debug("Requesting signing certificate from", fulcioURL, "and recording signature in", rekorURL)
+	content := &sign.DSSEData{Data: statement, PayloadType: payloadType}
+	bundleOptions := sign.BundleOptions{
+		CertificateProvider:        newCertificateProvider(fulcioURL),
+		CertificateProviderOptions: &sign.CertificateProviderOptions{IDToken: opts.IdentityToken},
+		TransparencyLogs:           newTransparencyLogs(rekorURL),
+		Context:                    ctx,
+	}
+	if opts.TSAURL != "" {
+		log.Debug("Requesting RFC 3161 timestamp from", opts.TSAURL)
+		bundleOptions.TimestampAuthorities = []*sign.TimestampAuthority{sign.NewTimestampAuthority(&sign.TimestampAuthorityOptions{URL: opts.TSAURL})}
+	}
+	protoBundle, err := sign.Bundle(content, keypair, bundleOptions)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("keyless signing failed: %s", err.Error())
+	}
+	return &bundle.Bundle{Bundle: protoBundle}, nil
+}
+
+// ResolveIdentityToken returns the explicitly provided OIDC token, or requests one from
+// the GitHub Actions token endpoint when running in a workflow with `id-token: write`.
+func ResolveIdentityToken(identityToken string) (string, error) {
+	if identityToken != "" {
+		return identityToken, nil
+	}
+	requestURL := os.Getenv(githubTokenRequestURLEnv)
+	requestToken := os.Getenv(githubTokenRequestTokenEnv)
+	if requestURL == "" || requestToken == "" {
+		return "", errorutils.CheckErrorf("no OIDC identity token available. Provide --identity-token or run in GitHub Actions with 'id-token: write' permission")
+	}
+	return fetchGitHubActionsToken(requestURL, requestToken)
+}
+
+func fetchGitHubActionsToken(requestURL, requestToken string) (string, error) {
+	parsed, err := url.Parse(requestURL)
+	if err != nil {
+		return "", errorutils.CheckErrorf("invalid %s: %s", githubTokenRequestURLEnv, err.Error())
+	}
+	query := parsed.Query()
+	query.Set("audience", sigstoreAudience)
+	parsed.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Timeout: keylessRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", errorutils.CheckErrorf("failed to request OIDC token from GitHub Actions: %s", err.Error())
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errorutils.CheckErrorf("GitHub Actions OIDC token request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var tokenResponse struct {
+		Value string `json:"value"`
+	}
+	if err = json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", errorutils.CheckErrorf("failed to parse GitHub Actions OIDC token response: %s", err.Error())
+	}
+	if tokenResponse.Value == "" {
+		return "", errorutils.CheckErrorf("GitHub Actions OIDC token response did not contain a token")
+	}
+	return tokenResponse.Value, nil
+}
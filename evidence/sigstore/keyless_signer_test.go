@@ -0,0 +1,207 @@
+package sigstore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	"github.com/sigstore/sigstore-go/pkg/sign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransparency struct {
+	called bool
+	pem    []byte
+}
+
+func (f *fakeTransparency) GetTransparencyLogEntry(_ context.Context, keyOrCertPEM []byte, _ *protobundle.Bundle) error {
+	f.called = true
+	f.pem = keyOrCertPEM
+	return nil
+}
+
+func newTestIdentityToken(t *testing.T) string {
+	claims, err := json.Marshal(map[string]string{"sub": "repo:jfrog/evidence:ref:refs/heads/main", "iss": "https://token.actions.githubusercontent.com"})
+	require.NoError(t, err)
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString(claims) + ".sig"
+}
+
+// newFulcioStandIn issues a certificate for the requested public key, signed by a throwaway CA.
+func newFulcioStandIn(t *testing.T) *httptest.Server {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-fulcio"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v2/signingCert", r.URL.Path)
+		assert.Contains(t, r.Header.Get("Authorization"), "Bearer ")
+		var req struct {
+			PublicKeyRequest struct {
+				PublicKey struct {
+					Content string `json:"content"`
+				} `json:"publicKey"`
+			} `json:"publicKeyRequest"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		block, _ := pem.Decode([]byte(req.PublicKeyRequest.PublicKey.Content))
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		leafTemplate := &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(10 * time.Minute),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		}
+		leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, caCert, pub, caKey)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		leafPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})
+		resp := map[string]any{
+			"signedCertificateEmbeddedSct": map[string]any{
+				"chain": map[string]any{"certificates": []string{string(leafPEM)}},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestSignStatementKeyless(t *testing.T) {
+	fulcio := newFulcioStandIn(t)
+	defer fulcio.Close()
+
+	tlog := &fakeTransparency{}
+	var rekorURL string
+	origTlogs := newTransparencyLogs
+	newTransparencyLogs = func(url string) []sign.Transparency {
+		rekorURL = url
+		return []sign.Transparency{tlog}
+	}
+	defer func() { newTransparencyLogs = origTlogs }()
+
+	statement := []byte(`{"_type":"https://in-toto.io/Statement/v1","subject":[{"digest":{"sha256":"abc"}}],"predicateType":"t","predicate":{}}`)
+	b, err := SignStatementKeyless(statement, "application/vnd.in-toto+json", KeylessOptions{
+		FulcioURL:     fulcio.URL,
+		RekorURL:      "https://rekor.example.com",
+		IdentityToken: newTestIdentityToken(t),
+	})
+	require.NoError(t, err)
+
+	assert.True(t, tlog.called)
+	assert.Equal(t, "https://rekor.example.com", rekorURL)
+	assert.Contains(t, string(tlog.pem), "BEGIN CERTIFICATE")
+
+	envelope, err := GetDSSEEnvelope(b)
+	require.NoError(t, err)
+	assert.Equal(t, statement, envelope.Payload)
+	assert.Len(t, envelope.Signatures, 1)
+	assert.NotNil(t, b.GetVerificationMaterial().GetCertificate())
+
+	raw, err := json.Marshal(b)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "dsseEnvelope")
+}
+
+func TestSignStatementKeyless_DefaultEndpoints(t *testing.T) {
+	var fulcioURL, rekorURL string
+	origTlogs, origCA := newTransparencyLogs, newCertificateProvider
+	newTransparencyLogs = func(url string) []sign.Transparency {
+		rekorURL = url
+		return nil
+	}
+	newCertificateProvider = func(url string) sign.CertificateProvider {
+		fulcioURL = url
+		return sign.NewFulcio(&sign.FulcioOptions{BaseURL: "http://127.0.0.1:0"})
+	}
+	defer func() { newTransparencyLogs, newCertificateProvider = origTlogs, origCA }()
+
+	_, err := SignStatementKeyless([]byte("{}"), "application/vnd.in-toto+json", KeylessOptions{IdentityToken: newTestIdentityToken(t)})
+	assert.Error(t, err)
+	assert.Equal(t, DefaultFulcioURL, fulcioURL)
+	assert.Equal(t, DefaultRekorURL, rekorURL)
+}
+
+func TestSignStatementKeyless_MissingToken(t *testing.T) {
+	_, err := SignStatementKeyless([]byte("{}"), "application/vnd.in-toto+json", KeylessOptions{})
+	assert.ErrorContains(t, err, "OIDC identity token")
+}
+
+func TestResolveIdentityToken_Explicit(t *testing.T) {
+	token, err := ResolveIdentityToken("explicit-token")
+	assert.NoError(t, err)
+	assert.Equal(t, "explicit-token", token)
+}
+
+func TestResolveIdentityToken_GitHubActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer request-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "sigstore", r.URL.Query().Get("audience"))
+		assert.Equal(t, "1", r.URL.Query().Get("api-version"))
+		_, _ = w.Write([]byte(`{"value":"oidc-token"}`))
+	}))
+	defer server.Close()
+
+	t.Setenv(githubTokenRequestURLEnv, server.URL+"/token?api-version=1")
+	t.Setenv(githubTokenRequestTokenEnv, "request-token")
+
+	token, err := ResolveIdentityToken("")
+	assert.NoError(t, err)
+	assert.Equal(t, "oidc-token", token)
+}
+
+func TestResolveIdentityToken_GitHubActionsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("forbidden"))
+	}))
+	defer server.Close()
+
+	t.Setenv(githubTokenRequestURLEnv, server.URL)
+	t.Setenv(githubTokenRequestTokenEnv, "request-token")
+
+	_, err := ResolveIdentityToken("")
+	assert.ErrorContains(t, err, "status 403")
+}
+
+func TestResolveIdentityToken_NotAvailable(t *testing.T) {
+	t.Setenv(githubTokenRequestURLEnv, "")
+	t.Setenv(githubTokenRequestTokenEnv, "")
+
+	_, err := ResolveIdentityToken("")
+	assert.ErrorContains(t, err, "--identity-token")
+}
@@ -0,0 +1,216 @@
+package slsa
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+const (
+	// PredicateType is the in-toto predicate type of SLSA v1 provenance.
+	PredicateType = "https://slsa.dev/provenance/v1"
+	// BuildType describes how the build definition was derived: from a build-info published to Artifactory.
+	BuildType = "https://jfrog.com/evidence/build-info/v1"
+	// builderIdPrefix is followed by the build agent name recorded in the build-info.
+	builderIdPrefix   = "https://jfrog.com/evidence/builders/"
+	defaultBuildAgent = "generic"
+)
+
+// Provenance is the SLSA v1 provenance predicate.
+type Provenance struct {
+	BuildDefinition BuildDefinition `json:"buildDefinition"`
+	RunDetails      RunDetails      `json:"runDetails"`
+}
+
+type BuildDefinition struct {
+	BuildType            string               `json:"buildType"`
+	ExternalParameters   ExternalParameters   `json:"externalParameters"`
+	InternalParameters   InternalParameters   `json:"internalParameters,omitempty"`
+	ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// ExternalParameters are the inputs of the build as requested by whoever triggered it.
+type ExternalParameters struct {
+	BuildName   string `json:"buildName"`
+	BuildNumber string `json:"buildNumber"`
+	Project     string `json:"project,omitempty"`
+	Vcs         []Vcs  `json:"vcs,omitempty"`
+}
+
+type Vcs struct {
+	Url      string `json:"url,omitempty"`
+	Revision string `json:"revision,omitempty"`
+	Branch   string `json:"branch,omitempty"`
+}
+
+// InternalParameters are set by the build platform, such as the agents. The build-info properties are left out:
+// they hold the environment captured by --collect-env, which may contain secrets.
+type InternalParameters struct {
+	Agent      *buildinfo.Agent `json:"agent,omitempty"`
+	BuildAgent *buildinfo.Agent `json:"buildAgent,omitempty"`
+	Principal  string           `json:"principal,omitempty"`
+}
+
+type ResourceDescriptor struct {
+	Uri         string            `json:"uri,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Digest      map[string]string `json:"digest,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type RunDetails struct {
+	Builder  Builder       `json:"builder"`
+	Metadata BuildMetadata `json:"metadata"`
+}
+
+type Builder struct {
+	Id      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+type BuildMetadata struct {
+	InvocationId string `json:"invocationId,omitempty"`
+	StartedOn    string `json:"startedOn,omitempty"`
+}
+
+// FromBuildInfo generates SLSA v1 provenance describing the build recorded in the published build-info.
+func FromBuildInfo(buildInfo *buildinfo.BuildInfo, project string) (*Provenance, error) {
+	if buildInfo == nil {
+		return nil, fmt.Errorf("build-info is required to generate provenance")
+	}
+	provenance := &Provenance{
+		BuildDefinition: BuildDefinition{
+			BuildType: BuildType,
+			ExternalParameters: ExternalParameters{
+				BuildName:   buildInfo.Name,
+				BuildNumber: buildInfo.Number,
+				Project:     project,
+				Vcs:         toVcs(buildInfo.VcsList),
+			},
+			InternalParameters: InternalParameters{
+				Agent:      buildInfo.Agent,
+				BuildAgent: buildInfo.BuildAgent,
+				Principal:  buildInfo.Principal,
+			},
+			ResolvedDependencies: resolvedDependencies(buildInfo),
+		},
+		RunDetails: RunDetails{
+			Builder: builder(buildInfo),
+			Metadata: BuildMetadata{
+				InvocationId: invocationId(buildInfo),
+			},
+		},
+	}
+	if buildInfo.Started != "" {
+		started, err := utils.ParseIsoTimestamp(buildInfo.Started)
+		if err != nil {
+			return nil, err
+		}
+		provenance.RunDetails.Metadata.StartedOn = started.UTC().Format(time.RFC3339)
+	}
+	return provenance, nil
+}
+
+// Marshal returns the provenance as predicate JSON.
+func (p *Provenance) Marshal() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+func toVcs(vcsList []buildinfo.Vcs) []Vcs {
+	var result []Vcs
+	for _, vcs := range vcsList {
+		result = append(result, Vcs{Url: vcs.Url, Revision: vcs.Revision, Branch: vcs.Branch})
+	}
+	return result
+}
+
+// resolvedDependencies lists the source revisions and the module dependencies consumed by the build,
+// deduplicated across modules.
+func resolvedDependencies(buildInfo *buildinfo.BuildInfo) []ResourceDescriptor {
+	var dependencies []ResourceDescriptor
+	for _, vcs := range buildInfo.VcsList {
+		if vcs.Url == "" {
+			continue
+		}
+		descriptor := ResourceDescriptor{Uri: gitUri(vcs.Url)}
+		if vcs.Revision != "" {
+			descriptor.Digest = map[string]string{"gitCommit": vcs.Revision}
+		}
+		if vcs.Branch != "" {
+			descriptor.Annotations = map[string]string{"branch": vcs.Branch}
+		}
+		dependencies = append(dependencies, descriptor)
+	}
+
+	seen := make(map[string]bool)
+	for _, module := range buildInfo.Modules {
+		for _, dependency := range module.Dependencies {
+			digest := checksumDigest(dependency.Checksum)
+			key := dependency.Id + "@" + dependency.Sha256 + dependency.Sha1
+			if seen[key] || (dependency.Id == "" && len(digest) == 0) {
+				continue
+			}
+			seen[key] = true
+			descriptor := ResourceDescriptor{Name: dependency.Id, Digest: digest}
+			if dependency.Repository != "" {
+				descriptor.Annotations = map[string]string{"repository": dependency.Repository}
+			}
+			dependencies = append(dependencies, descriptor)
+		}
+	}
+	return dependencies
+}
+
+func checksumDigest(checksum buildinfo.Checksum) map[string]string {
+	digest := make(map[string]string)
+	if checksum.Sha256 != "" {
+		digest["sha256"] = checksum.Sha256
+	}
+	if checksum.Sha1 != "" {
+		digest["sha1"] = checksum.Sha1
+	}
+	if len(digest) == 0 {
+		return nil
+	}
+	return digest
+}
+
+func gitUri(vcsUrl string) string {
+	if strings.HasPrefix(vcsUrl, "git+") {
+		return vcsUrl
+	}
+	return "git+" + vcsUrl
+}
+
+func builder(buildInfo *buildinfo.BuildInfo) Builder {
+	agentName := defaultBuildAgent
+	if buildInfo.BuildAgent != nil && buildInfo.BuildAgent.Name != "" {
+		agentName = strings.ToLower(buildInfo.BuildAgent.Name)
+	}
+	version := make(map[string]string)
+	for _, agent := range []*buildinfo.Agent{buildInfo.Agent, buildInfo.BuildAgent} {
+		if agent != nil && agent.Name != "" && agent.Version != "" {
+			version[agent.Name] = agent.Version
+		}
+	}
+	if len(version) == 0 {
+		version = nil
+	}
+	return Builder{Id: builderIdPrefix + url.PathEscape(agentName), Version: version}
+}
+
+// invocationId identifies the build run, preferring the CI job URL recorded in the build-info.
+func invocationId(buildInfo *buildinfo.BuildInfo) string {
+	if buildInfo.BuildUrl != "" {
+		return buildInfo.BuildUrl
+	}
+	if buildInfo.Name == "" {
+		return ""
+	}
+	return buildInfo.Name + "/" + buildInfo.Number
+}
@@ -0,0 +1,102 @@
+package slsa
+
+import (
+	"encoding/json"
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBuildInfo() *buildinfo.BuildInfo {
+	return &buildinfo.BuildInfo{
+		Name:       "my-build",
+		Number:     "42",
+		Started:    "2025-01-15T10:00:00.000+0000",
+		Agent:      &buildinfo.Agent{Name: "jfrog-cli-go", Version: "2.70.0"},
+		BuildAgent: &buildinfo.Agent{Name: "GENERIC", Version: "2.70.0"},
+		Principal:  "ci-user",
+		BuildUrl:   "https://github.com/my-org/app/actions/runs/1",
+		Properties: buildinfo.Env{"buildInfo.env.CI": "true", "buildInfo.env.DEPLOY_TOKEN": "s3cr3t"},
+		VcsList:    []buildinfo.Vcs{{Url: "https://github.com/my-org/app.git", Revision: "abc123", Branch: "main"}},
+		Modules: []buildinfo.Module{
+			{
+				Id: "app",
+				Dependencies: []buildinfo.Dependency{
+					{Id: "lodash:4.17.21", Repository: "npm-remote", Checksum: buildinfo.Checksum{Sha256: "dep-sha256", Sha1: "dep-sha1"}},
+				},
+			},
+			{
+				Id: "lib",
+				Dependencies: []buildinfo.Dependency{
+					{Id: "lodash:4.17.21", Repository: "npm-remote", Checksum: buildinfo.Checksum{Sha256: "dep-sha256", Sha1: "dep-sha1"}},
+					{Id: "left-pad:1.3.0", Checksum: buildinfo.Checksum{Sha1: "pad-sha1"}},
+				},
+			},
+		},
+	}
+}
+
+func TestFromBuildInfo(t *testing.T) {
+	provenance, err := FromBuildInfo(testBuildInfo(), "my-project")
+	require.NoError(t, err)
+
+	definition := provenance.BuildDefinition
+	assert.Equal(t, BuildType, definition.BuildType)
+	assert.Equal(t, ExternalParameters{
+		BuildName:   "my-build",
+		BuildNumber: "42",
+		Project:     "my-project",
+		Vcs:         []Vcs{{Url: "https://github.com/my-org/app.git", Revision: "abc123", Branch: "main"}},
+	}, definition.ExternalParameters)
+	assert.Equal(t, "ci-user", definition.InternalParameters.Principal)
+	predicateJson, err := provenance.Marshal()
+	require.NoError(t, err)
+	assert.NotContains(t, string(predicateJson), "buildInfo.env")
+	assert.NotContains(t, string(predicateJson), "s3cr3t")
+
+	require.Len(t, definition.ResolvedDependencies, 3)
+	assert.Equal(t, ResourceDescriptor{
+		Uri:         "git+https://github.com/my-org/app.git",
+		Digest:      map[string]string{"gitCommit": "abc123"},
+		Annotations: map[string]string{"branch": "main"},
+	}, definition.ResolvedDependencies[0])
+	assert.Equal(t, ResourceDescriptor{
+		Name:        "lodash:4.17.21",
+		Digest:      map[string]string{"sha256": "dep-sha256", "sha1": "dep-sha1"},
+		Annotations: map[string]string{"repository": "npm-remote"},
+	}, definition.ResolvedDependencies[1])
+	assert.Equal(t, ResourceDescriptor{Name: "left-pad:1.3.0", Digest: map[string]string{"sha1": "pad-sha1"}}, definition.ResolvedDependencies[2])
+
+	run := provenance.RunDetails
+	assert.Equal(t, "https://jfrog.com/evidence/builders/generic", run.Builder.Id)
+	assert.Equal(t, map[string]string{"jfrog-cli-go": "2.70.0", "GENERIC": "2.70.0"}, run.Builder.Version)
+	assert.Equal(t, "https://github.com/my-org/app/actions/runs/1", run.Metadata.InvocationId)
+	assert.Equal(t, "2025-01-15T10:00:00Z", run.Metadata.StartedOn)
+}
+
+func TestFromBuildInfo_Minimal(t *testing.T) {
+	provenance, err := FromBuildInfo(&buildinfo.BuildInfo{Name: "my-build", Number: "1"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://jfrog.com/evidence/builders/generic", provenance.RunDetails.Builder.Id)
+	assert.Nil(t, provenance.RunDetails.Builder.Version)
+	assert.Equal(t, "my-build/1", provenance.RunDetails.Metadata.InvocationId)
+	assert.Empty(t, provenance.RunDetails.Metadata.StartedOn)
+	assert.Empty(t, provenance.BuildDefinition.ResolvedDependencies)
+
+	predicate, err := provenance.Marshal()
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(predicate, &decoded))
+	assert.Contains(t, decoded, "buildDefinition")
+	assert.Contains(t, decoded, "runDetails")
+}
+
+func TestFromBuildInfo_Errors(t *testing.T) {
+	_, err := FromBuildInfo(nil, "")
+	assert.ErrorContains(t, err, "build-info is required")
+
+	_, err = FromBuildInfo(&buildinfo.BuildInfo{Name: "my-build", Number: "1", Started: "yesterday"}, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+// Package tsa obtains and verifies RFC 3161 timestamp tokens over evidence signatures.
+package tsa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/sigstore/timestamp-authority/v2/pkg/verification"
+)
+
+const (
+	timestampQueryContentType = "application/timestamp-query"
+	timestampReplyContentType = "application/timestamp-reply"
+	requestTimeout            = 30 * time.Second
+	nonceBits                 = 64
+)
+
+// httpClient is used to reach the timestamp authority. It is a variable so tests can replace it.
+var httpClient = &http.Client{Timeout: requestTimeout}
+
+// CertChain holds the trusted certificates of a timestamp authority.
+type CertChain struct {
+	Roots         []*x509.Certificate
+	Intermediates []*x509.Certificate
+}
+
+// RequestTimestamp requests an RFC 3161 timestamp over the SHA-256 digest of signature from the
+// timestamp authority at tsaURL and returns the DER encoded TimeStampResp.
+func RequestTimestamp(tsaURL string, signature []byte) ([]byte, error) {
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), nonceBits))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	request, err := timestamp.CreateRequest(bytes.NewReader(signature), &timestamp.RequestOptions{
+		Hash:         crypto.SHA256,
+		Certificates: true,
+		Nonce:        nonce,
+	})
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to create timestamp request: %s", err.Error())
+	}
+
+	log.Debug("Requesting RFC 3161 timestamp from", tsaURL)
+	req, err := http.NewRequest(http.MethodPost, tsaURL, bytes.NewReader(request))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	req.Header.Set("Content-Type", timestampQueryContentType)
+	req.Header.Set("Accept", timestampReplyContentType)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("timestamp request to %s failed: %s", tsaURL, err.Error())
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckErrorf("timestamp authority %s responded with status %d", tsaURL, resp.StatusCode)
+	}
+
+	ts, err := timestamp.ParseResponse(body)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid timestamp response from %s: %s", tsaURL, err.Error())
+	}
+	if ts.Nonce == nil || ts.Nonce.Cmp(nonce) != 0 {
+		return nil, errorutils.CheckErrorf("timestamp response nonce does not match the request")
+	}
+	if err = verifyHashedMessage(ts, signature); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return body, nil
+}
+
+// Verify validates the timestamp response against the TSA certificate chain and checks that it
+// covers signature. It returns the time asserted by the timestamp authority.
+func Verify(timestampResponse, signature []byte, chain *CertChain) (time.Time, error) {
+	if chain == nil || len(chain.Roots) == 0 {
+		return time.Time{}, fmt.Errorf("no trusted TSA root certificates configured")
+	}
+	ts, err := verification.VerifyTimestampResponse(timestampResponse, bytes.NewReader(signature), verification.VerifyOpts{
+		Roots:         chain.Roots,
+		Intermediates: chain.Intermediates,
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	return ts.Time, nil
+}
+
+// LoadCertChain reads PEM encoded TSA certificates. Self-signed certificates are trusted as roots,
+// all others are used as intermediates.
+func LoadCertChain(path string) (*CertChain, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TSA certificate chain %s: %w", path, err)
+	}
+	chain, err := ParseCertChain(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TSA certificate chain %s: %w", path, err)
+	}
+	return chain, nil
+}
+
+// ParseCertChain parses PEM encoded TSA certificates, see LoadCertChain.
+func ParseCertChain(content []byte) (*CertChain, error) {
+	chain := &CertChain{}
+	for {
+		var block *pem.Block
+		block, content = pem.Decode(content)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		if isSelfSigned(cert) {
+			chain.Roots = append(chain.Roots, cert)
+		} else {
+			chain.Intermediates = append(chain.Intermediates, cert)
+		}
+	}
+	if len(chain.Roots) == 0 {
+		return nil, fmt.Errorf("no self-signed root certificate found")
+	}
+	return chain, nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+}
+
+// verifyHashedMessage checks that the timestamp covers signature. Hash algorithms unknown to the timestamp
+// parser are reported as crypto.Hash(0), which cannot be instantiated.
+func verifyHashedMessage(ts *timestamp.Timestamp, signature []byte) error {
+	if !ts.HashAlgorithm.Available() {
+		return fmt.Errorf("timestamp uses an unsupported hash algorithm")
+	}
+	h := ts.HashAlgorithm.New()
+	h.Write(signature)
+	if !bytes.Equal(h.Sum(nil), ts.HashedMessage) {
+		return fmt.Errorf("timestamp does not cover the signature")
+	}
+	return nil
+}
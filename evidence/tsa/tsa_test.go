@@ -0,0 +1,91 @@
+package tsa
+
+import (
+	"crypto"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa/tsatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestAndVerifyTimestamp(t *testing.T) {
+	server := tsatest.NewServer(t)
+	server.Time = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	signature := []byte("signature-bytes")
+
+	response, err := RequestTimestamp(server.URL, signature)
+	require.NoError(t, err)
+	assert.Equal(t, 1, server.Requests)
+
+	chainPath := filepath.Join(t.TempDir(), "tsa-chain.pem")
+	require.NoError(t, os.WriteFile(chainPath, server.ChainPEM, 0600))
+	chain, err := LoadCertChain(chainPath)
+	require.NoError(t, err)
+	assert.Len(t, chain.Roots, 1)
+	assert.Len(t, chain.Intermediates, 1)
+
+	signedAt, err := Verify(response, signature, chain)
+	require.NoError(t, err)
+	assert.True(t, server.Time.Equal(signedAt))
+
+	_, err = Verify(response, []byte("other-signature"), chain)
+	assert.ErrorContains(t, err, "hashed messages don't match")
+}
+
+func TestVerify_UntrustedChain(t *testing.T) {
+	server := tsatest.NewServer(t)
+	other := tsatest.NewServer(t)
+	signature := []byte("signature-bytes")
+
+	response, err := RequestTimestamp(server.URL, signature)
+	require.NoError(t, err)
+	otherChain, err := ParseCertChain(other.ChainPEM)
+	require.NoError(t, err)
+
+	_, err = Verify(response, signature, otherChain)
+	assert.ErrorContains(t, err, "invalid timestamp")
+
+	_, err = Verify(response, signature, nil)
+	assert.ErrorContains(t, err, "no trusted TSA root certificates")
+}
+
+func TestRequestTimestamp_Errors(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	_, err := RequestTimestamp(failing.URL, []byte("sig"))
+	assert.ErrorContains(t, err, "responded with status 503")
+
+	garbage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("not a timestamp"))
+	}))
+	defer garbage.Close()
+	_, err = RequestTimestamp(garbage.URL, []byte("sig"))
+	assert.ErrorContains(t, err, "invalid timestamp response")
+}
+
+func TestVerifyHashedMessage(t *testing.T) {
+	signature := []byte("sig")
+	digest := crypto.SHA256.New()
+	digest.Write(signature)
+	assert.NoError(t, verifyHashedMessage(&timestamp.Timestamp{HashAlgorithm: crypto.SHA256, HashedMessage: digest.Sum(nil)}, signature))
+	assert.ErrorContains(t, verifyHashedMessage(&timestamp.Timestamp{HashAlgorithm: crypto.SHA256}, signature), "does not cover the signature")
+	// Unknown hash OIDs are parsed as crypto.Hash(0).
+	assert.ErrorContains(t, verifyHashedMessage(&timestamp.Timestamp{}, signature), "unsupported hash algorithm")
+}
+
+func TestParseCertChain_Errors(t *testing.T) {
+	_, err := ParseCertChain([]byte("no pem here"))
+	assert.ErrorContains(t, err, "no self-signed root certificate")
+
+	_, err = LoadCertChain(filepath.Join(t.TempDir(), "missing.pem"))
+	assert.ErrorContains(t, err, "failed to read TSA certificate chain")
+}
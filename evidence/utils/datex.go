@@ -1,9 +1,57 @@
 package utils
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 const IsoDateTimeLayout = "2006-01-02T15:04:05.000-0700"
 
+// DateLayout is accepted by ParseTimestamp for whole days, interpreted as midnight UTC.
+const DateLayout = "2006-01-02"
+
+const day = 24 * time.Hour
+
 func ParseIsoTimestamp(isoTimestamp string) (time.Time, error) {
 	return time.Parse(IsoDateTimeLayout, isoTimestamp)
 }
+
+// ParseTimestamp parses evidence timestamps, accepting RFC 3339, the ISO layout used by the evidence service
+// and plain dates (YYYY-MM-DD).
+func ParseTimestamp(timestamp string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(DateLayout, timestamp); err == nil {
+		return t, nil
+	}
+	return ParseIsoTimestamp(timestamp)
+}
+
+// ParseDuration extends time.ParseDuration with whole day ("d") and week ("w") units, e.g. "90d" or "2w".
+func ParseDuration(duration string) (time.Duration, error) {
+	duration = strings.TrimSpace(duration)
+	if duration == "" {
+		return 0, fmt.Errorf("duration cannot be empty")
+	}
+	var unit time.Duration
+	switch {
+	case strings.HasSuffix(duration, "d"):
+		unit = day
+	case strings.HasSuffix(duration, "w"):
+		unit = 7 * day
+	default:
+		parsed, err := time.ParseDuration(duration)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s': %w", duration, err)
+		}
+		return parsed, nil
+	}
+	count, err := strconv.Atoi(duration[:len(duration)-1])
+	if err != nil || count < 0 {
+		return 0, fmt.Errorf("invalid duration '%s': expected a non-negative whole number followed by a unit", duration)
+	}
+	return time.Duration(count) * unit, nil
+}
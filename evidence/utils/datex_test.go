@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{input: "90d", expected: 90 * 24 * time.Hour},
+		{input: "2w", expected: 14 * 24 * time.Hour},
+		{input: "36h", expected: 36 * time.Hour},
+		{input: "1h30m", expected: 90 * time.Minute},
+		{input: "", wantErr: true},
+		{input: "xd", wantErr: true},
+		{input: "-1d", wantErr: true},
+		{input: "10y", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	expected := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	got, err := ParseTimestamp("2025-01-02T03:04:05.000Z")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(got))
+
+	got, err = ParseTimestamp("2025-01-02T03:04:05.000+0000")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(got))
+
+	got, err = ParseTimestamp("2025-01-02")
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC).Equal(got))
+
+	_, err = ParseTimestamp("yesterday")
+	assert.Error(t, err)
+}
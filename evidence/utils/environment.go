@@ -15,3 +15,7 @@ func GetEnvVariable(envVarName string) (string, error) {
 func IsRunningUnderGitHubAction() bool {
 	return os.Getenv("GITHUB_ACTIONS") == "true"
 }
+
+func IsRunningUnderGitLabCI() bool {
+	return os.Getenv("GITLAB_CI") == "true"
+}
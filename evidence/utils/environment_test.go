@@ -79,3 +79,11 @@ func TestIsRunningUnderGitHubAction_OtherValue(t *testing.T) {
 	result := IsRunningUnderGitHubAction()
 	assert.False(t, result)
 }
+
+func TestIsRunningUnderGitLabCI(t *testing.T) {
+	t.Setenv("GITLAB_CI", "true")
+	assert.True(t, IsRunningUnderGitLabCI())
+
+	t.Setenv("GITLAB_CI", "false")
+	assert.False(t, IsRunningUnderGitLabCI())
+}
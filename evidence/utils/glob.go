@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// ExpandGlob returns the files matching pattern, sorted. Besides the usual wildcards the pattern
+// may use '**' to match any number of directories, e.g. 'build/test-results/**/*.xml'.
+func ExpandGlob(pattern string) ([]string, error) {
+	base, relativePattern := doublestar.SplitPattern(filepath.ToSlash(pattern))
+	if !doublestar.ValidatePattern(relativePattern) {
+		return nil, fmt.Errorf("invalid file pattern '%s'", pattern)
+	}
+	matches, err := doublestar.Glob(os.DirFS(filepath.FromSlash(base)), relativePattern)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, match := range matches {
+		path := filepath.Join(filepath.FromSlash(base), filepath.FromSlash(match))
+		if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+			files = append(files, path)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
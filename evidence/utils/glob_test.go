@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandGlob(t *testing.T) {
+	dir := t.TempDir()
+	for _, file := range []string{"a/TEST-one.xml", "a/b/TEST-two.xml", "a/b/notes.txt", "TEST-root.xml"} {
+		path := filepath.Join(dir, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte("x"), 0600))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "a", "dir.xml"), 0755))
+
+	files, err := ExpandGlob(filepath.Join(dir, "**", "*.xml"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "TEST-root.xml"),
+		filepath.Join(dir, "a", "TEST-one.xml"),
+		filepath.Join(dir, "a", "b", "TEST-two.xml"),
+	}, files)
+
+	files, err = ExpandGlob(filepath.Join(dir, "a", "*.xml"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a", "TEST-one.xml")}, files)
+
+	files, err = ExpandGlob(filepath.Join(dir, "missing", "*.xml"))
+	require.NoError(t, err)
+	assert.Empty(t, files)
+
+	t.Chdir(dir)
+	files, err = ExpandGlob("a/b/*.xml")
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("a", "b", "TEST-two.xml")}, files)
+
+	_, err = ExpandGlob("a/[b")
+	assert.ErrorContains(t, err, "invalid file pattern")
+}
@@ -0,0 +1,17 @@
+package utils
+
+import "strings"
+
+// MarkdownCell makes a value safe to place in a markdown table cell. Pipes are escaped, and line breaks,
+// which would end the table row, are folded with the surrounding whitespace into single spaces.
+func MarkdownCell(value string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(value, "|", "\\|")), " ")
+}
+
+// ShortSha abbreviates a commit hash or sha256 digest to its first 12 characters.
+func ShortSha(sha string) string {
+	if len(sha) > 12 {
+		return sha[:12]
+	}
+	return sha
+}
@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownCell(t *testing.T) {
+	assert.Equal(t, "plain", MarkdownCell("plain"))
+	assert.Equal(t, `a \| b`, MarkdownCell("a | b"))
+	assert.Equal(t, "first line second line", MarkdownCell("first line\nsecond line"))
+	assert.Equal(t, `x \| y z`, MarkdownCell(" x |\r\n  y\tz "))
+	assert.Equal(t, "", MarkdownCell(""))
+}
+
+func TestShortSha(t *testing.T) {
+	assert.Equal(t, "0123456789ab", ShortSha("0123456789abcdef"))
+	assert.Equal(t, "abc", ShortSha("abc"))
+}
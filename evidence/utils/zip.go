@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// ZipEntry is a local file stored in a zip archive under Name, a slash separated path.
+type ZipEntry struct {
+	Path string
+	Name string
+}
+
+// ZipFiles creates a zip archive at archivePath holding the entries, in order.
+func ZipFiles(archivePath string, entries []ZipEntry) (err error) {
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		if closeErr := archive.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	writer := zip.NewWriter(archive)
+	for _, entry := range entries {
+		if err = addZipEntry(writer, entry); err != nil {
+			return err
+		}
+	}
+	return errorutils.CheckError(writer.Close())
+}
+
+func addZipEntry(writer *zip.Writer, entry ZipEntry) error {
+	source, err := os.Open(entry.Path)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+	destination, err := writer.Create(entry.Name)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err = io.Copy(destination, source)
+	return errorutils.CheckError(err)
+}
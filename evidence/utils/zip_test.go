@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"archive/zip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZipFiles(t *testing.T) {
+	dir := t.TempDir()
+	first, second := filepath.Join(dir, "first.txt"), filepath.Join(dir, "second.txt")
+	require.NoError(t, os.WriteFile(first, []byte("one"), 0o600))
+	require.NoError(t, os.WriteFile(second, []byte("two"), 0o600))
+	archivePath := filepath.Join(dir, "files.zip")
+
+	require.NoError(t, ZipFiles(archivePath, []ZipEntry{{Path: first, Name: "a/first.txt"}, {Path: second, Name: "second.txt"}}))
+
+	archive, err := zip.OpenReader(archivePath)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, archive.Close())
+	}()
+	contents := map[string]string{}
+	var names []string
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		names = append(names, file.Name)
+		contents[file.Name] = string(content)
+	}
+	assert.Equal(t, []string{"a/first.txt", "second.txt"}, names)
+	assert.Equal(t, map[string]string{"a/first.txt": "one", "second.txt": "two"}, contents)
+}
+
+func TestZipFiles_MissingFile(t *testing.T) {
+	dir := t.TempDir()
+	err := ZipFiles(filepath.Join(dir, "files.zip"), []ZipEntry{{Path: filepath.Join(dir, "missing.txt"), Name: "missing.txt"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,179 @@
+package assertions
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/google/cel-go/cel"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Variables available to assertion expressions.
+const (
+	// StatementVariable is the full decoded in-toto statement.
+	StatementVariable = "statement"
+	// PredicateVariable is the statement predicate.
+	PredicateVariable = "predicate"
+	// PredicateTypeVariable is the statement predicate type.
+	PredicateTypeVariable = "predicateType"
+	// SubjectVariable is the list of statement subjects.
+	SubjectVariable = "subject"
+)
+
+// Assertions holds compiled CEL expressions keyed by predicate type.
+//
+// Example file:
+//
+//	https://jfrog.com/evidence/sonar/v1:
+//	  - predicate.gates.exists(g, g.status == "OK")
+//	https://slsa.dev/provenance/v1:
+//	  - predicate.buildDefinition.buildType.startsWith("https://")
+type Assertions struct {
+	programs map[string][]*compiledAssertion
+}
+
+type compiledAssertion struct {
+	expression string
+	program    cel.Program
+}
+
+// Load reads and compiles an assertions file.
+func Load(path string) (*Assertions, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read assertions file %s: %w", path, err)
+	}
+	a, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid assertions file %s: %w", path, err)
+	}
+	return a, nil
+}
+
+// Parse decodes a YAML (or JSON) map of predicate type to expressions and compiles every expression.
+// Expressions must evaluate to a boolean.
+func Parse(content []byte) (*Assertions, error) {
+	var raw map[string][]string
+	if err := yaml.NewDecoder(bytes.NewReader(content)).Decode(&raw); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return Compile(raw)
+}
+
+// Compile compiles the expressions of every predicate type.
+func Compile(expressions map[string][]string) (*Assertions, error) {
+	env, err := newEnv()
+	if err != nil {
+		return nil, err
+	}
+	a := &Assertions{programs: map[string][]*compiledAssertion{}}
+	for _, predicateType := range sortedKeys(expressions) {
+		for _, expression := range expressions[predicateType] {
+			ast, issues := env.Compile(expression)
+			if issues != nil && issues.Err() != nil {
+				return nil, fmt.Errorf("predicate '%s': failed to compile assertion '%s': %w", predicateType, expression, issues.Err())
+			}
+			if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+				return nil, fmt.Errorf("predicate '%s': assertion '%s' must evaluate to a boolean, got %s", predicateType, expression, ast.OutputType())
+			}
+			program, err := env.Program(ast)
+			if err != nil {
+				return nil, fmt.Errorf("predicate '%s': failed to build assertion '%s': %w", predicateType, expression, err)
+			}
+			a.programs[predicateType] = append(a.programs[predicateType], &compiledAssertion{expression: expression, program: program})
+		}
+	}
+	return a, nil
+}
+
+// HasAssertions reports whether any expression is registered for the predicate type.
+func (a *Assertions) HasAssertions(predicateType string) bool {
+	return a != nil && len(a.programs[predicateType]) > 0
+}
+
+// Evaluate runs the expressions registered for the predicate type against the raw in-toto statement.
+// An expression that does not evaluate to true, or fails to evaluate, is recorded as failed.
+func (a *Assertions) Evaluate(predicateType string, statement []byte) []model.AssertionResult {
+	programs := a.programs[predicateType]
+	results := make([]model.AssertionResult, 0, len(programs))
+
+	activation, err := newActivation(statement)
+	if err != nil {
+		for _, p := range programs {
+			results = append(results, model.AssertionResult{Expression: p.expression, Status: model.Failed, Error: err.Error()})
+		}
+		return results
+	}
+	for _, p := range programs {
+		results = append(results, p.evaluate(activation))
+	}
+	return results
+}
+
+func (c *compiledAssertion) evaluate(activation map[string]any) model.AssertionResult {
+	result := model.AssertionResult{Expression: c.expression, Status: model.Failed}
+	value, _, err := c.program.Eval(activation)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	passed, ok := value.Value().(bool)
+	if !ok {
+		result.Error = fmt.Sprintf("assertion evaluated to %v instead of a boolean", value.Value())
+		return result
+	}
+	if passed {
+		result.Status = model.Success
+	}
+	return result
+}
+
+func newEnv() (*cel.Env, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(StatementVariable, cel.DynType),
+		cel.Variable(PredicateVariable, cel.DynType),
+		cel.Variable(PredicateTypeVariable, cel.StringType),
+		cel.Variable(SubjectVariable, cel.ListType(cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	return env, nil
+}
+
+func newActivation(statement []byte) (map[string]any, error) {
+	var decoded map[string]any
+	if err := json.Unmarshal(statement, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode in-toto statement: %w", err)
+	}
+	predicateType, _ := decoded["predicateType"].(string)
+	subject, _ := decoded["subject"].([]any)
+	if subject == nil {
+		subject = []any{}
+	}
+	predicate := decoded["predicate"]
+	if predicate == nil {
+		predicate = map[string]any{}
+	}
+	return map[string]any{
+		StatementVariable:     decoded,
+		PredicateVariable:     predicate,
+		PredicateTypeVariable: predicateType,
+		SubjectVariable:       subject,
+	}, nil
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,79 @@
+package assertions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sonarPredicateType = "https://jfrog.com/evidence/sonar/v1"
+
+const sonarStatement = `{
+  "_type": "https://in-toto.io/Statement/v1",
+  "subject": [{"name": "app.tgz", "digest": {"sha256": "abc"}}],
+  "predicateType": "https://jfrog.com/evidence/sonar/v1",
+  "predicate": {"gates": [{"name": "coverage", "status": "OK"}, {"name": "bugs", "status": "ERROR"}]}
+}`
+
+func TestLoadAndEvaluate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "assertions.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+https://jfrog.com/evidence/sonar/v1:
+  - predicate.gates.exists(g, g.status == "OK")
+  - predicate.gates.all(g, g.status == "OK")
+  - subject[0].digest.sha256 == "abc" && predicateType.endsWith("/v1")
+`), 0600))
+
+	a, err := Load(path)
+	require.NoError(t, err)
+	assert.True(t, a.HasAssertions(sonarPredicateType))
+	assert.False(t, a.HasAssertions("https://slsa.dev/provenance/v1"))
+
+	results := a.Evaluate(sonarPredicateType, []byte(sonarStatement))
+	require.Len(t, results, 3)
+	assert.Equal(t, model.AssertionResult{Expression: `predicate.gates.exists(g, g.status == "OK")`, Status: model.Success}, results[0])
+	assert.Equal(t, model.AssertionResult{Expression: `predicate.gates.all(g, g.status == "OK")`, Status: model.Failed}, results[1])
+	assert.Equal(t, model.Success, results[2].Status)
+}
+
+func TestEvaluate_RuntimeErrors(t *testing.T) {
+	a, err := Compile(map[string][]string{sonarPredicateType: {
+		`predicate.missing.status == "OK"`,
+		`predicate.gates[0].name`,
+	}})
+	require.NoError(t, err)
+
+	results := a.Evaluate(sonarPredicateType, []byte(sonarStatement))
+	require.Len(t, results, 2)
+	assert.Equal(t, model.Failed, results[0].Status)
+	assert.Contains(t, results[0].Error, "no such key")
+	assert.Equal(t, model.Failed, results[1].Status)
+	assert.Contains(t, results[1].Error, "instead of a boolean")
+
+	results = a.Evaluate(sonarPredicateType, []byte("not json"))
+	require.Len(t, results, 2)
+	assert.Contains(t, results[0].Error, "failed to decode in-toto statement")
+}
+
+func TestCompile_Errors(t *testing.T) {
+	_, err := Compile(map[string][]string{"t": {"predicate.gates.exists(g,"}})
+	assert.ErrorContains(t, err, "predicate 't': failed to compile assertion")
+
+	_, err = Compile(map[string][]string{"t": {`predicateType + "x"`}})
+	assert.ErrorContains(t, err, "must evaluate to a boolean")
+
+	_, err = Compile(map[string][]string{"t": {"unknown.field"}})
+	assert.ErrorContains(t, err, "undeclared reference")
+}
+
+func TestLoad_Errors(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yml"))
+	assert.ErrorContains(t, err, "failed to read assertions file")
+
+	_, err = Parse([]byte("t: not-a-list"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package verify
+
+// VerifyOption customizes optional behaviour of the verify commands shared across all subject types.
+type VerifyOption func(*verifyEvidenceBase)
+
+// WithPolicy evaluates the verification result against the policy file at policyPath.
+// The overall status is then derived from the policy rules.
+func WithPolicy(policyPath string) VerifyOption {
+	return func(v *verifyEvidenceBase) {
+		v.policyPath = policyPath
+	}
+}
+
+// WithAssertions evaluates the CEL assertions in the file at assertionsPath against the statement of
+// every evidence of a matching predicate type. A failed assertion fails the evidence verification.
+func WithAssertions(assertionsPath string) VerifyOption {
+	return func(v *verifyEvidenceBase) {
+		v.assertionsPath = assertionsPath
+	}
+}
+
+// WithTimestampCertChain validates RFC 3161 signature timestamps against the trusted TSA certificate chain
+// in the PEM file at certChainPath. Signing keys are then checked at the timestamped time instead of now.
+func WithTimestampCertChain(certChainPath string) VerifyOption {
+	return func(v *verifyEvidenceBase) {
+		v.tsaCertChainPath = certChainPath
+	}
+}
+
+// WithRevocations rejects evidence signed by keys listed in the revocation file at revocationsPath
+// after their revocation time.
+func WithRevocations(revocationsPath string) VerifyOption {
+	return func(v *verifyEvidenceBase) {
+		v.revocationsPath = revocationsPath
+	}
+}
+
+// WithAsOf evaluates evidence expiry and policy age rules at the given time (RFC 3339 or YYYY-MM-DD)
+// instead of now, for example to audit the evidence state at a past release date.
+func WithAsOf(asOf string) VerifyOption {
+	return func(v *verifyEvidenceBase) {
+		v.asOf = asOf
+	}
+}
+
+// WithSchemaValidation re-validates every evidence predicate against the JSON Schema registered for its
+// predicate type in evidence.yml.
+func WithSchemaValidation() VerifyOption {
+	return func(v *verifyEvidenceBase) {
+		v.validateSchemas = true
+	}
+}
+
+// WithDeepVerification downloads the subject and every attachment to compute their sha256 locally, instead of
+// trusting the checksums reported by Artifactory.
+func WithDeepVerification() VerifyOption {
+	return func(v *verifyEvidenceBase) {
+		v.deep = true
+	}
+}
+
+func (v *verifyEvidenceBase) applyOptions(opts []VerifyOption) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt(v)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+const (
+	RuleRequiredPredicateType = "required-predicate-type"
+	RuleMinCount              = "min-count"
+	RulePredicateConstraints  = "predicate-constraints"
+	RuleEvidenceVerification  = "evidence-verification"
+	RuleEvidenceAssertions    = "evidence-assertions"
+	RuleSubjectContent        = "subject-content"
+
+	fingerprintPrefix = "SHA256:"
+)
+
+// Evaluate applies the policy to the verification response of a subject of the given type.
+// Per-rule results are recorded on the response and the overall status is derived from them.
+func (p *Policy) Evaluate(response *model.VerificationResponse, subjectType string, now time.Time) *model.PolicyVerification {
+	var evidence []model.EvidenceVerification
+	if response.EvidenceVerifications != nil {
+		evidence = *response.EvidenceVerifications
+	}
+
+	result := &model.PolicyVerification{
+		PolicyPath:  p.path,
+		Status:      model.Success,
+		RuleResults: []model.PolicyRuleResult{},
+	}
+
+	required := p.requiredPredicateTypes(subjectType)
+	for _, predicateType := range required {
+		rule := p.rule(predicateType)
+		result.RuleResults = append(result.RuleResults, evaluateCount(RuleRequiredPredicateType, predicateType, rule, max(1, rule.MinCount), evidence, now))
+	}
+
+	for _, predicateType := range p.sortedPredicateTypes() {
+		if slices.Contains(required, predicateType) {
+			continue
+		}
+		rule := p.rule(predicateType)
+		if rule.MinCount > 0 {
+			result.RuleResults = append(result.RuleResults, evaluateCount(RuleMinCount, predicateType, rule, rule.MinCount, evidence, now))
+			continue
+		}
+		if ruleResult, applicable := evaluateConstraints(predicateType, rule, evidence, now); applicable {
+			result.RuleResults = append(result.RuleResults, ruleResult)
+		}
+	}
+
+	if p.failOnInvalidEvidence() {
+		result.RuleResults = append(result.RuleResults, evaluateAllVerified(evidence))
+	}
+	// Failed predicate assertions always fail the verification, regardless of the count rules.
+	if ruleResult, applicable := evaluateAssertions(evidence); applicable {
+		result.RuleResults = append(result.RuleResults, ruleResult)
+	}
+	// So does subject content that differs from the sha256 Artifactory reports for it.
+	if response.SubjectContentVerificationStatus == model.Failed {
+		result.RuleResults = append(result.RuleResults, model.PolicyRuleResult{
+			Rule:       RuleSubjectContent,
+			Status:     model.Failed,
+			Message:    "subject content does not match the reported sha256",
+			Violations: []string{fmt.Sprintf("%s: computed sha256 %s, reported %s", response.Subject.Path, response.Subject.ComputedSha256, response.Subject.Sha256)},
+		})
+	}
+
+	for _, ruleResult := range result.RuleResults {
+		if ruleResult.Status == model.Failed {
+			result.Status = model.Failed
+			break
+		}
+	}
+	response.PolicyVerification = result
+	response.OverallVerificationStatus = result.Status
+	return result
+}
+
+func (p *Policy) rule(predicateType string) *PredicateRule {
+	if rule, ok := p.Predicates[predicateType]; ok && rule != nil {
+		return rule
+	}
+	return &PredicateRule{}
+}
+
+func (p *Policy) sortedPredicateTypes() []string {
+	types := make([]string, 0, len(p.Predicates))
+	for predicateType := range p.Predicates {
+		types = append(types, predicateType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// evaluateCount requires at least requiredCount evidence of the predicate type that satisfy the rule.
+func evaluateCount(ruleName, predicateType string, rule *PredicateRule, requiredCount int, evidence []model.EvidenceVerification, now time.Time) model.PolicyRuleResult {
+	matched, violations := matchEvidence(predicateType, rule, evidence, now)
+	ruleResult := model.PolicyRuleResult{
+		Rule:          ruleName,
+		PredicateType: predicateType,
+		Status:        model.Success,
+		RequiredCount: requiredCount,
+		MatchedCount:  matched,
+		Violations:    violations,
+	}
+	if matched < requiredCount {
+		ruleResult.Status = model.Failed
+		ruleResult.Message = fmt.Sprintf("found %d compliant evidence of predicate type %s, at least %d required", matched, predicateType, requiredCount)
+	}
+	return ruleResult
+}
+
+// evaluateConstraints requires every present evidence of the predicate type to satisfy the rule.
+// It is not applicable when the subject has no evidence of that predicate type.
+func evaluateConstraints(predicateType string, rule *PredicateRule, evidence []model.EvidenceVerification, now time.Time) (model.PolicyRuleResult, bool) {
+	matched, violations := matchEvidence(predicateType, rule, evidence, now)
+	if matched == 0 && len(violations) == 0 {
+		return model.PolicyRuleResult{}, false
+	}
+	ruleResult := model.PolicyRuleResult{
+		Rule:          RulePredicateConstraints,
+		PredicateType: predicateType,
+		Status:        model.Success,
+		MatchedCount:  matched,
+		Violations:    violations,
+	}
+	if len(violations) > 0 {
+		ruleResult.Status = model.Failed
+		ruleResult.Message = fmt.Sprintf("%d evidence of predicate type %s do not satisfy the policy", len(violations), predicateType)
+	}
+	return ruleResult, true
+}
+
+func evaluateAllVerified(evidence []model.EvidenceVerification) model.PolicyRuleResult {
+	ruleResult := model.PolicyRuleResult{
+		Rule:   RuleEvidenceVerification,
+		Status: model.Success,
+	}
+	for i := range evidence {
+		if evidence[i].VerificationResult.HasFailure() {
+			ruleResult.Violations = append(ruleResult.Violations, violation(&evidence[i], verificationFailureReason(&evidence[i])))
+			continue
+		}
+		ruleResult.MatchedCount++
+	}
+	if len(ruleResult.Violations) > 0 {
+		ruleResult.Status = model.Failed
+		ruleResult.Message = fmt.Sprintf("%d evidence failed verification", len(ruleResult.Violations))
+	}
+	return ruleResult
+}
+
+func evaluateAssertions(evidence []model.EvidenceVerification) (model.PolicyRuleResult, bool) {
+	ruleResult := model.PolicyRuleResult{
+		Rule:   RuleEvidenceAssertions,
+		Status: model.Success,
+	}
+	applicable := false
+	for i := range evidence {
+		switch evidence[i].VerificationResult.AssertionsVerificationStatus {
+		case model.Success:
+			ruleResult.MatchedCount++
+		case model.Failed:
+			ruleResult.Violations = append(ruleResult.Violations, violation(&evidence[i], "predicate assertions failed"))
+		default:
+			continue
+		}
+		applicable = true
+	}
+	if len(ruleResult.Violations) > 0 {
+		ruleResult.Status = model.Failed
+		ruleResult.Message = fmt.Sprintf("%d evidence failed predicate assertions", len(ruleResult.Violations))
+	}
+	return ruleResult, applicable
+}
+
+func matchEvidence(predicateType string, rule *PredicateRule, evidence []model.EvidenceVerification, now time.Time) (int, []string) {
+	matched := 0
+	var violations []string
+	for i := range evidence {
+		if evidence[i].PredicateType != predicateType {
+			continue
+		}
+		if reason := rule.check(&evidence[i], now); reason != "" {
+			violations = append(violations, violation(&evidence[i], reason))
+			continue
+		}
+		matched++
+	}
+	return matched, violations
+}
+
+// check returns the reason the evidence does not satisfy the rule, or an empty string if it does.
+func (r *PredicateRule) check(evidence *model.EvidenceVerification, now time.Time) string {
+	result := &evidence.VerificationResult
+	if result.HasFailure() {
+		return verificationFailureReason(evidence)
+	}
+	if result.SignaturesVerificationStatus != model.Success && result.SigstoreBundleVerificationStatus != model.Success {
+		return "signature was not verified"
+	}
+	if r.hasTrustConstraints() && !r.isTrustedSigner(evidence) {
+		return "not signed by a trusted key or identity"
+	}
+	if r.maxAge > 0 {
+		createdAt, err := utils.ParseTimestamp(evidence.CreatedAt)
+		if err != nil {
+			return fmt.Sprintf("cannot determine evidence age from createdAt '%s'", evidence.CreatedAt)
+		}
+		if now.Sub(createdAt) > r.maxAge {
+			return fmt.Sprintf("evidence created at %s is older than the maximum age of %s", evidence.CreatedAt, r.MaxAge)
+		}
+	}
+	if r.RequireAttachments && len(evidence.AttachmentsVerification) == 0 {
+		return "evidence has no attachments"
+	}
+	if r.FourEyes != nil {
+		return r.checkFourEyes(evidence)
+	}
+	return ""
+}
+
+// checkFourEyes evaluates the four-eyes policy over the commits and reviews recorded in the predicate,
+// regardless of the policy the evidence was created with.
+func (r *PredicateRule) checkFourEyes(evidence *model.EvidenceVerification) string {
+	payload, err := evidence.StatementPayload()
+	if err != nil {
+		return err.Error()
+	}
+	var statement struct {
+		Predicate []model.GitLogEntry `json:"predicate"`
+	}
+	if err = json.Unmarshal(payload, &statement); err != nil {
+		return fmt.Sprintf("predicate is not a list of commits: %v", err)
+	}
+	if err = r.FourEyes.Evaluate(statement.Predicate).Err(); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+func (r *PredicateRule) isTrustedSigner(evidence *model.EvidenceVerification) bool {
+	result := &evidence.VerificationResult
+	if result.KeyFingerprint != "" {
+		for _, fingerprint := range r.TrustedKeys {
+			if strings.TrimPrefix(fingerprint, fingerprintPrefix) == result.KeyFingerprint {
+				return true
+			}
+		}
+	}
+	// The alias is reported by the server, so it only identifies the signer when the signature was verified
+	// with the key Artifactory stores under that alias, not with a local --public-keys key.
+	if evidence.SigningKeyAlias != "" && result.KeySource == model.ArtifactoryKeySource &&
+		result.SignaturesVerificationStatus == model.Success && slices.Contains(r.KeyAliases, evidence.SigningKeyAlias) {
+		return true
+	}
+	if result.SigstoreBundleVerificationStatus == model.Success && result.SigstoreBundleVerificationResult != nil {
+		signature := result.SigstoreBundleVerificationResult.Signature
+		if signature == nil || signature.Certificate == nil {
+			return false
+		}
+		for _, identity := range r.SigstoreIdentities {
+			if identity.matches(signature.Certificate.Issuer, signature.Certificate.SubjectAlternativeName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (i *SigstoreIdentity) matches(issuer, subject string) bool {
+	if i.Issuer != "" && i.Issuer != issuer {
+		return false
+	}
+	if i.Subject != "" && i.Subject != subject {
+		return false
+	}
+	if i.subjectRegex != nil && !i.subjectRegex.MatchString(subject) {
+		return false
+	}
+	return true
+}
+
+func verificationFailureReason(evidence *model.EvidenceVerification) string {
+	if evidence.VerificationResult.FailureReason != "" {
+		return "verification failed: " + evidence.VerificationResult.FailureReason
+	}
+	return "verification failed"
+}
+
+func violation(evidence *model.EvidenceVerification, reason string) string {
+	return fmt.Sprintf("%s: %s", evidence.DownloadPath, reason)
+}
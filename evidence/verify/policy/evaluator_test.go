@@ -0,0 +1,320 @@
+package policy
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const provenance = "https://slsa.dev/provenance/v1"
+
+var evaluationTime = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// dsseEvidence builds evidence verified with a local key, or with the Artifactory key when an alias is given.
+func dsseEvidence(path, predicateType, fingerprint, alias, createdAt string) model.EvidenceVerification {
+	keySource := "User Provided Key"
+	if alias != "" {
+		keySource = model.ArtifactoryKeySource
+	}
+	return model.EvidenceVerification{
+		MediaType:       model.SimpleDSSE,
+		DownloadPath:    path,
+		PredicateType:   predicateType,
+		CreatedAt:       createdAt,
+		SigningKeyAlias: alias,
+		VerificationResult: model.EvidenceVerificationResult{
+			Sha256VerificationStatus:     model.Success,
+			SignaturesVerificationStatus: model.Success,
+			KeySource:                    keySource,
+			KeyFingerprint:               fingerprint,
+		},
+	}
+}
+
+func aliasVerifiedLocally(path, predicateType, alias string) model.EvidenceVerification {
+	evidence := dsseEvidence(path, predicateType, "other", alias, "")
+	evidence.VerificationResult.KeySource = "User Provided Key"
+	return evidence
+}
+
+func sigstoreEvidence(path, predicateType, issuer, san string) model.EvidenceVerification {
+	return model.EvidenceVerification{
+		MediaType:     model.SigstoreBundle,
+		DownloadPath:  path,
+		PredicateType: predicateType,
+		CreatedAt:     "2025-05-30T00:00:00.000Z",
+		VerificationResult: model.EvidenceVerificationResult{
+			Sha256VerificationStatus:         model.Success,
+			SigstoreBundleVerificationStatus: model.Success,
+			SigstoreBundleVerificationResult: &verify.VerificationResult{
+				Signature: &verify.SignatureVerificationResult{
+					Certificate: &certificate.Summary{
+						SubjectAlternativeName: san,
+						Extensions:             certificate.Extensions{Issuer: issuer},
+					},
+				},
+			},
+		},
+	}
+}
+
+func response(evidence ...model.EvidenceVerification) *model.VerificationResponse {
+	return &model.VerificationResponse{
+		OverallVerificationStatus: model.Success,
+		EvidenceVerifications:     &evidence,
+	}
+}
+
+func mustParse(t *testing.T, content string) *Policy {
+	p, err := Parse([]byte(content))
+	require.NoError(t, err)
+	return p
+}
+
+func TestEvaluate_RequiredPredicateType(t *testing.T) {
+	p := mustParse(t, `
+subjects:
+  build:
+    requiredPredicateTypes: [https://slsa.dev/provenance/v1]
+`)
+	resp := response(dsseEvidence("e1", "other", "fp", "", "2025-05-30T00:00:00.000Z"))
+	result := p.Evaluate(resp, SubjectTypeBuild, evaluationTime)
+
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	assert.Same(t, result, resp.PolicyVerification)
+	require.Len(t, result.RuleResults, 2)
+	assert.Equal(t, RuleRequiredPredicateType, result.RuleResults[0].Rule)
+	assert.Equal(t, 1, result.RuleResults[0].RequiredCount)
+	assert.Equal(t, 0, result.RuleResults[0].MatchedCount)
+	assert.Equal(t, RuleEvidenceVerification, result.RuleResults[1].Rule)
+	assert.Equal(t, model.Success, result.RuleResults[1].Status)
+
+	// Not required for artifacts
+	resp = response(dsseEvidence("e1", "other", "fp", "", "2025-05-30T00:00:00.000Z"))
+	result = p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Success, resp.OverallVerificationStatus)
+	require.Len(t, result.RuleResults, 1)
+	assert.Equal(t, RuleEvidenceVerification, result.RuleResults[0].Rule)
+}
+
+func TestEvaluate_TrustedSigners(t *testing.T) {
+	p := mustParse(t, `
+subjects:
+  artifact:
+    requiredPredicateTypes: [https://slsa.dev/provenance/v1]
+predicates:
+  https://slsa.dev/provenance/v1:
+    trustedKeys: ["SHA256:trusted-fp"]
+    keyAliases: [ci-signer]
+    sigstoreIdentities:
+      - issuer: https://token.actions.githubusercontent.com
+        subjectRegex: https://github.com/jfrog/.*
+`)
+	tests := []struct {
+		name     string
+		evidence model.EvidenceVerification
+		expected model.VerificationStatus
+	}{
+		{name: "trusted fingerprint", evidence: dsseEvidence("e", provenance, "trusted-fp", "", ""), expected: model.Success},
+		{name: "trusted alias", evidence: dsseEvidence("e", provenance, "other", "ci-signer", ""), expected: model.Success},
+		{name: "untrusted key", evidence: dsseEvidence("e", provenance, "other", "dev", ""), expected: model.Failed},
+		{name: "trusted alias verified with a local key", evidence: aliasVerifiedLocally("e", provenance, "ci-signer"), expected: model.Failed},
+		{name: "trusted identity", evidence: sigstoreEvidence("e", provenance, "https://token.actions.githubusercontent.com", "https://github.com/jfrog/repo/.github/workflows/ci.yml@refs/heads/main"), expected: model.Success},
+		{name: "wrong issuer", evidence: sigstoreEvidence("e", provenance, "https://accounts.google.com", "https://github.com/jfrog/repo"), expected: model.Failed},
+		{name: "wrong subject", evidence: sigstoreEvidence("e", provenance, "https://token.actions.githubusercontent.com", "https://github.com/evil/repo"), expected: model.Failed},
+		{name: "subject only containing the pattern", evidence: sigstoreEvidence("e", provenance, "https://token.actions.githubusercontent.com", "https://evil.example/https://github.com/jfrog/repo"), expected: model.Failed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := response(tt.evidence)
+			result := p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+			assert.Equal(t, tt.expected, resp.OverallVerificationStatus)
+			if tt.expected == model.Failed {
+				assert.Contains(t, result.RuleResults[0].Violations[0], "not signed by a trusted key or identity")
+			}
+		})
+	}
+}
+
+func TestEvaluate_MinCountMaxAgeAndAttachments(t *testing.T) {
+	p := mustParse(t, `
+predicates:
+  https://slsa.dev/provenance/v1:
+    minCount: 2
+    maxAge: 7d
+    requireAttachments: true
+`)
+	fresh := dsseEvidence("fresh", provenance, "fp", "", "2025-05-30T00:00:00.000Z")
+	fresh.AttachmentsVerification = []model.AttachmentVerification{{Name: "a", VerificationStatus: model.Success}}
+	fresh.VerificationResult.AttachmentsVerificationStatus = model.Success
+	old := dsseEvidence("old", provenance, "fp", "", "2025-01-01T00:00:00.000Z")
+	old.AttachmentsVerification = fresh.AttachmentsVerification
+	noAttachments := dsseEvidence("bare", provenance, "fp", "", "2025-05-31T00:00:00.000Z")
+
+	resp := response(fresh, old, noAttachments)
+	result := p.Evaluate(resp, SubjectTypePackage, evaluationTime)
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	require.Len(t, result.RuleResults, 2)
+	rule := result.RuleResults[0]
+	assert.Equal(t, RuleMinCount, rule.Rule)
+	assert.Equal(t, 1, rule.MatchedCount)
+	assert.Equal(t, 2, rule.RequiredCount)
+	require.Len(t, rule.Violations, 2)
+	assert.Contains(t, rule.Violations[0], "old: evidence created at 2025-01-01T00:00:00.000Z is older than the maximum age of 7d")
+	assert.Contains(t, rule.Violations[1], "bare: evidence has no attachments")
+
+	// Evaluating at an earlier point in time makes the old evidence compliant
+	resp = response(fresh, old)
+	p.Evaluate(resp, SubjectTypePackage, time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, model.Success, resp.OverallVerificationStatus)
+	assert.Equal(t, 2, resp.PolicyVerification.RuleResults[0].MatchedCount)
+}
+
+func TestEvaluate_PredicateConstraintsWithoutCount(t *testing.T) {
+	p := mustParse(t, `
+predicates:
+  https://slsa.dev/provenance/v1:
+    keyAliases: [ci-signer]
+`)
+	// Not applicable when no evidence of the predicate type exists
+	resp := response(dsseEvidence("e", "other", "fp", "", ""))
+	result := p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	require.Len(t, result.RuleResults, 1)
+	assert.Equal(t, RuleEvidenceVerification, result.RuleResults[0].Rule)
+	assert.Equal(t, model.Success, resp.OverallVerificationStatus)
+
+	resp = response(dsseEvidence("good", provenance, "fp", "ci-signer", ""), dsseEvidence("bad", provenance, "fp", "dev", ""))
+	result = p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	require.Len(t, result.RuleResults, 2)
+	assert.Equal(t, RulePredicateConstraints, result.RuleResults[0].Rule)
+	assert.Equal(t, model.Failed, result.RuleResults[0].Status)
+	assert.Equal(t, 1, result.RuleResults[0].MatchedCount)
+}
+
+func TestEvaluate_VerificationFailures(t *testing.T) {
+	p := mustParse(t, `
+subjects:
+  "*":
+    requiredPredicateTypes: [https://slsa.dev/provenance/v1]
+`)
+	valid := dsseEvidence("valid", provenance, "fp", "", "")
+	invalid := dsseEvidence("invalid", "other", "fp", "", "")
+	invalid.VerificationResult.SignaturesVerificationStatus = model.Failed
+	invalid.VerificationResult.FailureReason = "bad signature"
+
+	// Invalid evidence fails the verification by default, even when no rule governs its predicate type
+	resp := response(valid, invalid)
+	result := p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	last := result.RuleResults[len(result.RuleResults)-1]
+	assert.Equal(t, RuleEvidenceVerification, last.Rule)
+	assert.Equal(t, []string{"invalid: verification failed: bad signature"}, last.Violations)
+
+	// Unless the policy opts out
+	p = mustParse(t, `
+subjects:
+  "*":
+    requiredPredicateTypes: [https://slsa.dev/provenance/v1]
+failOnInvalidEvidence: false
+`)
+	resp = response(valid, invalid)
+	resp.OverallVerificationStatus = model.Failed
+	p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Success, resp.OverallVerificationStatus)
+
+	// Evidence whose signature was not checked at all does not count
+	unchecked := dsseEvidence("unchecked", provenance, "", "", "")
+	unchecked.VerificationResult.SignaturesVerificationStatus = ""
+	resp = response(unchecked)
+	result = p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	assert.Contains(t, result.RuleResults[0].Violations[0], "signature was not verified")
+}
+
+func TestEvaluate_UnsignedUngovernedEvidenceFails(t *testing.T) {
+	p := mustParse(t, `
+predicates:
+  https://slsa.dev/provenance/v1:
+    minCount: 1
+`)
+	unsigned := dsseEvidence("unsigned", "https://example.com/ungoverned/v1", "", "", "")
+	unsigned.VerificationResult.SignaturesVerificationStatus = model.Failed
+
+	resp := response(dsseEvidence("valid", provenance, "fp", "", ""), unsigned)
+	result := p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	assert.Equal(t, model.Failed, result.Status)
+	last := result.RuleResults[len(result.RuleResults)-1]
+	assert.Equal(t, RuleEvidenceVerification, last.Rule)
+	assert.Equal(t, []string{"unsigned: verification failed"}, last.Violations)
+}
+
+func TestEvaluate_FailedAssertionsAlwaysFail(t *testing.T) {
+	p := mustParse(t, "")
+	passing := dsseEvidence("passing", provenance, "fp", "", "")
+	passing.VerificationResult.AssertionsVerificationStatus = model.Success
+	failing := dsseEvidence("failing", "sonar", "fp", "", "")
+	failing.VerificationResult.AssertionsVerificationStatus = model.Failed
+
+	resp := response(passing, failing)
+	result := p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	require.Len(t, result.RuleResults, 2)
+	assert.Equal(t, RuleEvidenceVerification, result.RuleResults[0].Rule)
+	assert.Equal(t, []string{"failing: verification failed"}, result.RuleResults[0].Violations)
+	assert.Equal(t, RuleEvidenceAssertions, result.RuleResults[1].Rule)
+	assert.Equal(t, 1, result.RuleResults[1].MatchedCount)
+	assert.Equal(t, []string{"failing: predicate assertions failed"}, result.RuleResults[1].Violations)
+
+	resp = response(passing)
+	p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Success, resp.OverallVerificationStatus)
+}
+
+func TestEvaluate_FourEyes(t *testing.T) {
+	const committer = "https://jfrog.com/evidence/gh-commiter/v1"
+	p := mustParse(t, "predicates:\n  "+committer+":\n    fourEyes:\n      requiredApprovals: 1\n      approvers: [alice]\n")
+	withStatement := func(path, statement string) model.EvidenceVerification {
+		evidence := dsseEvidence(path, committer, "fp", "", "")
+		evidence.DsseEnvelope = &dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(statement))}
+		return evidence
+	}
+	approved := withStatement("approved", `{"predicate":[{"commit":"c1","pr_number":7,"pr_author":"dev","pr_reviewer":[{"Reviewer":"alice","State":"APPROVED"}]}]}`)
+	// The recorded four_eyes outcome is not trusted: the reviews are evaluated again.
+	selfApproved := withStatement("self-approved", `{"predicate":[{"commit":"c2","pr_number":8,"pr_author":"alice","pr_reviewer":[{"Reviewer":"alice","State":"APPROVED"}],"four_eyes":{"satisfied":true}}]}`)
+	notCommits := withStatement("not-commits", `{"predicate":{"commit":"c3"}}`)
+
+	resp := response(approved)
+	p.Evaluate(resp, SubjectTypeBuild, evaluationTime)
+	assert.Equal(t, model.Success, resp.OverallVerificationStatus)
+
+	resp = response(approved, selfApproved, notCommits)
+	result := p.Evaluate(resp, SubjectTypeBuild, evaluationTime)
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	require.Len(t, result.RuleResults, 2)
+	assert.Equal(t, RulePredicateConstraints, result.RuleResults[0].Rule)
+	assert.Equal(t, 1, result.RuleResults[0].MatchedCount)
+	require.Len(t, result.RuleResults[0].Violations, 2)
+	assert.Equal(t, "self-approved: four-eyes policy violated by 1 of 1 commits: c2 (0 of 1 required approvals)", result.RuleResults[0].Violations[0])
+	assert.Contains(t, result.RuleResults[0].Violations[1], "not-commits: predicate is not a list of commits")
+}
+
+func TestEvaluate_SubjectContentMismatchAlwaysFails(t *testing.T) {
+	p := mustParse(t, "")
+	resp := response(dsseEvidence("e", provenance, "fp", "", ""))
+	resp.Subject = model.Subject{Path: "repo/app.tgz", Sha256: "reported", ComputedSha256: "computed"}
+	resp.SubjectContentVerificationStatus = model.Failed
+
+	result := p.Evaluate(resp, SubjectTypeArtifact, evaluationTime)
+	assert.Equal(t, model.Failed, resp.OverallVerificationStatus)
+	require.Len(t, result.RuleResults, 2)
+	assert.Equal(t, RuleSubjectContent, result.RuleResults[1].Rule)
+	assert.Equal(t, []string{"repo/app.tgz: computed sha256 computed, reported reported"}, result.RuleResults[1].Violations)
+}
@@ -0,0 +1,192 @@
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/foureyes"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Subject types a policy can declare required predicate types for.
+const (
+	SubjectTypeArtifact      = "artifact"
+	SubjectTypeBuild         = "build"
+	SubjectTypePackage       = "package"
+	SubjectTypeReleaseBundle = "release-bundle"
+	// SubjectTypeAny applies the requirements regardless of the verified subject type.
+	SubjectTypeAny = "*"
+)
+
+// Policy describes which evidence a subject must carry and which evidence is trusted.
+//
+// Example:
+//
+//	subjects:
+//	  build:
+//	    requiredPredicateTypes: [https://slsa.dev/provenance/v1]
+//	predicates:
+//	  https://slsa.dev/provenance/v1:
+//	    keyAliases: [ci-signer]
+//	    minCount: 1
+//	    maxAge: 30d
+//	    requireAttachments: false
+//	  https://jfrog.com/evidence/gh-commiter/v1:
+//	    fourEyes:
+//	      requiredApprovals: 2
+//	      approvers: [alice, bob]
+type Policy struct {
+	Subjects   map[string]SubjectRequirements `yaml:"subjects"`
+	Predicates map[string]*PredicateRule      `yaml:"predicates"`
+	// FailOnInvalidEvidence fails the verification when any evidence fails verification, as verify does without
+	// a policy. It defaults to true; set it to false to only fail on the policy rules.
+	FailOnInvalidEvidence *bool `yaml:"failOnInvalidEvidence"`
+
+	path string
+}
+
+type SubjectRequirements struct {
+	RequiredPredicateTypes []string `yaml:"requiredPredicateTypes"`
+}
+
+// PredicateRule constrains the evidence of a single predicate type.
+type PredicateRule struct {
+	// TrustedKeys lists base64 SHA-256 public key fingerprints, as reported in keyFingerprint.
+	TrustedKeys []string `yaml:"trustedKeys"`
+	// KeyAliases lists Artifactory signing key aliases, trusted when the signature was verified with that key.
+	KeyAliases         []string           `yaml:"keyAliases"`
+	SigstoreIdentities []SigstoreIdentity `yaml:"sigstoreIdentities"`
+	MinCount           int                `yaml:"minCount"`
+	MaxAge             string             `yaml:"maxAge"`
+	RequireAttachments bool               `yaml:"requireAttachments"`
+	// FourEyes re-evaluates the reviews recorded in committer/reviewer evidence.
+	FourEyes *foureyes.Policy `yaml:"fourEyes"`
+
+	maxAge time.Duration
+}
+
+// SigstoreIdentity matches the Fulcio certificate of a Sigstore bundle.
+// Subject is compared exactly; SubjectRegex must match the whole certificate subject alternative name.
+type SigstoreIdentity struct {
+	Issuer       string `yaml:"issuer"`
+	Subject      string `yaml:"subject"`
+	SubjectRegex string `yaml:"subjectRegex"`
+
+	subjectRegex *regexp.Regexp
+}
+
+// Load reads and validates a policy file.
+func Load(path string) (*Policy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file %s: %w", path, err)
+	}
+	p, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+	p.path = path
+	return p, nil
+}
+
+// Parse decodes a YAML (or JSON) policy document and validates it. Unknown fields are rejected.
+func Parse(content []byte) (*Policy, error) {
+	p := &Policy{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(p); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Path returns the file the policy was loaded from, if any.
+func (p *Policy) Path() string {
+	return p.path
+}
+
+// UsesKeyAliases reports whether any rule trusts signers by Artifactory key alias.
+func (p *Policy) UsesKeyAliases() bool {
+	for _, rule := range p.Predicates {
+		if rule != nil && len(rule.KeyAliases) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) failOnInvalidEvidence() bool {
+	return p.FailOnInvalidEvidence == nil || *p.FailOnInvalidEvidence
+}
+
+func (p *Policy) validate() error {
+	for subjectType := range p.Subjects {
+		switch subjectType {
+		case SubjectTypeArtifact, SubjectTypeBuild, SubjectTypePackage, SubjectTypeReleaseBundle, SubjectTypeAny:
+		default:
+			return fmt.Errorf("unsupported subject type '%s'", subjectType)
+		}
+	}
+	for predicateType, rule := range p.Predicates {
+		if rule == nil {
+			p.Predicates[predicateType] = &PredicateRule{}
+			continue
+		}
+		if rule.MinCount < 0 {
+			return fmt.Errorf("predicate '%s': minCount cannot be negative", predicateType)
+		}
+		if rule.MaxAge != "" {
+			maxAge, err := utils.ParseDuration(rule.MaxAge)
+			if err != nil {
+				return fmt.Errorf("predicate '%s': %w", predicateType, err)
+			}
+			rule.maxAge = maxAge
+		}
+		if rule.FourEyes != nil {
+			if err := rule.FourEyes.Validate(); err != nil {
+				return fmt.Errorf("predicate '%s': %w", predicateType, err)
+			}
+		}
+		for i := range rule.SigstoreIdentities {
+			identity := &rule.SigstoreIdentities[i]
+			if identity.Issuer == "" && identity.Subject == "" && identity.SubjectRegex == "" {
+				return fmt.Errorf("predicate '%s': sigstore identity must define issuer, subject or subjectRegex", predicateType)
+			}
+			if identity.SubjectRegex != "" {
+				compiled, err := regexp.Compile("^(?:" + identity.SubjectRegex + ")$")
+				if err != nil {
+					return fmt.Errorf("predicate '%s': invalid subjectRegex: %w", predicateType, err)
+				}
+				identity.subjectRegex = compiled
+			}
+		}
+	}
+	return nil
+}
+
+func (p *Policy) requiredPredicateTypes(subjectType string) []string {
+	var required []string
+	seen := map[string]bool{}
+	for _, key := range []string{SubjectTypeAny, subjectType} {
+		for _, predicateType := range p.Subjects[key].RequiredPredicateTypes {
+			if !seen[predicateType] {
+				seen[predicateType] = true
+				required = append(required, predicateType)
+			}
+		}
+	}
+	return required
+}
+
+func (r *PredicateRule) hasTrustConstraints() bool {
+	return len(r.TrustedKeys) > 0 || len(r.KeyAliases) > 0 || len(r.SigstoreIdentities) > 0
+}
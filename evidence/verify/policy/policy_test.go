@@ -0,0 +1,89 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const samplePolicy = `
+subjects:
+  build:
+    requiredPredicateTypes:
+      - https://slsa.dev/provenance/v1
+  "*":
+    requiredPredicateTypes:
+      - https://jfrog.com/evidence/signature/v1
+predicates:
+  https://slsa.dev/provenance/v1:
+    keyAliases: [ci-signer]
+    trustedKeys: ["SHA256:abc"]
+    minCount: 2
+    maxAge: 30d
+    requireAttachments: true
+    sigstoreIdentities:
+      - issuer: https://token.actions.githubusercontent.com
+        subjectRegex: ^https://github.com/jfrog/.*$
+  https://cyclonedx.org/bom:
+failOnInvalidEvidence: true
+`
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yml")
+	require.NoError(t, os.WriteFile(path, []byte(samplePolicy), 0600))
+
+	p, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, path, p.Path())
+	assert.True(t, p.failOnInvalidEvidence())
+	assert.True(t, p.UsesKeyAliases())
+
+	rule := p.Predicates["https://slsa.dev/provenance/v1"]
+	require.NotNil(t, rule)
+	assert.Equal(t, 2, rule.MinCount)
+	assert.Equal(t, 30*24*time.Hour, rule.maxAge)
+	assert.True(t, rule.RequireAttachments)
+	require.Len(t, rule.SigstoreIdentities, 1)
+	assert.NotNil(t, rule.SigstoreIdentities[0].subjectRegex)
+
+	assert.NotNil(t, p.Predicates["https://cyclonedx.org/bom"])
+	assert.Equal(t, []string{"https://jfrog.com/evidence/signature/v1", "https://slsa.dev/provenance/v1"}, p.requiredPredicateTypes(SubjectTypeBuild))
+	assert.Equal(t, []string{"https://jfrog.com/evidence/signature/v1"}, p.requiredPredicateTypes(SubjectTypeArtifact))
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yml"))
+	assert.ErrorContains(t, err, "failed to read policy file")
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		errorContains string
+	}{
+		{name: "unknown field", content: "predicate:\n  x: {}\n", errorContains: "field predicate not found"},
+		{name: "unknown subject type", content: "subjects:\n  docker:\n    requiredPredicateTypes: [x]\n", errorContains: "unsupported subject type 'docker'"},
+		{name: "negative min count", content: "predicates:\n  x:\n    minCount: -1\n", errorContains: "minCount cannot be negative"},
+		{name: "invalid max age", content: "predicates:\n  x:\n    maxAge: soon\n", errorContains: "invalid duration"},
+		{name: "empty identity", content: "predicates:\n  x:\n    sigstoreIdentities:\n      - {}\n", errorContains: "sigstore identity must define"},
+		{name: "invalid regex", content: "predicates:\n  x:\n    sigstoreIdentities:\n      - subjectRegex: '('\n", errorContains: "invalid subjectRegex"},
+		{name: "no four-eyes approvals", content: "predicates:\n  x:\n    fourEyes:\n      approvers: [alice]\n", errorContains: "four-eyes policy requires at least 1 approval"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.content))
+			assert.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
+
+func TestParse_Empty(t *testing.T) {
+	p, err := Parse([]byte(""))
+	assert.NoError(t, err)
+	assert.False(t, p.UsesKeyAliases())
+}
@@ -0,0 +1,151 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/htmlreport"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+)
+
+var HtmlReportPrinter = &htmlReportPrinter{}
+
+type htmlReportPrinter struct {
+}
+
+// decodedStatement holds the statement fields shown in the HTML report.
+type decodedStatement struct {
+	Predicate json.RawMessage `json:"predicate"`
+	Markdown  string          `json:"markdown"`
+}
+
+func (p *htmlReportPrinter) Print(result *model.VerificationResponse) error {
+	err := verifyNotEmptyResponse(result)
+	if err != nil {
+		return err
+	}
+	return htmlreport.Render(os.Stdout, toHtmlDocument(result))
+}
+
+func toHtmlDocument(result *model.VerificationResponse) htmlreport.Document {
+	doc := htmlreport.Document{
+		Title:  "Evidence Verification Report",
+		Status: string(result.OverallVerificationStatus),
+		Subject: []htmlreport.Field{
+			{Name: "Subject path", Value: result.Subject.Path},
+			{Name: "Subject sha256", Value: result.Subject.Sha256},
+		},
+	}
+	if result.Subject.ComputedSha256 != "" {
+		doc.Subject = append(doc.Subject,
+			htmlreport.Field{Name: "Computed sha256", Value: result.Subject.ComputedSha256},
+			htmlreport.Field{Name: "Content verification", Value: string(result.SubjectContentVerificationStatus)})
+	}
+	if result.AsOf != "" {
+		doc.Subject = append(doc.Subject, htmlreport.Field{Name: "Evaluated as of", Value: result.AsOf})
+	}
+	section := htmlreport.Section{Title: "Evidence"}
+	if result.EvidenceVerifications != nil {
+		for i := range *result.EvidenceVerifications {
+			section.Evidence = append(section.Evidence, toHtmlEvidence(&(*result.EvidenceVerifications)[i]))
+		}
+	}
+	doc.Sections = append(doc.Sections, section)
+	if result.PolicyVerification != nil {
+		doc.Sections = append(doc.Sections, toHtmlPolicySection(result.PolicyVerification))
+	}
+	return doc
+}
+
+func toHtmlEvidence(verification *model.EvidenceVerification) htmlreport.Evidence {
+	status := model.Failed
+	if IsVerificationSucceed(*verification) {
+		status = model.Success
+	}
+	evidence := htmlreport.Evidence{
+		Title:  verification.PredicateType,
+		Status: string(status),
+		Fields: htmlreport.NonEmpty(
+			htmlreport.Field{Name: "Predicate type", Value: verification.PredicateType},
+			htmlreport.Field{Name: "Media type", Value: string(verification.MediaType)},
+			htmlreport.Field{Name: "Download path", Value: verification.DownloadPath},
+			htmlreport.Field{Name: "Evidence subject sha256", Value: verification.SubjectChecksum},
+			htmlreport.Field{Name: "Created by", Value: verification.CreatedBy},
+			htmlreport.Field{Name: "Created at", Value: verification.CreatedAt},
+			htmlreport.Field{Name: "Key source", Value: verification.VerificationResult.KeySource},
+			htmlreport.Field{Name: "Key fingerprint", Value: verification.VerificationResult.KeyFingerprint},
+			htmlreport.Field{Name: "Key ID", Value: verification.VerificationResult.KeyId},
+			htmlreport.Field{Name: "Signing key alias", Value: verification.SigningKeyAlias},
+			htmlreport.Field{Name: "Signed at (timestamped)", Value: verification.VerificationResult.SignedAt},
+			htmlreport.Field{Name: "Expires at", Value: verification.VerificationResult.ExpiresAt},
+			htmlreport.Field{Name: "Failure reason", Value: verification.VerificationResult.FailureReason},
+		),
+	}
+	for _, check := range evidenceChecks(verification) {
+		evidence.Checks = append(evidence.Checks, htmlreport.Result{Name: check.name, Status: string(check.status)})
+	}
+	for _, assertion := range verification.VerificationResult.AssertionResults {
+		result := htmlreport.Result{Name: "assertion: " + assertion.Expression, Status: string(assertion.Status)}
+		if assertion.Error != "" {
+			result.Details = []string{assertion.Error}
+		}
+		evidence.Checks = append(evidence.Checks, result)
+	}
+	for _, attachment := range verification.AttachmentsVerification {
+		sha256 := attachment.ActualSha256
+		if sha256 == "" {
+			sha256 = attachment.ExpectedSha256
+		}
+		evidence.Attachments = append(evidence.Attachments, htmlreport.Attachment{
+			Name:          attachment.Name,
+			Sha256:        sha256,
+			DownloadPath:  attachment.DownloadPath,
+			Status:        string(attachment.VerificationStatus),
+			FailureReason: attachment.FailureReason,
+		})
+	}
+	if statement, ok := decodeStatement(verification); ok {
+		if len(statement.Predicate) > 0 {
+			evidence.Predicate = htmlreport.ParseJSON(statement.Predicate)
+		}
+		evidence.Markdown = statement.Markdown
+	}
+	return evidence
+}
+
+func toHtmlPolicySection(policy *model.PolicyVerification) htmlreport.Section {
+	section := htmlreport.Section{
+		Title:  "Policy",
+		Fields: []htmlreport.Field{{Name: "Policy", Value: policy.PolicyPath}, {Name: "Status", Value: string(policy.Status)}},
+	}
+	for _, rule := range policy.RuleResults {
+		name := rule.Rule
+		if rule.PredicateType != "" {
+			name = fmt.Sprintf("%s (%s)", rule.Rule, rule.PredicateType)
+		}
+		var details []string
+		if rule.Message != "" {
+			details = append(details, rule.Message)
+		}
+		section.Results = append(section.Results, htmlreport.Result{
+			Name:    name,
+			Status:  string(rule.Status),
+			Details: append(details, rule.Violations...),
+		})
+	}
+	return section
+}
+
+// decodeStatement extracts the in-toto statement from the DSSE envelope or Sigstore bundle, if available.
+func decodeStatement(verification *model.EvidenceVerification) (*decodedStatement, bool) {
+	payload, err := verification.StatementPayload()
+	if err != nil {
+		return nil, false
+	}
+	statement := &decodedStatement{}
+	if err = json.Unmarshal(payload, statement); err != nil {
+		return nil, false
+	}
+	return statement, true
+}
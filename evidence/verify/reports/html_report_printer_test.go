@@ -0,0 +1,59 @@
+package reports
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHtml_Print(t *testing.T) {
+	statement := `{"_type":"https://in-toto.io/Statement/v1","predicateType":"https://jfrog.com/evidence/sonar/v1","predicate":{"qualityGate":"OK"},"markdown":"## Sonar\n\nQuality gate **passed**"}`
+	resp := &model.VerificationResponse{
+		Subject:                   model.Subject{Path: "generic-local/app.tgz", Sha256: "abc"},
+		OverallVerificationStatus: model.Failed,
+		EvidenceVerifications: &[]model.EvidenceVerification{{
+			MediaType:     model.SimpleDSSE,
+			DownloadPath:  "generic-local/.evidence/sonar.json",
+			PredicateType: "https://jfrog.com/evidence/sonar/v1",
+			CreatedBy:     "ci-user",
+			CreatedAt:     "2025-01-01T00:00:00.000Z",
+			DsseEnvelope:  &dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(statement))},
+			VerificationResult: model.EvidenceVerificationResult{
+				Sha256VerificationStatus:      model.Success,
+				SignaturesVerificationStatus:  model.Success,
+				AttachmentsVerificationStatus: model.Failed,
+				KeySource:                     "User Provided Key",
+				KeyFingerprint:                "fingerprint=",
+			},
+			AttachmentsVerification: []model.AttachmentVerification{{Name: "report.pdf", ExpectedSha256: "123", VerificationStatus: model.Failed, FailureReason: "checksum mismatch"}},
+		}},
+		PolicyVerification: &model.PolicyVerification{
+			PolicyPath:  "policy.yml",
+			Status:      model.Failed,
+			RuleResults: []model.PolicyRuleResult{{Rule: "min-count", PredicateType: "pred", Status: model.Failed, Message: "found 0"}},
+		},
+	}
+
+	out := captureOutput(func() {
+		assert.NoError(t, HtmlReportPrinter.Print(resp))
+	})
+	assert.Contains(t, out, "<title>Evidence Verification Report</title>")
+	assert.Contains(t, out, "generic-local/app.tgz")
+	assert.Contains(t, out, "ci-user")
+	assert.Contains(t, out, "2025-01-01T00:00:00.000Z")
+	assert.Contains(t, out, "User Provided Key")
+	assert.Contains(t, out, "fingerprint=")
+	assert.Contains(t, out, "report.pdf")
+	assert.Contains(t, out, "checksum mismatch")
+	assert.Contains(t, out, `<span class="json-key">qualityGate</span>`)
+	assert.Contains(t, out, "<h2>Sonar</h2>")
+	assert.Contains(t, out, "<strong>passed</strong>")
+	assert.Contains(t, out, "min-count (pred)")
+}
+
+func TestHtml_Print_NilResponse(t *testing.T) {
+	assert.ErrorContains(t, HtmlReportPrinter.Print(nil), "verification response is empty")
+}
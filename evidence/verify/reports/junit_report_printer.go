@@ -0,0 +1,219 @@
+package reports
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+)
+
+var JunitReportPrinter = &junitReportPrinter{}
+
+type junitReportPrinter struct {
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	TestCases  []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Assertions int             `xml:"assertions,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitFailure   `xml:"failure,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Details string `xml:",chardata"`
+}
+
+// junitCheck is a single verification check of an evidence, reported as a JUnit assertion.
+type junitCheck struct {
+	name   string
+	status model.VerificationStatus
+}
+
+// Print writes the verification result as a JUnit XML document with a single test suite for the subject,
+// mapping every evidence and policy rule to a test case.
+func (p *junitReportPrinter) Print(result *model.VerificationResponse) error {
+	if err := verifyNotEmptyResponse(result); err != nil {
+		return err
+	}
+	suite := toJunitTestSuite(result)
+	suites := junitTestSuites{
+		Name:     "evidence-verification",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Suites:   []junitTestSuite{suite},
+	}
+	out, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Print(xml.Header)
+	fmt.Println(string(out))
+	return nil
+}
+
+func toJunitTestSuite(result *model.VerificationResponse) junitTestSuite {
+	suite := junitTestSuite{
+		Name: result.Subject.Path,
+		Properties: []junitProperty{
+			{Name: "subject.sha256", Value: result.Subject.Sha256},
+			{Name: "overallVerificationStatus", Value: string(result.OverallVerificationStatus)},
+		},
+	}
+	if result.Subject.ComputedSha256 != "" {
+		suite.Properties = append(suite.Properties,
+			junitProperty{Name: "subject.computedSha256", Value: result.Subject.ComputedSha256},
+			junitProperty{Name: "subjectContentVerificationStatus", Value: string(result.SubjectContentVerificationStatus)})
+	}
+	if result.AsOf != "" {
+		suite.Properties = append(suite.Properties, junitProperty{Name: "asOf", Value: result.AsOf})
+	}
+	if result.EvidenceVerifications != nil {
+		for i := range *result.EvidenceVerifications {
+			suite.TestCases = append(suite.TestCases, toJunitTestCase(result.Subject.Path, &(*result.EvidenceVerifications)[i]))
+		}
+	}
+	if result.PolicyVerification != nil {
+		for _, rule := range result.PolicyVerification.RuleResults {
+			suite.TestCases = append(suite.TestCases, policyRuleToJunitTestCase(result.Subject.Path, rule))
+		}
+	}
+	suite.Tests = len(suite.TestCases)
+	for _, testCase := range suite.TestCases {
+		if testCase.Failure != nil {
+			suite.Failures++
+		}
+	}
+	return suite
+}
+
+func toJunitTestCase(subjectPath string, verification *model.EvidenceVerification) junitTestCase {
+	checks := evidenceChecks(verification)
+	testCase := junitTestCase{
+		Name:       fmt.Sprintf("%s (%s)", verification.PredicateType, verification.DownloadPath),
+		ClassName:  subjectPath,
+		Assertions: len(checks),
+		Properties: []junitProperty{
+			{Name: "mediaType", Value: string(verification.MediaType)},
+			{Name: "createdBy", Value: verification.CreatedBy},
+			{Name: "createdAt", Value: verification.CreatedAt},
+		},
+	}
+	if verification.VerificationResult.KeySource != "" {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "keySource", Value: verification.VerificationResult.KeySource})
+	}
+	if verification.VerificationResult.KeyFingerprint != "" {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "keyFingerprint", Value: verification.VerificationResult.KeyFingerprint})
+	}
+	if verification.VerificationResult.KeyId != "" {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "keyId", Value: verification.VerificationResult.KeyId})
+	}
+	var failedChecks, details []string
+	for _, check := range checks {
+		testCase.Properties = append(testCase.Properties, junitProperty{Name: "check." + check.name, Value: string(check.status)})
+		details = append(details, fmt.Sprintf("%s: %s", check.name, check.status))
+		if check.status != model.Success {
+			failedChecks = append(failedChecks, check.name)
+		}
+	}
+	if IsVerificationSucceed(*verification) && len(failedChecks) == 0 {
+		return testCase
+	}
+	message := verification.VerificationResult.FailureReason
+	if message == "" {
+		message = "evidence verification failed"
+	}
+	for _, attachment := range verification.AttachmentsVerification {
+		if attachment.VerificationStatus == model.Failed {
+			details = append(details, fmt.Sprintf("attachment %s: %s", attachment.Name, attachment.FailureReason))
+		}
+	}
+	for _, assertion := range verification.VerificationResult.AssertionResults {
+		if assertion.Status == model.Failed {
+			details = append(details, fmt.Sprintf("assertion %s: %s %s", assertion.Expression, assertion.Status, assertion.Error))
+		}
+	}
+	testCase.Failure = &junitFailure{
+		Message: message,
+		Type:    strings.Join(failedChecks, ","),
+		Details: strings.Join(details, "\n"),
+	}
+	return testCase
+}
+
+// evidenceChecks lists the checks performed on the evidence according to its media type.
+func evidenceChecks(verification *model.EvidenceVerification) []junitCheck {
+	result := verification.VerificationResult
+	checks := []junitCheck{{name: "sha256", status: result.Sha256VerificationStatus}}
+	switch verification.MediaType {
+	case model.SigstoreBundle:
+		checks = append(checks, junitCheck{name: "sigstore", status: result.SigstoreBundleVerificationStatus})
+	default:
+		checks = append(checks, junitCheck{name: "signatures", status: result.SignaturesVerificationStatus})
+	}
+	if result.TimestampVerificationStatus != "" {
+		checks = append(checks, junitCheck{name: "timestamp", status: result.TimestampVerificationStatus})
+	}
+	if result.ExpiryVerificationStatus != "" {
+		checks = append(checks, junitCheck{name: "expiry", status: result.ExpiryVerificationStatus})
+	}
+	if result.AttachmentsVerificationStatus != "" {
+		checks = append(checks, junitCheck{name: "attachments", status: result.AttachmentsVerificationStatus})
+	}
+	if result.AssertionsVerificationStatus != "" {
+		checks = append(checks, junitCheck{name: "assertions", status: result.AssertionsVerificationStatus})
+	}
+	if result.SchemaVerificationStatus != "" {
+		checks = append(checks, junitCheck{name: "schema", status: result.SchemaVerificationStatus})
+	}
+	return checks
+}
+
+func policyRuleToJunitTestCase(subjectPath string, rule model.PolicyRuleResult) junitTestCase {
+	name := "policy: " + rule.Rule
+	if rule.PredicateType != "" {
+		name = fmt.Sprintf("%s (%s)", name, rule.PredicateType)
+	}
+	testCase := junitTestCase{
+		Name:       name,
+		ClassName:  subjectPath,
+		Assertions: 1,
+	}
+	if rule.Status != model.Failed {
+		return testCase
+	}
+	message := rule.Message
+	if message == "" {
+		message = "policy rule failed"
+	}
+	testCase.Failure = &junitFailure{
+		Message: message,
+		Type:    rule.Rule,
+		Details: strings.Join(rule.Violations, "\n"),
+	}
+	return testCase
+}
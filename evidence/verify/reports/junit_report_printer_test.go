@@ -0,0 +1,172 @@
+package reports
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseJunit(t *testing.T, out string) junitTestSuites {
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal([]byte(out), &suites))
+	return suites
+}
+
+func TestJunit_Print(t *testing.T) {
+	resp := &model.VerificationResponse{
+		Subject:                   model.Subject{Path: "generic-local/app.tgz", Sha256: "abc"},
+		OverallVerificationStatus: model.Failed,
+		EvidenceVerifications: &[]model.EvidenceVerification{{
+			MediaType:     model.SimpleDSSE,
+			DownloadPath:  "e1.json",
+			PredicateType: "pred-1",
+			VerificationResult: model.EvidenceVerificationResult{
+				Sha256VerificationStatus:     model.Success,
+				SignaturesVerificationStatus: model.Success,
+				KeySource:                    "User Provided Key",
+			},
+		}, {
+			MediaType:     model.SigstoreBundle,
+			DownloadPath:  "e2.json",
+			PredicateType: "pred-2",
+			VerificationResult: model.EvidenceVerificationResult{
+				Sha256VerificationStatus:         model.Success,
+				SigstoreBundleVerificationStatus: model.Failed,
+				FailureReason:                    "certificate chain invalid",
+			},
+		}, {
+			MediaType:     model.SimpleDSSE,
+			DownloadPath:  "e3.json",
+			PredicateType: "pred-3",
+			VerificationResult: model.EvidenceVerificationResult{
+				Sha256VerificationStatus:      model.Success,
+				SignaturesVerificationStatus:  model.Success,
+				AttachmentsVerificationStatus: model.Failed,
+			},
+			AttachmentsVerification: []model.AttachmentVerification{{Name: "sbom.json", VerificationStatus: model.Failed, FailureReason: "checksum mismatch"}},
+		}},
+	}
+
+	out := captureOutput(func() {
+		assert.NoError(t, JunitReportPrinter.Print(resp))
+	})
+	assert.True(t, strings.HasPrefix(out, xml.Header))
+
+	suites := parseJunit(t, out)
+	assert.Equal(t, 3, suites.Tests)
+	assert.Equal(t, 2, suites.Failures)
+	require.Len(t, suites.Suites, 1)
+	suite := suites.Suites[0]
+	assert.Equal(t, "generic-local/app.tgz", suite.Name)
+	assert.Contains(t, suite.Properties, junitProperty{Name: "subject.sha256", Value: "abc"})
+	require.Len(t, suite.TestCases, 3)
+
+	passed := suite.TestCases[0]
+	assert.Equal(t, "pred-1 (e1.json)", passed.Name)
+	assert.Equal(t, 2, passed.Assertions)
+	assert.Nil(t, passed.Failure)
+	assert.Contains(t, passed.Properties, junitProperty{Name: "check.signatures", Value: "success"})
+	assert.Contains(t, passed.Properties, junitProperty{Name: "keySource", Value: "User Provided Key"})
+
+	sigstoreFailure := suite.TestCases[1]
+	require.NotNil(t, sigstoreFailure.Failure)
+	assert.Equal(t, "certificate chain invalid", sigstoreFailure.Failure.Message)
+	assert.Equal(t, "sigstore", sigstoreFailure.Failure.Type)
+
+	attachmentFailure := suite.TestCases[2]
+	assert.Equal(t, 3, attachmentFailure.Assertions)
+	require.NotNil(t, attachmentFailure.Failure)
+	assert.Equal(t, "evidence verification failed", attachmentFailure.Failure.Message)
+	assert.Equal(t, "attachments", attachmentFailure.Failure.Type)
+	assert.Contains(t, attachmentFailure.Failure.Details, "attachment sbom.json: checksum mismatch")
+}
+
+func TestJunit_Print_PolicyRules(t *testing.T) {
+	resp := &model.VerificationResponse{
+		Subject: model.Subject{Path: "repo/a"},
+		EvidenceVerifications: &[]model.EvidenceVerification{{
+			PredicateType: "pred-1",
+			VerificationResult: model.EvidenceVerificationResult{
+				Sha256VerificationStatus:     model.Success,
+				SignaturesVerificationStatus: model.Success,
+			},
+		}},
+		PolicyVerification: &model.PolicyVerification{
+			Status: model.Failed,
+			RuleResults: []model.PolicyRuleResult{{
+				Rule:          "required-predicate-type",
+				PredicateType: "pred-2",
+				Status:        model.Failed,
+				Message:       "found 0 compliant evidence",
+				Violations:    []string{"e1: not trusted"},
+			}},
+		},
+	}
+
+	out := captureOutput(func() {
+		assert.NoError(t, JunitReportPrinter.Print(resp))
+	})
+	suites := parseJunit(t, out)
+	assert.Equal(t, 2, suites.Tests)
+	assert.Equal(t, 1, suites.Failures)
+	require.Len(t, suites.Suites, 1)
+	require.Len(t, suites.Suites[0].TestCases, 2)
+	assert.Nil(t, suites.Suites[0].TestCases[0].Failure)
+	policyCase := suites.Suites[0].TestCases[1]
+	assert.Equal(t, "policy: required-predicate-type (pred-2)", policyCase.Name)
+	require.NotNil(t, policyCase.Failure)
+	assert.Equal(t, "e1: not trusted", policyCase.Failure.Details)
+}
+
+func TestJunit_Print_NilResponse(t *testing.T) {
+	err := JunitReportPrinter.Print(nil)
+	assert.ErrorContains(t, err, "verification response is empty")
+}
+
+func TestJunit_Print_TimestampCheck(t *testing.T) {
+	resp := &model.VerificationResponse{
+		Subject:                   model.Subject{Path: "generic-local/app.tgz", Sha256: "abc"},
+		OverallVerificationStatus: model.Failed,
+		EvidenceVerifications: &[]model.EvidenceVerification{{
+			MediaType:     model.SimpleDSSE,
+			DownloadPath:  "e1.json",
+			PredicateType: "pred-1",
+			VerificationResult: model.EvidenceVerificationResult{
+				Sha256VerificationStatus:     model.Success,
+				SignaturesVerificationStatus: model.Success,
+				TimestampVerificationStatus:  model.Failed,
+				FailureReason:                "invalid timestamp",
+			},
+		}},
+	}
+
+	out := captureOutput(func() {
+		assert.NoError(t, JunitReportPrinter.Print(resp))
+	})
+	suites := parseJunit(t, out)
+	require.Len(t, suites.Suites, 1)
+	testCase := suites.Suites[0].TestCases[0]
+	assert.Equal(t, 3, testCase.Assertions)
+	require.NotNil(t, testCase.Failure)
+	assert.Equal(t, "timestamp", testCase.Failure.Type)
+	assert.Equal(t, "invalid timestamp", testCase.Failure.Message)
+}
+
+func TestJunit_Print_ComputedSubjectSha256(t *testing.T) {
+	resp := &model.VerificationResponse{
+		Subject:                   model.Subject{Path: "generic-local/app.tgz", Sha256: "abc", ComputedSha256: "def"},
+		OverallVerificationStatus: model.Success,
+		EvidenceVerifications:     &[]model.EvidenceVerification{},
+	}
+
+	out := captureOutput(func() {
+		assert.NoError(t, JunitReportPrinter.Print(resp))
+	})
+	suites := parseJunit(t, out)
+	require.Len(t, suites.Suites, 1)
+	assert.Contains(t, suites.Suites[0].Properties, junitProperty{Name: "subject.computedSha256", Value: "def"})
+}
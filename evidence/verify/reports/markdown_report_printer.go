@@ -2,6 +2,7 @@ package reports
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
 )
@@ -30,6 +31,13 @@ func (p *markdownReportPrinter) Print(result *model.VerificationResponse) error
 	fmt.Println()
 	fmt.Printf("Subject path: `%s`  \n", result.Subject.Path)
 	fmt.Printf("Subject sha256: `%s`  \n", result.Subject.Sha256)
+	if result.Subject.ComputedSha256 != "" {
+		fmt.Printf("Computed sha256: `%s`  \n", result.Subject.ComputedSha256)
+		fmt.Printf("Subject content verification status: %s  \n", getStatusDisplay(result.SubjectContentVerificationStatus))
+	}
+	if result.AsOf != "" {
+		fmt.Printf("Evaluated as of: `%s`  \n", result.AsOf)
+	}
 	fmt.Println()
 	fmt.Printf("**Overall attestation verification status:** %s  \n", getStatusDisplay(result.OverallVerificationStatus))
 
@@ -82,5 +90,57 @@ func (p *markdownReportPrinter) Print(result *model.VerificationResponse) error
 		fmt.Println()
 	}
 
+	for _, verification := range *result.EvidenceVerifications {
+		if !hasFailedAssertion(verification.VerificationResult.AssertionResults) {
+			continue
+		}
+		fmt.Println("### Assertion failures")
+		fmt.Printf("- Evidence: `%s`\n", verification.DownloadPath)
+		for _, assertion := range verification.VerificationResult.AssertionResults {
+			if assertion.Status != model.Failed {
+				continue
+			}
+			if assertion.Error != "" {
+				fmt.Printf("  - `%s` - failed (%s)\n", assertion.Expression, assertion.Error)
+			} else {
+				fmt.Printf("  - `%s` - failed\n", assertion.Expression)
+			}
+		}
+		fmt.Println()
+	}
+	printMarkdownPolicyVerification(result.PolicyVerification)
+
 	return nil
 }
+
+func printMarkdownPolicyVerification(policy *model.PolicyVerification) {
+	if policy == nil {
+		return
+	}
+	fmt.Println("## Policy Verification Results")
+	fmt.Printf("Policy: `%s`  \n", policy.PolicyPath)
+	fmt.Printf("**Policy status:** %s  \n", getStatusDisplay(policy.Status))
+	fmt.Println()
+	fmt.Println("| Rule | Predicate type | Matched | Required | Status | Details |")
+	fmt.Println("|-|-|-|-|-|-|")
+	for _, rule := range policy.RuleResults {
+		predicateType := rule.PredicateType
+		if predicateType == "" {
+			predicateType = "-"
+		}
+		required := "-"
+		if rule.RequiredCount > 0 {
+			required = fmt.Sprintf("%d", rule.RequiredCount)
+		}
+		details := rule.Message
+		if len(rule.Violations) > 0 {
+			details = strings.Join(append([]string{details}, rule.Violations...), "<br>")
+			details = strings.TrimPrefix(details, "<br>")
+		}
+		if details == "" {
+			details = "-"
+		}
+		fmt.Printf("| %s | %s | %d | %s | %s | %s |\n", rule.Rule, predicateType, rule.MatchedCount, required, getStatusDisplay(rule.Status), details)
+	}
+	fmt.Println()
+}
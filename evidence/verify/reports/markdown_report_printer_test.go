@@ -76,3 +76,64 @@ func TestMarkdown_Print_AttachmentFailuresAreShownWithoutExtraColumn(t *testing.
 	assert.Contains(t, out, "Attachment verification failures")
 	assert.Contains(t, out, "checksum mismatch")
 }
+
+func TestMarkdown_Print_PolicyVerification(t *testing.T) {
+	resp := &model.VerificationResponse{
+		OverallVerificationStatus: model.Success,
+		EvidenceVerifications:     &[]model.EvidenceVerification{},
+		PolicyVerification: &model.PolicyVerification{
+			PolicyPath: "policy.yml",
+			Status:     model.Success,
+			RuleResults: []model.PolicyRuleResult{{
+				Rule:          "min-count",
+				PredicateType: "pred-1",
+				Status:        model.Success,
+				RequiredCount: 2,
+				MatchedCount:  2,
+			}, {
+				Rule:         "evidence-verification",
+				Status:       model.Success,
+				MatchedCount: 2,
+			}},
+		},
+	}
+
+	out := captureOutput(func() {
+		err := MarkdownReportPrinter.Print(resp)
+		assert.NoError(t, err)
+	})
+	assert.Contains(t, out, "## Policy Verification Results")
+	assert.Contains(t, out, "| min-count | pred-1 | 2 | 2 | ✅ Verified | - |")
+	assert.Contains(t, out, "| evidence-verification | - | 2 | - | ✅ Verified | - |")
+}
+
+func TestMarkdown_Print_AssertionFailures(t *testing.T) {
+	resp := &model.VerificationResponse{
+		OverallVerificationStatus: model.Failed,
+		EvidenceVerifications: &[]model.EvidenceVerification{{
+			MediaType:     model.SimpleDSSE,
+			DownloadPath:  "repo/.evidence/sonar.json",
+			PredicateType: "sonar",
+			VerificationResult: model.EvidenceVerificationResult{
+				SignaturesVerificationStatus: model.Success,
+				Sha256VerificationStatus:     model.Success,
+				AssertionsVerificationStatus: model.Failed,
+				AssertionResults: []model.AssertionResult{
+					{Expression: "predicate.ok", Status: model.Success},
+					{Expression: "predicate.gate == 'OK'", Status: model.Failed},
+				},
+				FailureReason: "assertion failed: predicate.gate == 'OK'",
+			},
+		}},
+	}
+
+	out := captureOutput(func() {
+		err := MarkdownReportPrinter.Print(resp)
+		assert.NoError(t, err)
+	})
+	assert.Contains(t, out, "| sonar | evidence.dsse | - | - | ❌ Failed | assertion failed: predicate.gate == 'OK' |")
+	assert.Contains(t, out, "### Assertion failures")
+	assert.Contains(t, out, "- Evidence: `repo/.evidence/sonar.json`")
+	assert.Contains(t, out, "  - `predicate.gate == 'OK'` - failed")
+	assert.NotContains(t, out, "`predicate.ok`")
+}
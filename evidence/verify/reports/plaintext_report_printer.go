@@ -23,7 +23,14 @@ func (p *plaintextReportPrinter) Print(result *model.VerificationResponse) error
 		return err
 	}
 	fmt.Printf("Subject sha256:        %s\n", result.Subject.Sha256)
+	if result.Subject.ComputedSha256 != "" {
+		fmt.Printf("Computed sha256:       %s\n", result.Subject.ComputedSha256)
+		fmt.Printf("Content verification:  %s\n", result.SubjectContentVerificationStatus)
+	}
 	fmt.Printf("Subject:               %s\n", result.Subject.Path)
+	if result.AsOf != "" {
+		fmt.Printf("Evaluated as of:       %s\n", result.AsOf)
+	}
 	evidenceNumber := len(*result.EvidenceVerifications)
 	fmt.Printf("Loaded %d evidence\n", evidenceNumber)
 	successfulVerifications := 0
@@ -46,10 +53,32 @@ func (p *plaintextReportPrinter) Print(result *model.VerificationResponse) error
 	for i, verification := range *result.EvidenceVerifications {
 		p.printVerificationResult(&verification, i)
 	}
+	p.printPolicyVerification(result.PolicyVerification)
 
 	return nil
 }
 
+func (p *plaintextReportPrinter) printPolicyVerification(policy *model.PolicyVerification) {
+	if policy == nil {
+		return
+	}
+	fmt.Println()
+	fmt.Printf("Policy %s: %s\n", policy.PolicyPath, p.getColoredStatus(policy.Status))
+	for _, rule := range policy.RuleResults {
+		name := rule.Rule
+		if rule.PredicateType != "" {
+			name = fmt.Sprintf("%s (%s)", rule.Rule, rule.PredicateType)
+		}
+		fmt.Printf("- %s: %s\n", name, p.getColoredStatus(rule.Status))
+		if rule.Message != "" {
+			fmt.Printf("    - %s\n", rule.Message)
+		}
+		for _, violation := range rule.Violations {
+			fmt.Printf("      • %s\n", violation)
+		}
+	}
+}
+
 func (p *plaintextReportPrinter) printVerificationResult(verification *model.EvidenceVerification, index int) {
 	fmt.Printf("- Evidence %d:\n", index+1)
 	fmt.Printf("    - Media type:                      %s\n", verification.MediaType)
@@ -61,10 +90,22 @@ func (p *plaintextReportPrinter) printVerificationResult(verification *model.Evi
 	if verification.VerificationResult.KeyFingerprint != "" {
 		fmt.Printf("    - Key fingerprint:                 %s\n", verification.VerificationResult.KeyFingerprint)
 	}
+	if verification.VerificationResult.KeyId != "" {
+		fmt.Printf("    - Key ID:                          %s\n", verification.VerificationResult.KeyId)
+	}
 	fmt.Printf("    - Sha256 verification status:      %s\n", p.getColoredStatus(verification.VerificationResult.Sha256VerificationStatus))
 	if verification.MediaType == model.SimpleDSSE {
 		fmt.Printf("    - Signatures verification status:  %s\n", p.getColoredStatus(verification.VerificationResult.SignaturesVerificationStatus))
 	}
+	if verification.VerificationResult.TimestampVerificationStatus != "" {
+		fmt.Printf("    - Timestamp verification status:   %s\n", p.getColoredStatus(verification.VerificationResult.TimestampVerificationStatus))
+	}
+	if verification.VerificationResult.SignedAt != "" {
+		fmt.Printf("    - Signed at (timestamped):         %s\n", verification.VerificationResult.SignedAt)
+	}
+	if verification.VerificationResult.ExpiryVerificationStatus != "" {
+		fmt.Printf("    - Expiry verification status:      %s (expires at %s)\n", p.getColoredStatus(verification.VerificationResult.ExpiryVerificationStatus), verification.VerificationResult.ExpiresAt)
+	}
 	if verification.MediaType == model.SigstoreBundle {
 		fmt.Printf("    - Sigstore verification status:    %s\n", p.getColoredStatus(verification.VerificationResult.SigstoreBundleVerificationStatus))
 	}
@@ -76,6 +117,24 @@ func (p *plaintextReportPrinter) printVerificationResult(verification *model.Evi
 		}
 	}
 
+	if verification.VerificationResult.AssertionsVerificationStatus != "" {
+		fmt.Printf("    - Assertions verification status:  %s\n", p.getColoredStatus(verification.VerificationResult.AssertionsVerificationStatus))
+		for _, assertion := range verification.VerificationResult.AssertionResults {
+			if assertion.Error != "" {
+				fmt.Printf("      • %s: %s (%s)\n", assertion.Expression, p.getColoredStatus(assertion.Status), assertion.Error)
+				continue
+			}
+			fmt.Printf("      • %s: %s\n", assertion.Expression, p.getColoredStatus(assertion.Status))
+		}
+	}
+
+	if verification.VerificationResult.SchemaVerificationStatus != "" {
+		fmt.Printf("    - Schema verification status:      %s\n", p.getColoredStatus(verification.VerificationResult.SchemaVerificationStatus))
+		for _, violation := range verification.VerificationResult.SchemaViolations {
+			fmt.Printf("      • %s\n", violation)
+		}
+	}
+
 	if verification.VerificationResult.FailureReason != "" {
 		fmt.Printf("    - Failure reason:                  %s\n", verification.VerificationResult.FailureReason)
 	}
@@ -197,3 +197,59 @@ func TestPlaintext_Print_AttachmentStatusFailureWithDetails(t *testing.T) {
 	assert.Contains(t, out, "• contract.pdf "+PlaintextReportPrinter.failed)
 	assert.Contains(t, out, "checksum mismatch")
 }
+
+func TestPlaintext_Print_PolicyVerification(t *testing.T) {
+	resp := &model.VerificationResponse{
+		OverallVerificationStatus: model.Failed,
+		EvidenceVerifications:     &[]model.EvidenceVerification{},
+		PolicyVerification: &model.PolicyVerification{
+			PolicyPath: "policy.yml",
+			Status:     model.Failed,
+			RuleResults: []model.PolicyRuleResult{{
+				Rule:          "required-predicate-type",
+				PredicateType: "https://slsa.dev/provenance/v1",
+				Status:        model.Failed,
+				RequiredCount: 1,
+				Message:       "found 0 compliant evidence",
+				Violations:    []string{"path/e1.json: not signed by a trusted key or identity"},
+			}},
+		},
+	}
+
+	out := captureOutput(func() {
+		err := PlaintextReportPrinter.Print(resp)
+		assert.NoError(t, err)
+	})
+	assert.Contains(t, out, "Policy policy.yml:")
+	assert.Contains(t, out, "- required-predicate-type (https://slsa.dev/provenance/v1):")
+	assert.Contains(t, out, "found 0 compliant evidence")
+	assert.Contains(t, out, "path/e1.json: not signed by a trusted key or identity")
+}
+
+func TestPlaintext_Print_AssertionResults(t *testing.T) {
+	resp := &model.VerificationResponse{
+		OverallVerificationStatus: model.Failed,
+		EvidenceVerifications: &[]model.EvidenceVerification{{
+			MediaType:     model.SimpleDSSE,
+			PredicateType: "sonar",
+			VerificationResult: model.EvidenceVerificationResult{
+				SignaturesVerificationStatus: model.Success,
+				Sha256VerificationStatus:     model.Success,
+				AssertionsVerificationStatus: model.Failed,
+				AssertionResults: []model.AssertionResult{
+					{Expression: `predicate.gates.exists(g, g.status == "OK")`, Status: model.Failed},
+					{Expression: "predicate.missing", Status: model.Failed, Error: "no such key: missing"},
+				},
+			},
+		}},
+	}
+
+	out := captureOutput(func() {
+		err := PlaintextReportPrinter.Print(resp)
+		assert.NoError(t, err)
+	})
+	assert.Contains(t, out, "Verification passed for 0 out of 1 evidence")
+	assert.Contains(t, out, "Assertions verification status:")
+	assert.Contains(t, out, `predicate.gates.exists(g, g.status == "OK"):`)
+	assert.Contains(t, out, "(no such key: missing)")
+}
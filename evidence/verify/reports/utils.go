@@ -15,8 +15,25 @@ func verifyNotEmptyResponse(result *model.VerificationResponse) error {
 
 func IsVerificationSucceed(v model.EvidenceVerification) bool {
 	attachmentsStatusOk := v.VerificationResult.AttachmentsVerificationStatus == "" || v.VerificationResult.AttachmentsVerificationStatus == model.Success
+	assertionsStatusOk := v.VerificationResult.AssertionsVerificationStatus == "" || v.VerificationResult.AssertionsVerificationStatus == model.Success
+	timestampStatusOk := v.VerificationResult.TimestampVerificationStatus == "" || v.VerificationResult.TimestampVerificationStatus == model.Success
+	expiryStatusOk := v.VerificationResult.ExpiryVerificationStatus == "" || v.VerificationResult.ExpiryVerificationStatus == model.Success
+	schemaStatusOk := v.VerificationResult.SchemaVerificationStatus == "" || v.VerificationResult.SchemaVerificationStatus == model.Success
 	return v.VerificationResult.Sha256VerificationStatus == model.Success &&
 		attachmentsStatusOk &&
+		assertionsStatusOk &&
+		timestampStatusOk &&
+		expiryStatusOk &&
+		schemaStatusOk &&
 		(v.VerificationResult.SignaturesVerificationStatus == model.Success ||
 			v.VerificationResult.SigstoreBundleVerificationStatus == model.Success)
 }
+
+func hasFailedAssertion(assertionResults []model.AssertionResult) bool {
+	for _, assertion := range assertionResults {
+		if assertion.Status == model.Failed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package revocation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"gopkg.in/yaml.v3"
+)
+
+const fingerprintPrefix = "SHA256:"
+
+// List holds revoked signing keys.
+//
+// Example file:
+//
+//	revocations:
+//	  - fingerprint: "SHA256:<base64 key fingerprint>"
+//	    revokedAfter: 2025-05-01T00:00:00Z
+//	    reason: private key leaked in CI logs
+//	  - keyId: 6bd1a2a0c8a1d3c4
+//	    reason: key decommissioned
+type List struct {
+	Revocations []Revocation `yaml:"revocations"`
+}
+
+// Revocation identifies a key by fingerprint or key ID. Signatures made after RevokedAfter are no longer trusted;
+// without RevokedAfter every signature of the key is rejected.
+type Revocation struct {
+	Fingerprint  string `yaml:"fingerprint"`
+	KeyId        string `yaml:"keyId"`
+	RevokedAfter string `yaml:"revokedAfter"`
+	Reason       string `yaml:"reason"`
+
+	revokedAfter time.Time
+}
+
+// Load reads and validates a revocation file.
+func Load(path string) (*List, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revocation file %s: %w", path, err)
+	}
+	list, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid revocation file %s: %w", path, err)
+	}
+	return list, nil
+}
+
+// Parse decodes a YAML (or JSON) revocation document and validates it. Unknown fields are rejected.
+func Parse(content []byte) (*List, error) {
+	list := &List{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(list); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	for i := range list.Revocations {
+		revocation := &list.Revocations[i]
+		revocation.Fingerprint = strings.TrimPrefix(strings.TrimSpace(revocation.Fingerprint), fingerprintPrefix)
+		revocation.KeyId = strings.TrimSpace(revocation.KeyId)
+		if revocation.Fingerprint == "" && revocation.KeyId == "" {
+			return nil, fmt.Errorf("revocation %d must define a fingerprint or keyId", i+1)
+		}
+		if revocation.RevokedAfter != "" {
+			revokedAfter, err := utils.ParseTimestamp(revocation.RevokedAfter)
+			if err != nil {
+				return nil, fmt.Errorf("revocation %d: invalid revokedAfter '%s'", i+1, revocation.RevokedAfter)
+			}
+			revocation.revokedAfter = revokedAfter
+		}
+	}
+	return list, nil
+}
+
+// Find returns the revocation of the key identified by fingerprint or keyId that applies to a signature
+// made at signedAt, or nil if the key is trusted at that time.
+func (l *List) Find(fingerprint, keyId string, signedAt time.Time) *Revocation {
+	if l == nil {
+		return nil
+	}
+	for i := range l.Revocations {
+		revocation := &l.Revocations[i]
+		if !revocation.matches(fingerprint, keyId) {
+			continue
+		}
+		if revocation.revokedAfter.IsZero() || signedAt.After(revocation.revokedAfter) {
+			return revocation
+		}
+	}
+	return nil
+}
+
+func (r *Revocation) matches(fingerprint, keyId string) bool {
+	return (r.Fingerprint != "" && r.Fingerprint == fingerprint) || (r.KeyId != "" && r.KeyId == keyId)
+}
+
+// Describe returns a human readable description of the revocation, used as the verification failure reason.
+func (r *Revocation) Describe() string {
+	description := "signing key is revoked"
+	if r.RevokedAfter != "" {
+		description = fmt.Sprintf("signing key was revoked after %s", r.RevokedAfter)
+	}
+	if r.Reason != "" {
+		description = fmt.Sprintf("%s: %s", description, r.Reason)
+	}
+	return description
+}
@@ -0,0 +1,62 @@
+package revocation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const revocationsYaml = `revocations:
+  - fingerprint: "SHA256:abc123"
+    revokedAfter: 2025-05-01T00:00:00Z
+    reason: private key leaked in CI logs
+  - keyId: old-key
+    reason: key decommissioned
+`
+
+func TestParseAndFind(t *testing.T) {
+	list, err := Parse([]byte(revocationsYaml))
+	require.NoError(t, err)
+	require.Len(t, list.Revocations, 2)
+
+	before := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, list.Find("abc123", "", before))
+	revoked := list.Find("abc123", "", after)
+	require.NotNil(t, revoked)
+	assert.Equal(t, "signing key was revoked after 2025-05-01T00:00:00Z: private key leaked in CI logs", revoked.Describe())
+
+	revoked = list.Find("other", "old-key", before)
+	require.NotNil(t, revoked)
+	assert.Equal(t, "signing key is revoked: key decommissioned", revoked.Describe())
+
+	assert.Nil(t, list.Find("other", "other-key", after))
+	assert.Nil(t, (*List)(nil).Find("abc123", "", after))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse([]byte("revocations:\n  - reason: missing key\n"))
+	assert.ErrorContains(t, err, "must define a fingerprint or keyId")
+
+	_, err = Parse([]byte("revocations:\n  - keyId: k\n    revokedAfter: last week\n"))
+	assert.ErrorContains(t, err, "invalid revokedAfter")
+
+	_, err = Parse([]byte("revocation:\n  - keyId: k\n"))
+	assert.Error(t, err)
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "revocations.yml")
+	require.NoError(t, os.WriteFile(path, []byte(revocationsYaml), 0600))
+	list, err := Load(path)
+	require.NoError(t, err)
+	assert.Len(t, list.Revocations, 2)
+
+	_, err = Load(filepath.Join(t.TempDir(), "missing.yml"))
+	assert.ErrorContains(t, err, "failed to read revocation file")
+}
@@ -0,0 +1,47 @@
+package verifiers
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/assertions"
+)
+
+const assertionFailedReason = "assertion failed"
+
+type assertionVerifierInterface interface {
+	verify(result *model.EvidenceVerification) error
+}
+
+type assertionVerifier struct {
+	assertions *assertions.Assertions
+}
+
+func newAssertionVerifier(a *assertions.Assertions) assertionVerifierInterface {
+	return &assertionVerifier{assertions: a}
+}
+
+// verify evaluates the assertions registered for the evidence predicate type against its decoded statement.
+// Evidence without registered assertions is left untouched.
+func (v *assertionVerifier) verify(result *model.EvidenceVerification) error {
+	if result == nil || !v.assertions.HasAssertions(result.PredicateType) {
+		return nil
+	}
+	statement, err := result.StatementPayload()
+	if err != nil {
+		return err
+	}
+	results := v.assertions.Evaluate(result.PredicateType, statement)
+	result.VerificationResult.AssertionResults = results
+	result.VerificationResult.AssertionsVerificationStatus = model.Success
+	for _, assertionResult := range results {
+		if assertionResult.Status == model.Failed {
+			result.VerificationResult.AssertionsVerificationStatus = model.Failed
+			if result.VerificationResult.FailureReason == "" {
+				result.VerificationResult.FailureReason = fmt.Sprintf("%s: %s", assertionFailedReason, assertionResult.Expression)
+			}
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package verifiers
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/assertions"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	protodsse "github.com/sigstore/protobuf-specs/gen/pb-go/dsse"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStatement = `{"_type":"https://in-toto.io/Statement/v1","predicateType":"sonar","predicate":{"gates":[{"status":"OK"}]}}`
+
+func newTestAssertionVerifier(t *testing.T, expressions ...string) assertionVerifierInterface {
+	a, err := assertions.Compile(map[string][]string{"sonar": expressions})
+	require.NoError(t, err)
+	return newAssertionVerifier(a)
+}
+
+func TestAssertionVerifier_DsseEnvelope(t *testing.T) {
+	result := &model.EvidenceVerification{
+		PredicateType: "sonar",
+		DsseEnvelope:  &dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(testStatement))},
+	}
+	err := newTestAssertionVerifier(t, `predicate.gates.exists(g, g.status == "OK")`).verify(result)
+	require.NoError(t, err)
+	assert.Equal(t, model.Success, result.VerificationResult.AssertionsVerificationStatus)
+	assert.Len(t, result.VerificationResult.AssertionResults, 1)
+	assert.Empty(t, result.VerificationResult.FailureReason)
+	assert.False(t, result.VerificationResult.HasFailure())
+}
+
+func TestAssertionVerifier_SigstoreBundle(t *testing.T) {
+	result := &model.EvidenceVerification{
+		PredicateType: "sonar",
+		SigstoreBundle: &bundle.Bundle{Bundle: &protobundle.Bundle{
+			Content: &protobundle.Bundle_DsseEnvelope{DsseEnvelope: &protodsse.Envelope{Payload: []byte(testStatement)}},
+		}},
+	}
+	err := newTestAssertionVerifier(t, `predicate.gates.exists(g, g.status == "ERROR")`).verify(result)
+	require.NoError(t, err)
+	assert.Equal(t, model.Failed, result.VerificationResult.AssertionsVerificationStatus)
+	assert.Equal(t, `assertion failed: predicate.gates.exists(g, g.status == "ERROR")`, result.VerificationResult.FailureReason)
+	assert.True(t, result.VerificationResult.HasFailure())
+}
+
+func TestAssertionVerifier_KeepsExistingFailureReason(t *testing.T) {
+	result := &model.EvidenceVerification{
+		PredicateType:      "sonar",
+		DsseEnvelope:       &dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(testStatement))},
+		VerificationResult: model.EvidenceVerificationResult{FailureReason: "signature mismatch"},
+	}
+	require.NoError(t, newTestAssertionVerifier(t, "false").verify(result))
+	assert.Equal(t, model.Failed, result.VerificationResult.AssertionsVerificationStatus)
+	assert.Equal(t, "signature mismatch", result.VerificationResult.FailureReason)
+}
+
+func TestAssertionVerifier_OtherPredicateType(t *testing.T) {
+	result := &model.EvidenceVerification{PredicateType: "other"}
+	require.NoError(t, newTestAssertionVerifier(t, "false").verify(result))
+	assert.Empty(t, result.VerificationResult.AssertionsVerificationStatus)
+	assert.Nil(t, result.VerificationResult.AssertionResults)
+}
+
+func TestAssertionVerifier_NoStatement(t *testing.T) {
+	result := &model.EvidenceVerification{PredicateType: "sonar"}
+	err := newTestAssertionVerifier(t, "true").verify(result)
+	assert.ErrorContains(t, err, "no statement available")
+
+	result.DsseEnvelope = &dsse.Envelope{Payload: "%%%"}
+	err = newTestAssertionVerifier(t, "true").verify(result)
+	assert.ErrorContains(t, err, "failed to decode DSSE payload")
+}
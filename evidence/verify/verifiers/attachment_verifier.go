@@ -19,6 +19,7 @@ const (
 	checksumMismatchReason              = "checksum mismatch"
 	attachmentMetadataUnavailableReason = "unable to get attachment metadata from GraphQL (query without attachments)"
 	attachmentVerificationFailedReason  = "attachment failed verification"
+	contentChecksumMismatchReason       = "downloaded content checksum mismatch"
 )
 
 type attachmentVerifierInterface interface {
@@ -27,6 +28,8 @@ type attachmentVerifierInterface interface {
 
 type attachmentVerifier struct {
 	artifactoryClient artifactory.ArtifactoryServicesManager
+	// deep also downloads every attachment and checks the sha256 computed from its content.
+	deep bool
 }
 
 func newAttachmentVerifier(client artifactory.ArtifactoryServicesManager) attachmentVerifierInterface {
@@ -89,10 +92,20 @@ func (v *attachmentVerifier) verify(evidence *model.SearchEvidenceEdge, result *
 		}
 
 		verification.ActualSha256 = fileInfo.Checksums.Sha256
-		if verification.ActualSha256 != expected.Sha256 {
+		if v.deep {
+			if verification.ComputedSha256, err = computeRemoteSha256(v.artifactoryClient, actualAttachment.DownloadPath); err != nil {
+				return fmt.Errorf("failed to hash attachment %s: %w", expected.Name, err)
+			}
+		}
+		switch {
+		case verification.ActualSha256 != expected.Sha256:
 			verification.VerificationStatus = model.Failed
 			verification.FailureReason = checksumMismatchReason
 			hasFailures = true
+		case v.deep && verification.ComputedSha256 != expected.Sha256:
+			verification.VerificationStatus = model.Failed
+			verification.FailureReason = contentChecksumMismatchReason
+			hasFailures = true
 		}
 		verifications = append(verifications, verification)
 	}
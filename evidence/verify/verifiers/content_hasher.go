@@ -0,0 +1,33 @@
+package verifiers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+)
+
+// computeRemoteSha256 streams the Artifactory file at repoPath through a sha256 hash, so files of any size
+// are verified without holding them in memory.
+func computeRemoteSha256(client artifactory.ArtifactoryServicesManager, repoPath string) (string, error) {
+	if client == nil {
+		return "", fmt.Errorf("no Artifactory client to download %s", repoPath)
+	}
+	file, err := client.ReadRemoteFile(repoPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to download %s: %w", repoPath, err)
+	}
+	if file == nil {
+		return "", fmt.Errorf("failed to download %s: empty response", repoPath)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", repoPath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,168 @@
+package verifiers
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	artUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// remoteContentServicesManager serves file content by repository path and reports FileInfo checksums
+// independently, so tests can make the reported and downloaded digests disagree.
+type remoteContentServicesManager struct {
+	MockArtifactoryServicesManagerVerifier
+	files    map[string][]byte
+	reported map[string]string
+	reads    []string
+}
+
+func (m *remoteContentServicesManager) ReadRemoteFile(path string) (io.ReadCloser, error) {
+	m.reads = append(m.reads, path)
+	content, ok := m.files[path]
+	if !ok {
+		return nil, errors.New("404 Not Found")
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (m *remoteContentServicesManager) FileInfo(path string) (*artUtils.FileInfo, error) {
+	info := &artUtils.FileInfo{}
+	info.Checksums.Sha256 = m.reported[path]
+	return info, nil
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func newDeepVerificationFixture(t *testing.T, subject, attachment []byte) (*remoteContentServicesManager, *[]model.SearchEvidenceEdge) {
+	attachmentSha := sha256Hex(attachment)
+	client := &remoteContentServicesManager{
+		files: map[string][]byte{
+			"repo/app.tgz":                          subject,
+			"repo/.evidence/app.tgz.json":           createDsseEnvelopeWithAttachmentMeta(t, attachmentSha),
+			"repo/.evidence/attachments/report.txt": attachment,
+		},
+		reported: map[string]string{"repo/.evidence/attachments/report.txt": attachmentSha},
+	}
+	evidence := &[]model.SearchEvidenceEdge{{
+		Node: model.EvidenceMetadata{
+			DownloadPath: "repo/.evidence/app.tgz.json",
+			Subject:      model.EvidenceSubject{Sha256: sha256Hex(subject)},
+			Attachments: []model.AttachmentRef{
+				{Name: "report.txt", Sha256: attachmentSha, DownloadPath: "repo/.evidence/attachments/report.txt"},
+			},
+		},
+	}}
+	return client, evidence
+}
+
+func TestComputeRemoteSha256(t *testing.T) {
+	content := bytes.Repeat([]byte("evidence"), 1<<16)
+	client := &remoteContentServicesManager{files: map[string][]byte{"repo/big.bin": content}}
+
+	digest, err := computeRemoteSha256(client, "repo/big.bin")
+	require.NoError(t, err)
+	assert.Equal(t, sha256Hex(content), digest)
+
+	_, err = computeRemoteSha256(client, "repo/missing.bin")
+	assert.ErrorContains(t, err, "failed to download repo/missing.bin")
+}
+
+func TestVerify_DeepVerification(t *testing.T) {
+	subject, attachment := []byte("subject content"), []byte("attachment content")
+	client, evidence := newDeepVerificationFixture(t, subject, attachment)
+	var clientInterface artifactory.ArtifactoryServicesManager = client
+	verifier := NewEvidenceVerifier(nil, false, &clientInterface, nil, WithDeepVerification())
+
+	result, err := verifier.Verify(sha256Hex(subject), evidence, "repo/app.tgz")
+	require.NoError(t, err)
+	assert.Equal(t, model.Success, result.OverallVerificationStatus)
+	assert.Equal(t, sha256Hex(subject), result.Subject.ComputedSha256)
+	assert.Equal(t, model.Success, result.SubjectContentVerificationStatus)
+	attachments := (*result.EvidenceVerifications)[0].AttachmentsVerification
+	require.Len(t, attachments, 1)
+	assert.Equal(t, sha256Hex(attachment), attachments[0].ActualSha256)
+	assert.Equal(t, sha256Hex(attachment), attachments[0].ComputedSha256)
+	assert.Contains(t, client.reads, "repo/app.tgz")
+	assert.Contains(t, client.reads, "repo/.evidence/attachments/report.txt")
+}
+
+func TestVerify_DeepVerificationSubjectContentMismatch(t *testing.T) {
+	subject, attachment := []byte("subject content"), []byte("attachment content")
+	client, evidence := newDeepVerificationFixture(t, subject, attachment)
+	// Artifactory reports the checksum the evidence was created for, but serves different content.
+	client.files["repo/app.tgz"] = []byte("tampered content")
+	var clientInterface artifactory.ArtifactoryServicesManager = client
+	verifier := NewEvidenceVerifier(nil, false, &clientInterface, nil, WithDeepVerification())
+
+	result, err := verifier.Verify(sha256Hex(subject), evidence, "repo/app.tgz")
+	require.NoError(t, err)
+	assert.Equal(t, model.Failed, result.OverallVerificationStatus)
+	assert.Equal(t, sha256Hex(subject), result.Subject.Sha256)
+	assert.Equal(t, sha256Hex([]byte("tampered content")), result.Subject.ComputedSha256)
+	verification := (*result.EvidenceVerifications)[0]
+	assert.Equal(t, model.Failed, verification.VerificationResult.Sha256VerificationStatus)
+	assert.Equal(t, subjectContentMismatchReason, verification.VerificationResult.FailureReason)
+	assert.Equal(t, model.Failed, result.SubjectContentVerificationStatus)
+}
+
+func TestVerify_DeepVerificationSubjectContentMismatch_EvidenceForServedContent(t *testing.T) {
+	subject, attachment := []byte("subject content"), []byte("attachment content")
+	client, evidence := newDeepVerificationFixture(t, subject, attachment)
+	// The evidence matches the served content, but Artifactory reports another checksum for it.
+	client.files["repo/app.tgz"] = []byte("tampered content")
+	(*evidence)[0].Node.Subject.Sha256 = sha256Hex([]byte("tampered content"))
+	var clientInterface artifactory.ArtifactoryServicesManager = client
+	verifier := NewEvidenceVerifier(nil, false, &clientInterface, nil, WithDeepVerification())
+
+	result, err := verifier.Verify(sha256Hex(subject), evidence, "repo/app.tgz")
+	require.NoError(t, err)
+	assert.Equal(t, model.Failed, result.SubjectContentVerificationStatus)
+	assert.Equal(t, model.Failed, result.OverallVerificationStatus)
+	verification := (*result.EvidenceVerifications)[0]
+	assert.Equal(t, model.Success, verification.VerificationResult.Sha256VerificationStatus)
+	assert.Empty(t, verification.VerificationResult.FailureReason, "evidence that passes has no failure reason")
+}
+
+func TestVerify_DeepVerificationAttachmentContentMismatch(t *testing.T) {
+	subject, attachment := []byte("subject content"), []byte("attachment content")
+	client, evidence := newDeepVerificationFixture(t, subject, attachment)
+	client.files["repo/.evidence/attachments/report.txt"] = []byte("tampered attachment")
+	var clientInterface artifactory.ArtifactoryServicesManager = client
+	verifier := NewEvidenceVerifier(nil, false, &clientInterface, nil, WithDeepVerification())
+
+	result, err := verifier.Verify(sha256Hex(subject), evidence, "repo/app.tgz")
+	require.NoError(t, err)
+	assert.Equal(t, model.Failed, result.OverallVerificationStatus)
+	verification := (*result.EvidenceVerifications)[0]
+	assert.Equal(t, model.Failed, verification.VerificationResult.AttachmentsVerificationStatus)
+	require.Len(t, verification.AttachmentsVerification, 1)
+	assert.Equal(t, sha256Hex(attachment), verification.AttachmentsVerification[0].ActualSha256)
+	assert.Equal(t, sha256Hex([]byte("tampered attachment")), verification.AttachmentsVerification[0].ComputedSha256)
+	assert.Equal(t, contentChecksumMismatchReason, verification.AttachmentsVerification[0].FailureReason)
+}
+
+func TestVerify_WithoutDeepVerificationTrustsReportedChecksums(t *testing.T) {
+	subject, attachment := []byte("subject content"), []byte("attachment content")
+	client, evidence := newDeepVerificationFixture(t, subject, attachment)
+	client.files["repo/.evidence/attachments/report.txt"] = []byte("tampered attachment")
+	var clientInterface artifactory.ArtifactoryServicesManager = client
+	verifier := NewEvidenceVerifier(nil, false, &clientInterface, nil)
+
+	result, err := verifier.Verify(sha256Hex(subject), evidence, "repo/app.tgz")
+	require.NoError(t, err)
+	assert.Equal(t, model.Success, result.OverallVerificationStatus)
+	assert.Empty(t, result.Subject.ComputedSha256)
+	assert.NotContains(t, client.reads, "repo/app.tgz")
+	assert.Empty(t, (*result.EvidenceVerifications)[0].AttachmentsVerification[0].ComputedSha256)
+}
@@ -0,0 +1,96 @@
+package verifiers
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa/tsatest"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/revocation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func verifyWithRevocations(t *testing.T, fixture *timestampFixture, chain *tsa.CertChain, createdAt string, revocations *revocation.List) *model.EvidenceVerification {
+	t.Helper()
+	attachmentVerifier := &MockAttachmentVerifier{}
+	attachmentVerifier.On("verify", mock.Anything, mock.Anything).Return(nil)
+	verifier := &dsseVerifier{
+		keys:               []string{fixture.certPath},
+		attachmentVerifier: attachmentVerifier,
+		tsaChain:           chain,
+		revocations:        revocations,
+	}
+	result := &model.EvidenceVerification{DsseEnvelope: fixture.envelope, CreatedAt: createdAt}
+	require.NoError(t, verifier.verify(&model.SearchEvidenceEdge{}, result))
+	return result
+}
+
+func parseRevocations(t *testing.T, content string) *revocation.List {
+	t.Helper()
+	revocations, err := revocation.Parse([]byte(content))
+	require.NoError(t, err)
+	return revocations
+}
+
+func TestDsseVerifier_Revocation_SignedAfterRevocation(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	server.Time = now.Add(-time.Hour).Truncate(time.Second)
+	fixture := newTimestampFixture(t, now.Add(-48*time.Hour), now.Add(time.Hour))
+	fixture.timestamp(t, server)
+	fingerprint := fixture.verify(t, nil).VerificationResult.KeyFingerprint
+	require.NotEmpty(t, fingerprint)
+
+	revocations := parseRevocations(t, fmt.Sprintf("revocations:\n  - fingerprint: %s\n    revokedAfter: %s\n    reason: key compromised\n", fingerprint, now.Add(-2*time.Hour).UTC().Format(time.RFC3339)))
+	result := verifyWithRevocations(t, fixture, parseChain(t, server), "", revocations)
+	assert.Equal(t, model.Failed, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Contains(t, result.VerificationResult.FailureReason, "signing key was revoked after")
+	assert.Contains(t, result.VerificationResult.FailureReason, "key compromised")
+	assert.True(t, result.VerificationResult.HasFailure())
+}
+
+func TestDsseVerifier_Revocation_TimestampedBeforeRevocation(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	server.Time = now.Add(-3 * time.Hour).Truncate(time.Second)
+	fixture := newTimestampFixture(t, now.Add(-48*time.Hour), now.Add(time.Hour))
+	fixture.timestamp(t, server)
+	fingerprint := fixture.verify(t, nil).VerificationResult.KeyFingerprint
+
+	revocations := parseRevocations(t, fmt.Sprintf("revocations:\n  - fingerprint: %s\n    revokedAfter: %s\n    reason: key rotated\n", fingerprint, now.Add(-2*time.Hour).UTC().Format(time.RFC3339)))
+	result := verifyWithRevocations(t, fixture, parseChain(t, server), "", revocations)
+	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Empty(t, result.VerificationResult.FailureReason)
+}
+
+func TestDsseVerifier_Revocation_FallsBackToUploadTime(t *testing.T) {
+	now := time.Now()
+	fixture := newTimestampFixture(t, now.Add(-48*time.Hour), now.Add(time.Hour))
+	fingerprint := fixture.verify(t, nil).VerificationResult.KeyFingerprint
+	revocations := parseRevocations(t, fmt.Sprintf("revocations:\n  - fingerprint: %s\n    revokedAfter: %s\n    reason: key rotated\n", fingerprint, now.Add(-2*time.Hour).UTC().Format(time.RFC3339)))
+
+	uploadedBefore := now.Add(-3 * time.Hour).UTC().Format(time.RFC3339)
+	result := verifyWithRevocations(t, fixture, nil, uploadedBefore, revocations)
+	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
+
+	uploadedAfter := now.Add(-time.Hour).UTC().Format(time.RFC3339)
+	result = verifyWithRevocations(t, fixture, nil, uploadedAfter, revocations)
+	assert.Equal(t, model.Failed, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Contains(t, result.VerificationResult.FailureReason, "key rotated")
+}
+
+func TestDsseVerifier_Revocation_ByKeyId(t *testing.T) {
+	now := time.Now()
+	fixture := newTimestampFixture(t, now.Add(-48*time.Hour), now.Add(time.Hour))
+	keyId := fixture.verify(t, nil).VerificationResult.KeyId
+	require.NotEmpty(t, keyId)
+
+	revocations := parseRevocations(t, fmt.Sprintf("revocations:\n  - keyId: %s\n    reason: retired\n", keyId))
+	result := verifyWithRevocations(t, fixture, nil, "", revocations)
+	assert.Equal(t, model.Failed, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Equal(t, "signing key is revoked: retired", result.VerificationResult.FailureReason)
+}
@@ -0,0 +1,201 @@
+package verifiers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa/tsatest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// timestampFixture holds a signing key certificate and a DSSE envelope signed with it.
+type timestampFixture struct {
+	certPath string
+	envelope *dsse.Envelope
+}
+
+func newTimestampFixture(t *testing.T, notBefore, notAfter time.Time) *timestampFixture {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "evidence signer"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPath := filepath.Join(t.TempDir(), "signer.crt")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+
+	payload := []byte(`{"predicateType":"https://example.com/test"}`)
+	digest := sha256.Sum256(dsse.PAE("application/vnd.in-toto+json", payload))
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	return &timestampFixture{
+		certPath: certPath,
+		envelope: &dsse.Envelope{
+			Payload:     base64.StdEncoding.EncodeToString(payload),
+			PayloadType: "application/vnd.in-toto+json",
+			Signatures:  []dsse.Signature{{Sig: base64.StdEncoding.EncodeToString(sig)}},
+		},
+	}
+}
+
+func (f *timestampFixture) timestamp(t *testing.T, server *tsatest.Server) {
+	t.Helper()
+	sig, err := base64.StdEncoding.DecodeString(f.envelope.Signatures[0].Sig)
+	require.NoError(t, err)
+	response, err := tsa.RequestTimestamp(server.URL, sig)
+	require.NoError(t, err)
+	f.envelope.Signatures[0].Timestamp = base64.StdEncoding.EncodeToString(response)
+}
+
+func (f *timestampFixture) verify(t *testing.T, chain *tsa.CertChain) *model.EvidenceVerification {
+	t.Helper()
+	attachmentVerifier := &MockAttachmentVerifier{}
+	attachmentVerifier.On("verify", mock.Anything, mock.Anything).Return(nil)
+	verifier := &dsseVerifier{
+		keys:               []string{f.certPath},
+		attachmentVerifier: attachmentVerifier,
+		tsaChain:           chain,
+	}
+	result := &model.EvidenceVerification{DsseEnvelope: f.envelope}
+	require.NoError(t, verifier.verify(&model.SearchEvidenceEdge{}, result))
+	return result
+}
+
+func parseChain(t *testing.T, server *tsatest.Server) *tsa.CertChain {
+	t.Helper()
+	chain, err := tsa.ParseCertChain(server.ChainPEM)
+	require.NoError(t, err)
+	return chain
+}
+
+func TestDsseVerifier_Timestamp_Valid(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	server.Time = now.Add(-time.Hour).Truncate(time.Second)
+	fixture := newTimestampFixture(t, now.Add(-2*time.Hour), now.Add(time.Hour))
+	fixture.timestamp(t, server)
+
+	result := fixture.verify(t, parseChain(t, server))
+	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Equal(t, model.Success, result.VerificationResult.TimestampVerificationStatus)
+	assert.Equal(t, server.Time.UTC().Format(time.RFC3339), result.VerificationResult.SignedAt)
+	assert.False(t, result.VerificationResult.HasFailure())
+}
+
+func TestDsseVerifier_Timestamp_KeyExpiredAfterSigning(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	server.Time = now.Add(-48 * time.Hour).Truncate(time.Second)
+	fixture := newTimestampFixture(t, now.Add(-72*time.Hour), now.Add(-24*time.Hour))
+	fixture.timestamp(t, server)
+
+	result := fixture.verify(t, parseChain(t, server))
+	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Equal(t, model.Success, result.VerificationResult.TimestampVerificationStatus)
+
+	// Without a trusted timestamp the key is checked at the current time, when it has already expired.
+	fixture.envelope.Signatures[0].Timestamp = ""
+	result = fixture.verify(t, nil)
+	assert.Equal(t, model.Failed, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Empty(t, result.VerificationResult.TimestampVerificationStatus)
+	assert.Contains(t, result.VerificationResult.FailureReason, "signing key certificate is not valid at signing time")
+}
+
+func TestDsseVerifier_Timestamp_KeyNotValidAtTimestampedTime(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	server.Time = now.Add(-48 * time.Hour).Truncate(time.Second)
+	fixture := newTimestampFixture(t, now.Add(-time.Hour), now.Add(time.Hour))
+	fixture.timestamp(t, server)
+
+	result := fixture.verify(t, parseChain(t, server))
+	assert.Equal(t, model.Failed, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Contains(t, result.VerificationResult.FailureReason, "signing key certificate is not valid at signing time")
+}
+
+func TestDsseVerifier_Timestamp_UntrustedChain(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	other := tsatest.NewServer(t)
+	fixture := newTimestampFixture(t, now.Add(-time.Hour), now.Add(time.Hour))
+	fixture.timestamp(t, server)
+
+	result := fixture.verify(t, parseChain(t, other))
+	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Equal(t, model.Failed, result.VerificationResult.TimestampVerificationStatus)
+	assert.Contains(t, result.VerificationResult.FailureReason, "invalid timestamp")
+	assert.True(t, result.VerificationResult.HasFailure())
+}
+
+func TestDsseVerifier_Timestamp_TamperedToken(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	fixture := newTimestampFixture(t, now.Add(-time.Hour), now.Add(time.Hour))
+	other := newTimestampFixture(t, now.Add(-time.Hour), now.Add(time.Hour))
+	other.timestamp(t, server)
+	// A token issued for a different signature must not be accepted.
+	fixture.envelope.Signatures[0].Timestamp = other.envelope.Signatures[0].Timestamp
+
+	result := fixture.verify(t, parseChain(t, server))
+	assert.Equal(t, model.Failed, result.VerificationResult.TimestampVerificationStatus)
+	assert.Empty(t, result.VerificationResult.SignedAt)
+}
+
+func TestDsseVerifier_Timestamp_NoChainConfigured(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	fixture := newTimestampFixture(t, now.Add(-time.Hour), now.Add(time.Hour))
+	fixture.timestamp(t, server)
+
+	result := fixture.verify(t, nil)
+	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Empty(t, result.VerificationResult.TimestampVerificationStatus)
+	assert.Empty(t, result.VerificationResult.SignedAt)
+}
+
+func TestDsseVerifier_Timestamp_OfVerifiedSignatureOnly(t *testing.T) {
+	now := time.Now()
+	server := tsatest.NewServer(t)
+	server.Time = now.Add(-time.Hour).Truncate(time.Second)
+	chain := parseChain(t, server)
+	fixture := newTimestampFixture(t, now.Add(-2*time.Hour), now.Add(time.Hour))
+	other := newTimestampFixture(t, now.Add(-2*time.Hour), now.Add(time.Hour))
+	other.timestamp(t, server)
+
+	// A timestamp on a signature made with an untrusted key says nothing about when the trusted key signed.
+	fixture.envelope.Signatures = append([]dsse.Signature{other.envelope.Signatures[0]}, fixture.envelope.Signatures...)
+	result := fixture.verify(t, chain)
+	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
+	assert.Empty(t, result.VerificationResult.TimestampVerificationStatus)
+	assert.Empty(t, result.VerificationResult.SignedAt)
+
+	signature, err := base64.StdEncoding.DecodeString(fixture.envelope.Signatures[1].Sig)
+	require.NoError(t, err)
+	response, err := tsa.RequestTimestamp(server.URL, signature)
+	require.NoError(t, err)
+	fixture.envelope.Signatures[1].Timestamp = base64.StdEncoding.EncodeToString(response)
+	result = fixture.verify(t, chain)
+	assert.Equal(t, model.Success, result.VerificationResult.TimestampVerificationStatus)
+	assert.Equal(t, server.Time.UTC().Format(time.RFC3339), result.VerificationResult.SignedAt)
+}
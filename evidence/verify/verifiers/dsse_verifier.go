@@ -1,18 +1,24 @@
 package verifiers
 
 import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence/cryptox"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/revocation"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	clientLog "github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 const localKeySource = "User Provided Key"
-const artifactoryKeySource = "Artifactory Key"
 
 type dsseVerifierInterface interface {
 	verify(evidence *model.SearchEvidenceEdge, result *model.EvidenceVerification) error
@@ -23,6 +29,12 @@ type dsseVerifier struct {
 	useArtifactoryKeys bool
 	localKeys          []dsse.Verifier
 	attachmentVerifier attachmentVerifierInterface
+	// tsaChain is the trusted timestamp authority chain. Timestamps are not verified when it is nil.
+	tsaChain *tsa.CertChain
+	// keyCertificates maps the fingerprint of a local key provided as an X.509 certificate to that certificate.
+	keyCertificates map[string]*x509.Certificate
+	// revocations lists revoked signing keys. No key is considered revoked when it is nil.
+	revocations *revocation.List
 }
 
 func newDsseVerifier(keys []string, useArtifactoryKeys bool, client *artifactory.ArtifactoryServicesManager) dsseVerifierInterface {
@@ -41,22 +53,26 @@ func (v *dsseVerifier) verify(evidence *model.SearchEvidenceEdge, result *model.
 	if err != nil && v.keys != nil && len(v.keys) > 0 {
 		return err
 	}
-	signatureVerified := false
-	if len(localVerifiers) > 0 && verifyEnvelope(localVerifiers, result.DsseEnvelope, result) {
-		result.VerificationResult.KeySource = localKeySource
-		signatureVerified = true
+	var signature *dsse.Signature
+	if len(localVerifiers) > 0 {
+		if signature = verifyEnvelope(localVerifiers, result.DsseEnvelope, result); signature != nil {
+			result.VerificationResult.KeySource = localKeySource
+		}
 	}
 
-	if !signatureVerified && v.useArtifactoryKeys {
+	if signature == nil && v.useArtifactoryKeys {
 		artifactoryVerifiers, err := getArtifactoryVerifiers(evidence)
 		if err != nil {
 			return err
 		}
-		if verifyEnvelope(artifactoryVerifiers, result.DsseEnvelope, result) {
-			result.VerificationResult.KeySource = artifactoryKeySource
+		if signature = verifyEnvelope(artifactoryVerifiers, result.DsseEnvelope, result); signature != nil {
+			result.VerificationResult.KeySource = model.ArtifactoryKeySource
 		}
 	}
 
+	if signature != nil {
+		v.verifySigningTime(result, signature)
+	}
 	return v.attachmentVerifier.verify(evidence, result)
 }
 
@@ -73,7 +89,7 @@ func (v *dsseVerifier) getLocalVerifiers() ([]dsse.Verifier, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to read key %s: %w", keyPath, err)
 		}
-		loadedKey, err := cryptox.ReadPublicKey(keyFile)
+		loadedKey, certificate, err := readLocalKey(keyFile)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load key %s: %w", keyPath, err)
 		}
@@ -84,24 +100,129 @@ func (v *dsseVerifier) getLocalVerifiers() ([]dsse.Verifier, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to create verifier for key %s: %w", keyPath, err)
 		}
+		if certificate != nil {
+			if err = v.addKeyCertificate(certificate); err != nil {
+				return nil, fmt.Errorf("failed to load key %s: %w", keyPath, err)
+			}
+		}
 		keys = append(keys, verifier...)
 	}
 	v.localKeys = keys
 	return keys, nil
 }
 
-// verifyEnvelope returns true if verification succeeded, false otherwise. Uses pointer for result.
-func verifyEnvelope(verifiers []dsse.Verifier, envelope *dsse.Envelope, result *model.EvidenceVerification) bool {
+// readLocalKey loads a public key, either from a PEM public key or from the PEM X.509 certificate
+// carrying it. The certificate is returned so the key validity period can be checked.
+func readLocalKey(keyFile []byte) (*cryptox.SSLibKey, *x509.Certificate, error) {
+	block, _ := pem.Decode(keyFile)
+	if block == nil || block.Type != "CERTIFICATE" {
+		key, err := cryptox.ReadPublicKey(keyFile)
+		return key, nil, err
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(certificate.PublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := cryptox.ReadPublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}))
+	return key, certificate, err
+}
+
+func (v *dsseVerifier) addKeyCertificate(certificate *x509.Certificate) error {
+	fingerprint, err := cryptox.GenerateFingerprint(certificate.PublicKey)
+	if err != nil {
+		return err
+	}
+	if v.keyCertificates == nil {
+		v.keyCertificates = map[string]*x509.Certificate{}
+	}
+	v.keyCertificates[fingerprint] = certificate
+	return nil
+}
+
+// verifySigningTime validates the RFC 3161 timestamp of the verified signature, if present and a TSA chain is
+// configured, and checks that the signing key was valid and not revoked at the timestamped time, or at the current
+// time otherwise.
+func (v *dsseVerifier) verifySigningTime(result *model.EvidenceVerification, signature *dsse.Signature) {
+	signingTime := time.Now()
+	if timestampedAt, ok := v.verifyTimestamp(result, signature); ok {
+		signingTime = timestampedAt
+	} else if result.VerificationResult.TimestampVerificationStatus == model.Failed {
+		return
+	}
+	if certificate := v.keyCertificates[result.VerificationResult.KeyFingerprint]; certificate != nil {
+		if signingTime.Before(certificate.NotBefore) || signingTime.After(certificate.NotAfter) {
+			failSignature(result, fmt.Sprintf("signing key certificate is not valid at signing time %s (valid from %s to %s)",
+				signingTime.UTC().Format(time.RFC3339), certificate.NotBefore.UTC().Format(time.RFC3339), certificate.NotAfter.UTC().Format(time.RFC3339)))
+			return
+		}
+	}
+	if result.VerificationResult.SignedAt == "" {
+		// Without a trusted timestamp the signature was made no later than the evidence was uploaded.
+		if uploadedAt, err := utils.ParseTimestamp(result.CreatedAt); err == nil {
+			signingTime = uploadedAt
+		}
+	}
+	if revoked := v.revocations.Find(result.VerificationResult.KeyFingerprint, result.VerificationResult.KeyId, signingTime); revoked != nil {
+		failSignature(result, revoked.Describe())
+	}
+}
+
+func failSignature(result *model.EvidenceVerification, reason string) {
+	result.VerificationResult.SignaturesVerificationStatus = model.Failed
+	result.VerificationResult.FailureReason = reason
+}
+
+// verifyTimestamp returns the timestamped signing time if the verified signature carries a valid timestamp.
+// Timestamps of the other signatures of the envelope are ignored, as they do not vouch for the verified key.
+func (v *dsseVerifier) verifyTimestamp(result *model.EvidenceVerification, signature *dsse.Signature) (time.Time, bool) {
+	if signature.Timestamp == "" {
+		return time.Time{}, false
+	}
+	if v.tsaChain == nil {
+		clientLog.Debug("Evidence signature is timestamped but no TSA certificate chain is configured, skipping timestamp verification")
+		return time.Time{}, false
+	}
+	signedAt, err := verifySignatureTimestamp(signature, v.tsaChain)
+	if err != nil {
+		result.VerificationResult.TimestampVerificationStatus = model.Failed
+		result.VerificationResult.FailureReason = err.Error()
+		return time.Time{}, false
+	}
+	result.VerificationResult.TimestampVerificationStatus = model.Success
+	result.VerificationResult.SignedAt = signedAt.UTC().Format(time.RFC3339)
+	return signedAt, true
+}
+
+func verifySignatureTimestamp(signature *dsse.Signature, chain *tsa.CertChain) (time.Time, error) {
+	timestampResponse, err := base64.StdEncoding.DecodeString(signature.Timestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp encoding: %w", err)
+	}
+	signatureBytes, err := base64.StdEncoding.DecodeString(signature.Sig)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	return tsa.Verify(timestampResponse, signatureBytes, chain)
+}
+
+// verifyEnvelope returns the envelope signature one of the verifiers verified, or nil if none did.
+// Each signature is verified on its own, so an envelope signed by several keys verifies with any of them.
+func verifyEnvelope(verifiers []dsse.Verifier, envelope *dsse.Envelope, result *model.EvidenceVerification) *dsse.Signature {
 	// formal check for empty result
 	if result == nil {
-		return false
+		return nil
 	}
 	if verifiers == nil || envelope == nil {
 		result.VerificationResult.SignaturesVerificationStatus = model.Failed
-		return false
+		return nil
 	}
 	for _, verifier := range verifiers {
-		if err := envelope.Verify(verifier); err == nil {
+		index := verifiedSignatureIndex(verifier, envelope)
+		if index >= 0 {
 			result.VerificationResult.SignaturesVerificationStatus = model.Success
 			fingerprint, err := cryptox.GenerateFingerprint(verifier.Public())
 			if err != nil {
@@ -109,11 +230,25 @@ func verifyEnvelope(verifiers []dsse.Verifier, envelope *dsse.Envelope, result *
 			} else {
 				result.VerificationResult.KeyFingerprint = fingerprint
 			}
-			return true
+			if keyId, err := verifier.KeyID(); err == nil {
+				result.VerificationResult.KeyId = keyId
+			}
+			return &envelope.Signatures[index]
 		}
 	}
 	result.VerificationResult.SignaturesVerificationStatus = model.Failed
-	return false
+	return nil
+}
+
+// verifiedSignatureIndex returns the index of the envelope signature the verifier verifies, or -1.
+func verifiedSignatureIndex(verifier dsse.Verifier, envelope *dsse.Envelope) int {
+	for i := range envelope.Signatures {
+		single := dsse.Envelope{Payload: envelope.Payload, PayloadType: envelope.PayloadType, Signatures: envelope.Signatures[i : i+1]}
+		if single.Verify(verifier) == nil {
+			return i
+		}
+	}
+	return -1
 }
 
 func getArtifactoryVerifiers(evidence *model.SearchEvidenceEdge) ([]dsse.Verifier, error) {
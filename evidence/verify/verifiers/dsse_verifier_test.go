@@ -311,8 +311,8 @@ func TestVerifyEnvelope_Success(t *testing.T) {
 		VerificationResult: model.EvidenceVerificationResult{},
 	}
 
-	success := verifyEnvelope([]dsse.Verifier{mockVerifier}, &envelope, result)
-	assert.True(t, success)
+	signature := verifyEnvelope([]dsse.Verifier{mockVerifier}, &envelope, result)
+	assert.Equal(t, &envelope.Signatures[0], signature)
 	assert.Equal(t, model.Success, result.VerificationResult.SignaturesVerificationStatus)
 }
 
@@ -328,8 +328,8 @@ func TestVerifyEnvelope_Failed(t *testing.T) {
 		VerificationResult: model.EvidenceVerificationResult{},
 	}
 
-	success := verifyEnvelope([]dsse.Verifier{mockVerifier}, &envelope, result)
-	assert.False(t, success)
+	signature := verifyEnvelope([]dsse.Verifier{mockVerifier}, &envelope, result)
+	assert.Nil(t, signature)
 	assert.Equal(t, model.Failed, result.VerificationResult.SignaturesVerificationStatus)
 }
 
@@ -338,8 +338,8 @@ func TestVerifyEnvelope_NilInputs(t *testing.T) {
 		VerificationResult: model.EvidenceVerificationResult{},
 	}
 
-	success := verifyEnvelope(nil, nil, result)
-	assert.False(t, success)
+	signature := verifyEnvelope(nil, nil, result)
+	assert.Nil(t, signature)
 	assert.Equal(t, model.Failed, result.VerificationResult.SignaturesVerificationStatus)
 }
 
@@ -349,8 +349,8 @@ func TestVerifyEnvelope_NilResult(t *testing.T) {
 
 	envelope := createMockDsseEnvelope()
 
-	success := verifyEnvelope([]dsse.Verifier{mockVerifier}, &envelope, nil)
-	assert.False(t, success)
+	signature := verifyEnvelope([]dsse.Verifier{mockVerifier}, &envelope, nil)
+	assert.Nil(t, signature)
 }
 
 func TestGetArtifactoryVerifiers_NilEvidence(t *testing.T) {
@@ -2,12 +2,19 @@ package verifiers
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/predschema"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/assertions"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/revocation"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
 )
 
+const subjectContentMismatchReason = "subject content does not match the sha256 reported by Artifactory"
+
 type EvidenceVerifierInterface interface {
 	Verify(subjectSha256 string, evidenceMetadata *[]model.SearchEvidenceEdge, subjectPath string) (*model.VerificationResponse, error)
 }
@@ -15,21 +22,94 @@ type EvidenceVerifierInterface interface {
 type evidenceVerifier struct {
 	keys               []string
 	useArtifactoryKeys bool
+	deep               bool
+	artifactoryClient  artifactory.ArtifactoryServicesManager
 	parser             evidenceParserInterface
 	dsseVerifier       dsseVerifierInterface
 	sigstoreVerifier   sigstoreVerifierInterface
+	assertionVerifier  assertionVerifierInterface
+	schemaVerifier     schemaVerifierInterface
+	expiryVerifier     expiryVerifierInterface
 	progressMgr        ioUtils.ProgressMgr
 }
 
-func NewEvidenceVerifier(keys []string, useArtifactoryKeys bool, client *artifactory.ArtifactoryServicesManager, progressMgr ioUtils.ProgressMgr) EvidenceVerifierInterface {
-	return &evidenceVerifier{
+// EvidenceVerifierOption customizes optional checks performed by the evidence verifier.
+type EvidenceVerifierOption func(*evidenceVerifier)
+
+// WithAssertions evaluates the given CEL assertions against the statement of every evidence of a matching predicate type.
+func WithAssertions(a *assertions.Assertions) EvidenceVerifierOption {
+	return func(v *evidenceVerifier) {
+		if a != nil {
+			v.assertionVerifier = newAssertionVerifier(a)
+		}
+	}
+}
+
+// WithPredicateSchemas validates the predicate of every evidence against the JSON Schema registered for its predicate type.
+func WithPredicateSchemas(registry *predschema.Registry) EvidenceVerifierOption {
+	return func(v *evidenceVerifier) {
+		if registry != nil {
+			v.schemaVerifier = newSchemaVerifier(registry)
+		}
+	}
+}
+
+// WithTimestampVerification validates RFC 3161 signature timestamps against the given TSA chain
+// and checks signing keys at the timestamped time.
+func WithTimestampVerification(chain *tsa.CertChain) EvidenceVerifierOption {
+	return func(v *evidenceVerifier) {
+		if d, ok := v.dsseVerifier.(*dsseVerifier); ok && chain != nil {
+			d.tsaChain = chain
+		}
+	}
+}
+
+// WithRevocations rejects DSSE signatures made by revoked keys after their revocation time.
+func WithRevocations(revocations *revocation.List) EvidenceVerifierOption {
+	return func(v *evidenceVerifier) {
+		if d, ok := v.dsseVerifier.(*dsseVerifier); ok && revocations != nil {
+			d.revocations = revocations
+		}
+	}
+}
+
+// WithAsOf evaluates evidence expiry at the given point in time instead of now.
+func WithAsOf(asOf time.Time) EvidenceVerifierOption {
+	return func(v *evidenceVerifier) {
+		if !asOf.IsZero() {
+			v.expiryVerifier = &expiryVerifier{asOf: asOf}
+		}
+	}
+}
+
+// WithDeepVerification downloads the subject and every attachment and compares the sha256 computed from
+// their content, instead of trusting the checksums Artifactory reports.
+func WithDeepVerification() EvidenceVerifierOption {
+	return func(v *evidenceVerifier) {
+		v.deep = true
+		if d, ok := v.dsseVerifier.(*dsseVerifier); ok {
+			if a, ok := d.attachmentVerifier.(*attachmentVerifier); ok {
+				a.deep = true
+			}
+		}
+	}
+}
+
+func NewEvidenceVerifier(keys []string, useArtifactoryKeys bool, client *artifactory.ArtifactoryServicesManager, progressMgr ioUtils.ProgressMgr, opts ...EvidenceVerifierOption) EvidenceVerifierInterface {
+	v := &evidenceVerifier{
 		keys:               keys,
 		useArtifactoryKeys: useArtifactoryKeys,
+		artifactoryClient:  *client,
 		parser:             newEvidenceParser(client, progressMgr),
 		dsseVerifier:       newDsseVerifier(keys, useArtifactoryKeys, client),
 		sigstoreVerifier:   newSigstoreVerifier(),
+		expiryVerifier:     newExpiryVerifier(),
 		progressMgr:        progressMgr,
 	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
 }
 
 func (v *evidenceVerifier) Verify(subjectSha256 string, evidenceMetadata *[]model.SearchEvidenceEdge, subjectPath string) (*model.VerificationResponse, error) {
@@ -49,6 +129,20 @@ func (v *evidenceVerifier) Verify(subjectSha256 string, evidenceMetadata *[]mode
 		},
 		OverallVerificationStatus: model.Success,
 	}
+	if v.deep {
+		computedSha256, err := computeRemoteSha256(v.artifactoryClient, subjectPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash subject: %w", err)
+		}
+		// Evidence subjects are compared with the downloaded content rather than the reported checksum.
+		verificationResponse.Subject.ComputedSha256 = computedSha256
+		verificationResponse.SubjectContentVerificationStatus = verifyChecksum(computedSha256, subjectSha256)
+		if verificationResponse.SubjectContentVerificationStatus == model.Failed {
+			verificationResponse.OverallVerificationStatus = model.Failed
+		}
+		subjectSha256 = computedSha256
+	}
+	subjectContentMismatch := verificationResponse.SubjectContentVerificationStatus == model.Failed
 	evidenceVerifications := make([]model.EvidenceVerification, 0, evidenceNumber)
 	for i := range *evidenceMetadata {
 		evidence := &(*evidenceMetadata)[i]
@@ -56,6 +150,11 @@ func (v *evidenceVerifier) Verify(subjectSha256 string, evidenceMetadata *[]mode
 		if err != nil {
 			return nil, err
 		}
+		// Evidence created for the reported sha256 fails against the downloaded content; explain why.
+		if subjectContentMismatch && verification.VerificationResult.Sha256VerificationStatus == model.Failed &&
+			verification.VerificationResult.FailureReason == "" {
+			verification.VerificationResult.FailureReason = subjectContentMismatchReason
+		}
 		evidenceVerifications = append(evidenceVerifications, *verification)
 		if shouldFailOverall(verification) {
 			verificationResponse.OverallVerificationStatus = model.Failed
@@ -75,6 +174,7 @@ func (v *evidenceVerifier) verifyEvidence(evidence *model.SearchEvidenceEdge, su
 		PredicateType:      evidence.Node.PredicateType,
 		CreatedBy:          evidence.Node.CreatedBy,
 		CreatedAt:          evidence.Node.CreatedAt,
+		SigningKeyAlias:    evidence.Node.SigningKey.Alias,
 		VerificationResult: model.EvidenceVerificationResult{},
 	}
 	evidenceVerification.VerificationResult.Sha256VerificationStatus = verifyChecksum(subjectSha256, evidence.Node.Subject.Sha256)
@@ -84,6 +184,21 @@ func (v *evidenceVerifier) verifyEvidence(evidence *model.SearchEvidenceEdge, su
 	if err := v.performVerification(evidence, evidenceVerification); err != nil {
 		return nil, err
 	}
+	if v.expiryVerifier != nil {
+		if err := v.expiryVerifier.verify(evidenceVerification); err != nil {
+			return nil, fmt.Errorf("failed to check evidence expiry: %w", err)
+		}
+	}
+	if v.assertionVerifier != nil {
+		if err := v.assertionVerifier.verify(evidenceVerification); err != nil {
+			return nil, fmt.Errorf("failed to evaluate assertions: %w", err)
+		}
+	}
+	if v.schemaVerifier != nil {
+		if err := v.schemaVerifier.verify(evidenceVerification); err != nil {
+			return nil, fmt.Errorf("failed to validate predicate schema: %w", err)
+		}
+	}
 	return evidenceVerification, nil
 }
 
@@ -99,10 +214,7 @@ func (v *evidenceVerifier) performVerification(evidence *model.SearchEvidenceEdg
 }
 
 func shouldFailOverall(verification *model.EvidenceVerification) bool {
-	return verification.VerificationResult.SignaturesVerificationStatus == model.Failed ||
-		verification.VerificationResult.Sha256VerificationStatus == model.Failed ||
-		verification.VerificationResult.SigstoreBundleVerificationStatus == model.Failed ||
-		verification.VerificationResult.AttachmentsVerificationStatus == model.Failed
+	return verification.VerificationResult.HasFailure()
 }
 
 func verifyChecksum(subjectSha256, evidenceChecksum string) model.VerificationStatus {
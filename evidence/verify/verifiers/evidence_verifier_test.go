@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/assertions"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	artUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
@@ -517,3 +518,24 @@ func createDsseEnvelopeWithAttachmentMeta(t *testing.T, sha256 string) []byte {
 	envelope := `{"payload":"` + base64.StdEncoding.EncodeToString([]byte(payload)) + `","payloadType":"application/vnd.in-toto+json","signatures":[{"keyid":"k","sig":"dGVzdA=="}]}`
 	return []byte(envelope)
 }
+
+func TestVerify_WithAssertions(t *testing.T) {
+	evidence := createTestEvidenceWithKeys()
+	mockClient := &MockArtifactoryServicesManagerVerifier{
+		ReadRemoteFileFunc: func() io.ReadCloser {
+			return io.NopCloser(bytes.NewReader(createMockDsseEnvelopeBytes(t)))
+		},
+	}
+	var clientInterface artifactory.ArtifactoryServicesManager = mockClient
+	a, err := assertions.Compile(map[string][]string{"test-predicate": {`statement.test == "other"`}})
+	assert.NoError(t, err)
+	verifier := NewEvidenceVerifier(nil, false, &clientInterface, nil, WithAssertions(a))
+
+	result, err := verifier.Verify(createTestSHA256(), evidence, "/path/to/file")
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.Failed, result.OverallVerificationStatus)
+	verification := (*result.EvidenceVerifications)[0]
+	assert.Equal(t, model.Failed, verification.VerificationResult.AssertionsVerificationStatus)
+	assert.Equal(t, []model.AssertionResult{{Expression: `statement.test == "other"`, Status: model.Failed}}, verification.VerificationResult.AssertionResults)
+}
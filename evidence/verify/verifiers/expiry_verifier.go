@@ -0,0 +1,67 @@
+package verifiers
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+type expiryVerifierInterface interface {
+	verify(result *model.EvidenceVerification) error
+}
+
+type expiryVerifier struct {
+	// asOf is the time validity is evaluated at. The current time is used when it is zero.
+	asOf time.Time
+}
+
+func newExpiryVerifier() *expiryVerifier {
+	return &expiryVerifier{}
+}
+
+// statementValidity holds the statement fields describing the evidence validity period.
+type statementValidity struct {
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// verify checks the expiresAt recorded in the evidence statement. Evidence without an expiry is left untouched.
+func (v *expiryVerifier) verify(result *model.EvidenceVerification) error {
+	if result == nil {
+		return nil
+	}
+	// An unreadable statement is already reported by the signature verification.
+	statement, err := result.StatementPayload()
+	if err != nil {
+		return nil
+	}
+	validity := statementValidity{}
+	if err = json.Unmarshal(statement, &validity); err != nil || validity.ExpiresAt == "" {
+		return nil
+	}
+	result.VerificationResult.ExpiresAt = validity.ExpiresAt
+	expiresAt, err := utils.ParseTimestamp(validity.ExpiresAt)
+	if err != nil {
+		v.fail(result, fmt.Sprintf("invalid evidence expiry '%s'", validity.ExpiresAt))
+		return nil
+	}
+	asOf := v.asOf
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+	if !asOf.Before(expiresAt) {
+		v.fail(result, fmt.Sprintf("evidence expired at %s", validity.ExpiresAt))
+		return nil
+	}
+	result.VerificationResult.ExpiryVerificationStatus = model.Success
+	return nil
+}
+
+func (v *expiryVerifier) fail(result *model.EvidenceVerification, reason string) {
+	result.VerificationResult.ExpiryVerificationStatus = model.Failed
+	if result.VerificationResult.FailureReason == "" {
+		result.VerificationResult.FailureReason = reason
+	}
+}
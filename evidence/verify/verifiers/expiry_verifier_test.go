@@ -0,0 +1,66 @@
+package verifiers
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func verificationWithStatement(statement string) *model.EvidenceVerification {
+	return &model.EvidenceVerification{
+		MediaType:    model.SimpleDSSE,
+		DsseEnvelope: &dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(statement))},
+	}
+}
+
+func TestExpiryVerifier_NotExpired(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	result := verificationWithStatement(`{"createdAt":"2025-01-01T00:00:00.000Z","expiresAt":"` + expiresAt + `"}`)
+
+	assert.NoError(t, newExpiryVerifier().verify(result))
+	assert.Equal(t, model.Success, result.VerificationResult.ExpiryVerificationStatus)
+	assert.Equal(t, expiresAt, result.VerificationResult.ExpiresAt)
+	assert.False(t, result.VerificationResult.HasFailure())
+}
+
+func TestExpiryVerifier_Expired(t *testing.T) {
+	result := verificationWithStatement(`{"expiresAt":"2025-04-01T00:00:00.000Z"}`)
+
+	assert.NoError(t, newExpiryVerifier().verify(result))
+	assert.Equal(t, model.Failed, result.VerificationResult.ExpiryVerificationStatus)
+	assert.Equal(t, "evidence expired at 2025-04-01T00:00:00.000Z", result.VerificationResult.FailureReason)
+	assert.True(t, result.VerificationResult.HasFailure())
+}
+
+func TestExpiryVerifier_AsOf(t *testing.T) {
+	verifier := &expiryVerifier{asOf: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}
+	result := verificationWithStatement(`{"expiresAt":"2025-04-01T00:00:00.000Z"}`)
+	assert.NoError(t, verifier.verify(result))
+	assert.Equal(t, model.Success, result.VerificationResult.ExpiryVerificationStatus)
+
+	verifier.asOf = time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	result = verificationWithStatement(`{"expiresAt":"2025-04-01T00:00:00.000Z"}`)
+	assert.NoError(t, verifier.verify(result))
+	assert.Equal(t, model.Failed, result.VerificationResult.ExpiryVerificationStatus)
+}
+
+func TestExpiryVerifier_NoExpiry(t *testing.T) {
+	result := verificationWithStatement(`{"createdAt":"2025-01-01T00:00:00.000Z"}`)
+	assert.NoError(t, newExpiryVerifier().verify(result))
+	assert.Empty(t, result.VerificationResult.ExpiryVerificationStatus)
+
+	result = &model.EvidenceVerification{MediaType: model.SimpleDSSE}
+	assert.NoError(t, newExpiryVerifier().verify(result))
+	assert.Empty(t, result.VerificationResult.ExpiryVerificationStatus)
+}
+
+func TestExpiryVerifier_InvalidExpiry(t *testing.T) {
+	result := verificationWithStatement(`{"expiresAt":"next quarter"}`)
+	assert.NoError(t, newExpiryVerifier().verify(result))
+	assert.Equal(t, model.Failed, result.VerificationResult.ExpiryVerificationStatus)
+	assert.Contains(t, result.VerificationResult.FailureReason, "invalid evidence expiry")
+}
@@ -0,0 +1,62 @@
+package verifiers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/predschema"
+)
+
+const schemaFailedReason = "predicate does not match schema"
+
+type schemaVerifierInterface interface {
+	verify(result *model.EvidenceVerification) error
+}
+
+type schemaVerifier struct {
+	registry *predschema.Registry
+}
+
+func newSchemaVerifier(registry *predschema.Registry) schemaVerifierInterface {
+	return &schemaVerifier{registry: registry}
+}
+
+// verify validates the evidence predicate against the schema registered for its predicate type.
+// Evidence without a registered schema is left untouched.
+func (v *schemaVerifier) verify(result *model.EvidenceVerification) error {
+	if result == nil {
+		return nil
+	}
+	schema, err := v.registry.Lookup(result.PredicateType)
+	if err != nil || schema == nil {
+		return err
+	}
+	statementJson, err := result.StatementPayload()
+	if err != nil {
+		return err
+	}
+	var statement struct {
+		Predicate json.RawMessage `json:"predicate"`
+	}
+	if err = json.Unmarshal(statementJson, &statement); err != nil {
+		return fmt.Errorf("failed to parse statement: %w", err)
+	}
+	var validationError *predschema.ValidationError
+	switch err = schema.Validate(statement.Predicate); {
+	case err == nil:
+		result.VerificationResult.SchemaVerificationStatus = model.Success
+	case errors.As(err, &validationError):
+		result.VerificationResult.SchemaVerificationStatus = model.Failed
+		for _, violation := range validationError.Violations {
+			result.VerificationResult.SchemaViolations = append(result.VerificationResult.SchemaViolations, violation.String())
+		}
+		if result.VerificationResult.FailureReason == "" {
+			result.VerificationResult.FailureReason = fmt.Sprintf("%s %s", schemaFailedReason, validationError.Schema)
+		}
+	default:
+		return err
+	}
+	return nil
+}
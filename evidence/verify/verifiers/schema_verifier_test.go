@@ -0,0 +1,59 @@
+package verifiers
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/predschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSchemaVerifier(t *testing.T, schema string) schemaVerifierInterface {
+	path := filepath.Join(t.TempDir(), "sonar.schema.json")
+	require.NoError(t, os.WriteFile(path, []byte(schema), 0644))
+	return newSchemaVerifier(predschema.NewRegistry(map[string]string{"sonar": path}))
+}
+
+func TestSchemaVerifier_Valid(t *testing.T) {
+	result := &model.EvidenceVerification{
+		PredicateType: "sonar",
+		DsseEnvelope:  &dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(testStatement))},
+	}
+	err := newTestSchemaVerifier(t, `{"required":["gates"]}`).verify(result)
+	require.NoError(t, err)
+	assert.Equal(t, model.Success, result.VerificationResult.SchemaVerificationStatus)
+	assert.Empty(t, result.VerificationResult.SchemaViolations)
+	assert.False(t, result.VerificationResult.HasFailure())
+}
+
+func TestSchemaVerifier_Violations(t *testing.T) {
+	result := &model.EvidenceVerification{
+		PredicateType: "sonar",
+		DsseEnvelope:  &dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(testStatement))},
+	}
+	schema := `{"properties":{"gates":{"items":{"properties":{"status":{"enum":["PASSED"]}}}}}}`
+	err := newTestSchemaVerifier(t, schema).verify(result)
+	require.NoError(t, err)
+	assert.Equal(t, model.Failed, result.VerificationResult.SchemaVerificationStatus)
+	require.Len(t, result.VerificationResult.SchemaViolations, 1)
+	assert.Contains(t, result.VerificationResult.SchemaViolations[0], "/gates/0/status: ")
+	assert.Equal(t, "predicate does not match schema sonar.schema.json", result.VerificationResult.FailureReason)
+	assert.True(t, result.VerificationResult.HasFailure())
+}
+
+func TestSchemaVerifier_OtherPredicateType(t *testing.T) {
+	result := &model.EvidenceVerification{PredicateType: "other"}
+	require.NoError(t, newTestSchemaVerifier(t, `false`).verify(result))
+	assert.Empty(t, result.VerificationResult.SchemaVerificationStatus)
+}
+
+func TestSchemaVerifier_NoStatement(t *testing.T) {
+	result := &model.EvidenceVerification{PredicateType: "sonar"}
+	err := newTestSchemaVerifier(t, `{}`).verify(result)
+	assert.ErrorContains(t, err, "no statement available")
+}
@@ -4,14 +4,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	coreProgress "github.com/jfrog/jfrog-cli-core/v2/common/progressbar"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	evdConfig "github.com/jfrog/jfrog-cli-evidence/evidence/config"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/predschema"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/tsa"
 	evidenceutils "github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/assertions"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/policy"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/reports"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/revocation"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/verifiers"
 
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -32,6 +39,15 @@ type verifyEvidenceBase struct {
 	oneModelClient     onemodel.Manager
 	verifier           verifiers.EvidenceVerifierInterface
 	progressMgr        ioUtils.ProgressMgr
+	subjectType        string
+	policyPath         string
+	policy             *policy.Policy
+	assertionsPath     string
+	tsaCertChainPath   string
+	asOf               string
+	revocationsPath    string
+	validateSchemas    bool
+	deep               bool
 }
 
 // newVerifyEvidenceBase builds a base with optional progress manager initialized.
@@ -69,6 +85,10 @@ func (v *verifyEvidenceBase) printVerifyResult(result *model.VerificationRespons
 		return reports.MarkdownReportPrinter.Print(result)
 	case "json":
 		return reports.JsonReportPrinter.Print(result)
+	case "junit":
+		return reports.JunitReportPrinter.Print(result)
+	case "html":
+		return reports.HtmlReportPrinter.Print(result)
 	default:
 		return reports.PlaintextReportPrinter.Print(result)
 	}
@@ -77,13 +97,27 @@ func (v *verifyEvidenceBase) printVerifyResult(result *model.VerificationRespons
 // verifyEvidence runs the verification process for the given evidence metadata and subject sha256.
 func (v *verifyEvidenceBase) verifyEvidence(client *artifactory.ArtifactoryServicesManager, evidenceMetadata *[]model.SearchEvidenceEdge, sha256, subjectPath string) error {
 	if v.verifier == nil {
+		verifierOptions, err := v.verifierOptions()
+		if err != nil {
+			return err
+		}
 		v.setHeadline("Verifying evidence")
-		v.verifier = verifiers.NewEvidenceVerifier(v.keys, v.useArtifactoryKeys, client, v.progressMgr)
+		v.verifier = verifiers.NewEvidenceVerifier(v.keys, v.useArtifactoryKeys, client, v.progressMgr, verifierOptions...)
 	}
 	verify, err := v.verifier.Verify(sha256, evidenceMetadata, subjectPath)
 	if err != nil {
 		return err
 	}
+	if v.asOf != "" {
+		asOf, err := v.evaluationTime()
+		if err != nil {
+			return err
+		}
+		verify.AsOf = asOf.UTC().Format(time.RFC3339)
+	}
+	if err = v.applyPolicy(verify); err != nil {
+		return err
+	}
 
 	v.quitProgress()
 	err = v.printVerifyResult(verify)
@@ -93,6 +127,83 @@ func (v *verifyEvidenceBase) verifyEvidence(client *artifactory.ArtifactoryServi
 	return err
 }
 
+// verifierOptions builds the optional checks requested for the verifier, such as predicate assertions,
+// signature timestamp verification and key revocation.
+func (v *verifyEvidenceBase) verifierOptions() ([]verifiers.EvidenceVerifierOption, error) {
+	var opts []verifiers.EvidenceVerifierOption
+	if v.assertionsPath != "" {
+		loaded, err := assertions.Load(v.assertionsPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, verifiers.WithAssertions(loaded))
+	}
+	if certChainPath := evdConfig.ResolveTSACertChain(v.tsaCertChainPath); certChainPath != "" {
+		chain, err := tsa.LoadCertChain(certChainPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, verifiers.WithTimestampVerification(chain))
+	}
+	if revocationsPath := evdConfig.ResolveRevocationsPath(v.revocationsPath); revocationsPath != "" {
+		revocations, err := revocation.Load(revocationsPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, verifiers.WithRevocations(revocations))
+	}
+	if v.asOf != "" {
+		asOf, err := v.evaluationTime()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, verifiers.WithAsOf(asOf))
+	}
+	if v.validateSchemas {
+		registry := predschema.NewRegistry(evdConfig.ResolvePredicateSchemas())
+		if registry.Len() == 0 {
+			return nil, fmt.Errorf("schema validation requires predicateSchemas in evidence.yml")
+		}
+		opts = append(opts, verifiers.WithPredicateSchemas(registry))
+	}
+	if v.deep {
+		opts = append(opts, verifiers.WithDeepVerification())
+	}
+	return opts, nil
+}
+
+// evaluationTime returns the time expiry and policy age rules are evaluated at: the --as-of time if set, otherwise now.
+func (v *verifyEvidenceBase) evaluationTime() (time.Time, error) {
+	if v.asOf == "" {
+		return time.Now(), nil
+	}
+	asOf, err := evidenceutils.ParseTimestamp(v.asOf)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid as-of value '%s': expected an RFC 3339 time or a YYYY-MM-DD date", v.asOf)
+	}
+	return asOf, nil
+}
+
+// applyPolicy evaluates the configured policy, if any, and derives the overall status from its rules.
+func (v *verifyEvidenceBase) applyPolicy(response *model.VerificationResponse) error {
+	if v.policyPath == "" {
+		return nil
+	}
+	if v.policy == nil {
+		loaded, err := policy.Load(v.policyPath)
+		if err != nil {
+			return err
+		}
+		v.policy = loaded
+	}
+	now, err := v.evaluationTime()
+	if err != nil {
+		return err
+	}
+	v.policy.Evaluate(response, v.subjectType, now)
+	return nil
+}
+
 // createArtifactoryClient creates an Artifactory client for evidence operations.
 func (v *verifyEvidenceBase) createArtifactoryClient() (*artifactory.ArtifactoryServicesManager, error) {
 	if v.artifactoryClient != nil {
@@ -189,6 +300,7 @@ func (v *verifyEvidenceBase) buildSearchEvidenceQuery(includeAttachments bool) s
 	).
 		WithIf(includeAttachments, evidenceutils.AttachmentsFragment).
 		WithIf(v.useArtifactoryKeys, evidenceutils.FieldSigningKeyWithPublicKey).
+		WithIf(!v.useArtifactoryKeys && v.policyPath != "", evidenceutils.FieldSigningKeyAlias).
 		Build()
 	return evidenceutils.BuildQuery(searchEvidenceQueryTemplate, nodeFields)
 }
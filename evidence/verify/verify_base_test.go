@@ -5,11 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/model"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/policy"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/reports"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/onemodel"
@@ -736,3 +739,115 @@ func TestVerifyEvidenceBase_Progress_QueryEvidenceMetadata(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, len(pm.headlines) >= 1)
 }
+
+func TestVerifyEvidenceBase_ApplyPolicy(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yml")
+	assert.NoError(t, os.WriteFile(policyPath, []byte("subjects:\n  build:\n    requiredPredicateTypes: [https://slsa.dev/provenance/v1]\n"), 0600))
+
+	v := &verifyEvidenceBase{subjectType: policy.SubjectTypeBuild}
+	v.applyOptions([]VerifyOption{WithPolicy(policyPath)})
+
+	response := &model.VerificationResponse{
+		OverallVerificationStatus: model.Success,
+		EvidenceVerifications:     &[]model.EvidenceVerification{},
+	}
+	assert.NoError(t, v.applyPolicy(response))
+	assert.Equal(t, model.Failed, response.OverallVerificationStatus)
+	if assert.NotNil(t, response.PolicyVerification) {
+		assert.Equal(t, policyPath, response.PolicyVerification.PolicyPath)
+		assert.Len(t, response.PolicyVerification.RuleResults, 1)
+	}
+}
+
+func TestVerifyEvidenceBase_ApplyPolicy_NoPolicy(t *testing.T) {
+	v := &verifyEvidenceBase{}
+	response := &model.VerificationResponse{OverallVerificationStatus: model.Failed}
+	assert.NoError(t, v.applyPolicy(response))
+	assert.Equal(t, model.Failed, response.OverallVerificationStatus)
+	assert.Nil(t, response.PolicyVerification)
+}
+
+func TestVerifyEvidenceBase_ApplyPolicy_InvalidFile(t *testing.T) {
+	v := &verifyEvidenceBase{policyPath: filepath.Join(t.TempDir(), "missing.yml")}
+	err := v.applyPolicy(&model.VerificationResponse{})
+	assert.ErrorContains(t, err, "failed to read policy file")
+}
+
+func TestVerifyEvidenceBase_ApplyPolicy_AsOf(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.yml")
+	assert.NoError(t, os.WriteFile(policyPath, []byte("predicates:\n  pentest:\n    minCount: 1\n    maxAge: 30d\n"), 0600))
+	newResponse := func() *model.VerificationResponse {
+		return &model.VerificationResponse{
+			OverallVerificationStatus: model.Success,
+			EvidenceVerifications: &[]model.EvidenceVerification{{
+				PredicateType: "pentest",
+				CreatedAt:     "2025-01-01T00:00:00.000Z",
+				VerificationResult: model.EvidenceVerificationResult{
+					Sha256VerificationStatus:     model.Success,
+					SignaturesVerificationStatus: model.Success,
+				},
+			}},
+		}
+	}
+
+	v := &verifyEvidenceBase{}
+	v.applyOptions([]VerifyOption{WithPolicy(policyPath), WithAsOf("2025-01-15")})
+	response := newResponse()
+	assert.NoError(t, v.applyPolicy(response))
+	assert.Equal(t, model.Success, response.OverallVerificationStatus)
+
+	v.asOf = ""
+	response = newResponse()
+	assert.NoError(t, v.applyPolicy(response))
+	assert.Equal(t, model.Failed, response.OverallVerificationStatus)
+
+	v.asOf = "last week"
+	assert.ErrorContains(t, v.applyPolicy(newResponse()), "invalid as-of value")
+}
+
+func TestVerifyEvidenceBase_VerifierOptions(t *testing.T) {
+	v := &verifyEvidenceBase{}
+	opts, err := v.verifierOptions()
+	assert.NoError(t, err)
+	assert.Empty(t, opts)
+
+	assertionsPath := filepath.Join(t.TempDir(), "assertions.yml")
+	assert.NoError(t, os.WriteFile(assertionsPath, []byte("sonar:\n  - predicate.gates.exists(g, g.status == \"OK\")\n"), 0600))
+	v.applyOptions([]VerifyOption{WithAssertions(assertionsPath)})
+	opts, err = v.verifierOptions()
+	assert.NoError(t, err)
+	assert.Len(t, opts, 1)
+
+	assert.NoError(t, os.WriteFile(assertionsPath, []byte("sonar:\n  - predicate.gates.exists(\n"), 0600))
+	_, err = v.verifierOptions()
+	assert.ErrorContains(t, err, "invalid assertions file")
+
+	v = &verifyEvidenceBase{}
+	v.applyOptions([]VerifyOption{WithAsOf("2025-01-15T10:00:00Z")})
+	opts, err = v.verifierOptions()
+	assert.NoError(t, err)
+	assert.Len(t, opts, 1)
+
+	revocationsPath := filepath.Join(t.TempDir(), "revocations.yml")
+	assert.NoError(t, os.WriteFile(revocationsPath, []byte("revocations:\n  - keyId: abc123\n    reason: rotated\n"), 0600))
+	v = &verifyEvidenceBase{}
+	v.applyOptions([]VerifyOption{WithRevocations(revocationsPath)})
+	opts, err = v.verifierOptions()
+	assert.NoError(t, err)
+	assert.Len(t, opts, 1)
+
+	assert.NoError(t, os.WriteFile(revocationsPath, []byte("revocations:\n  - reason: rotated\n"), 0600))
+	_, err = v.verifierOptions()
+	assert.ErrorContains(t, err, "must define a fingerprint or keyId")
+}
+
+func TestVerifyEvidenceBase_PrintVerifyResult_JUnit(t *testing.T) {
+	v := &verifyEvidenceBase{format: "junit"}
+	resp := &model.VerificationResponse{
+		Subject:                   model.Subject{Path: "repo/file"},
+		OverallVerificationStatus: model.Success,
+		EvidenceVerifications:     &[]model.EvidenceVerification{},
+	}
+	assert.NoError(t, v.printVerifyResult(resp))
+	assert.Error(t, v.printVerifyResult(nil))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/policy"
 
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
@@ -20,13 +21,16 @@ type verifyEvidenceBuild struct {
 }
 
 // NewVerifyEvidenceBuild creates a new command for verifying evidence for a build.
-func NewVerifyEvidenceBuild(serverDetails *config.ServerDetails, project, buildName, buildNumber, format string, keys []string, useArtifactoryKeys bool) evidence.Command {
-	return &verifyEvidenceBuild{
+func NewVerifyEvidenceBuild(serverDetails *config.ServerDetails, project, buildName, buildNumber, format string, keys []string, useArtifactoryKeys bool, opts ...VerifyOption) evidence.Command {
+	cmd := &verifyEvidenceBuild{
 		verifyEvidenceBase: newVerifyEvidenceBase(serverDetails, format, keys, useArtifactoryKeys),
 		project:            project,
 		buildName:          buildName,
 		buildNumber:        buildNumber,
 	}
+	cmd.subjectType = policy.SubjectTypeBuild
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 // Run executes the build evidence verification command.
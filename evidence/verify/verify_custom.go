@@ -5,7 +5,9 @@ import (
 	"strings"
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/create/resolvers"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/policy"
 
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
@@ -17,20 +19,30 @@ const optionalPathTemplate = "\"path\": \"%s\","
 type verifyEvidenceCustom struct {
 	verifyEvidenceBase
 	subjectRepoPath string
+	lookup          resolvers.SubjectLookup
 }
 
 // NewVerifyEvidenceCustom creates a new command for verifying evidence for a custom subject path.
-func NewVerifyEvidenceCustom(serverDetails *config.ServerDetails, subjectRepoPath, format string, keys []string, useArtifactoryKeys bool) evidence.Command {
-	return &verifyEvidenceCustom{
+func NewVerifyEvidenceCustom(serverDetails *config.ServerDetails, subjectRepoPath, format string, keys []string, useArtifactoryKeys bool, opts ...VerifyOption) evidence.Command {
+	cmd := &verifyEvidenceCustom{
 		verifyEvidenceBase: newVerifyEvidenceBase(serverDetails, format, keys, useArtifactoryKeys),
 		subjectRepoPath:    subjectRepoPath,
+		lookup:             resolvers.DefaultSubjectLookup{ServerDetails: serverDetails},
 	}
+	cmd.subjectType = policy.SubjectTypeArtifact
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 // Run executes the custom evidence verification command.
 func (v *verifyEvidenceCustom) Run() error {
 	defer v.quitProgress()
 
+	if resolvers.IsPackageURL(v.subjectRepoPath) {
+		if err := v.resolvePackageURL(); err != nil {
+			return err
+		}
+	}
 	repo, path, name, err := extractSubjectRepoPathName(v)
 	if err != nil {
 		return err
@@ -56,6 +68,28 @@ func (v *verifyEvidenceCustom) Run() error {
 	return v.verifyEvidence(client, metadata, subjectSha256, v.subjectRepoPath)
 }
 
+// resolvePackageURL replaces a Package URL subject with the repository path of the package version lead file.
+func (v *verifyEvidenceCustom) resolvePackageURL() error {
+	client, err := v.createArtifactoryClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Artifactory client: %w", err)
+	}
+	lookup := v.lookup
+	if lookup == nil {
+		lookup = resolvers.DefaultSubjectLookup{ServerDetails: v.serverDetails}
+	}
+	v.setHeadline("Resolving package")
+	subjects, err := lookup.ResolveSubject(v.subjectRepoPath, "", *client)
+	if err != nil {
+		return fmt.Errorf("failed to resolve subject '%s': %w", v.subjectRepoPath, err)
+	}
+	if len(subjects) != 1 {
+		return fmt.Errorf("subject '%s' resolved to %d files (%s); add a repository_url qualifier to select the repository", v.subjectRepoPath, len(subjects), strings.Join(subjects, ", "))
+	}
+	v.subjectRepoPath = subjects[0]
+	return nil
+}
+
 func extractSubjectRepoPathName(v *verifyEvidenceCustom) (string, string, string, error) {
 	split := strings.Split(v.subjectRepoPath, "/")
 	if len(split) < 2 {
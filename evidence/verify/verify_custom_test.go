@@ -374,3 +374,52 @@ func TestVerifyEvidenceCustom_Progress_Success(t *testing.T) {
 	assert.True(t, pm.quitCalled)
 	assert.True(t, len(pm.headlines) >= 1)
 }
+
+type subjectLookupFunc func(subject, checksum string, client artifactory.ArtifactoryServicesManager) ([]string, error)
+
+func (f subjectLookupFunc) ResolveSubject(subject, checksum string, client artifactory.ArtifactoryServicesManager) ([]string, error) {
+	return f(subject, checksum, client)
+}
+
+func TestVerifyEvidenceCustom_Run_PackageURLSubject(t *testing.T) {
+	newVerifier := func(resolved ...string) *verifyEvidenceCustom {
+		mockClient := &MockArtifactoryServicesManagerCustom{AqlResponse: `{"results":[]}`}
+		return &verifyEvidenceCustom{
+			verifyEvidenceBase: verifyEvidenceBase{
+				serverDetails: &config.ServerDetails{},
+				artifactoryClient: func() *artifactory.ArtifactoryServicesManager {
+					c := artifactory.ArtifactoryServicesManager(mockClient)
+					return &c
+				}(),
+			},
+			subjectRepoPath: "pkg:npm/lodash@4.17.21",
+			lookup: subjectLookupFunc(func(subject, checksum string, _ artifactory.ArtifactoryServicesManager) ([]string, error) {
+				assert.Equal(t, "pkg:npm/lodash@4.17.21", subject)
+				assert.Empty(t, checksum)
+				return resolved, nil
+			}),
+		}
+	}
+
+	t.Run("resolved to lead file", func(t *testing.T) {
+		customVerifier := newVerifier("npm-local/lodash/-/lodash-4.17.21.tgz")
+		err := customVerifier.Run()
+		assert.ErrorContains(t, err, "no subject found for npm-local/lodash/-/lodash-4.17.21.tgz")
+		assert.Equal(t, "npm-local/lodash/-/lodash-4.17.21.tgz", customVerifier.subjectRepoPath)
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		err := newVerifier("npm-local/lodash/-/lodash-4.17.21.tgz", "npm-release/lodash/-/lodash-4.17.21.tgz").Run()
+		assert.ErrorContains(t, err, "resolved to 2 files")
+		assert.ErrorContains(t, err, "repository_url")
+	})
+
+	t.Run("resolution error", func(t *testing.T) {
+		customVerifier := newVerifier()
+		customVerifier.lookup = subjectLookupFunc(func(string, string, artifactory.ArtifactoryServicesManager) ([]string, error) {
+			return nil, errors.New("no npm repository contains package lodash version 4.17.21")
+		})
+		err := customVerifier.Run()
+		assert.ErrorContains(t, err, "failed to resolve subject 'pkg:npm/lodash@4.17.21'")
+	})
+}
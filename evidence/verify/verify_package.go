@@ -7,6 +7,7 @@ import (
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/policy"
 
 	cliUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -21,11 +22,14 @@ type verifyEvidencePackage struct {
 }
 
 // NewVerifyEvidencePackage creates a new command for verifying evidence for a package.
-func NewVerifyEvidencePackage(serverDetails *config.ServerDetails, format, packageName, packageVersion, packageRepoName string, keys []string, useArtifactoryKeys bool) evidence.Command {
-	return &verifyEvidencePackage{
+func NewVerifyEvidencePackage(serverDetails *config.ServerDetails, format, packageName, packageVersion, packageRepoName string, keys []string, useArtifactoryKeys bool, opts ...VerifyOption) evidence.Command {
+	cmd := &verifyEvidencePackage{
 		verifyEvidenceBase: newVerifyEvidenceBase(serverDetails, format, keys, useArtifactoryKeys),
 		packageService:     evidence.NewPackageService(packageName, packageVersion, packageRepoName),
 	}
+	cmd.subjectType = policy.SubjectTypePackage
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 // CommandName returns the command name for package evidence verification.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/jfrog/jfrog-cli-evidence/evidence"
 	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+	"github.com/jfrog/jfrog-cli-evidence/evidence/verify/policy"
 
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
@@ -20,13 +21,16 @@ type verifyEvidenceReleaseBundle struct {
 }
 
 // NewVerifyEvidenceReleaseBundle creates a new command for verifying evidence for a release bundle.
-func NewVerifyEvidenceReleaseBundle(serverDetails *config.ServerDetails, format, project, releaseBundle, releaseBundleVersion string, keys []string, useArtifactoryKeys bool) evidence.Command {
-	return &verifyEvidenceReleaseBundle{
+func NewVerifyEvidenceReleaseBundle(serverDetails *config.ServerDetails, format, project, releaseBundle, releaseBundleVersion string, keys []string, useArtifactoryKeys bool, opts ...VerifyOption) evidence.Command {
+	cmd := &verifyEvidenceReleaseBundle{
 		verifyEvidenceBase:   newVerifyEvidenceBase(serverDetails, format, keys, useArtifactoryKeys),
 		project:              project,
 		releaseBundle:        releaseBundle,
 		releaseBundleVersion: releaseBundleVersion,
 	}
+	cmd.subjectType = policy.SubjectTypeReleaseBundle
+	cmd.applyOptions(opts)
+	return cmd
 }
 
 // CommandName returns the command name for release bundle evidence verification.
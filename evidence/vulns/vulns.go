@@ -0,0 +1,346 @@
+package vulns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-evidence/evidence/utils"
+)
+
+const (
+	// PredicateType is the in-toto vulnerability scan predicate type.
+	PredicateType = "https://in-toto.io/attestation/vulns/v0.1"
+
+	ScannerTrivy = "trivy"
+	ScannerGrype = "grype"
+
+	severityUnknown = "UNKNOWN"
+)
+
+var scannerUris = map[string]string{
+	ScannerTrivy: "pkg:github/aquasecurity/trivy",
+	ScannerGrype: "pkg:github/anchore/grype",
+}
+
+// Severities lists the normalised severities from the most to the least severe.
+var Severities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "NEGLIGIBLE", severityUnknown}
+
+// Predicate is the in-toto vulns/v0.1 predicate.
+type Predicate struct {
+	Scanner  Scanner   `json:"scanner"`
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// Scanner describes the scanner, its vulnerability database and the vulnerabilities it found.
+type Scanner struct {
+	Name    string   `json:"name"`
+	Uri     string   `json:"uri"`
+	Version string   `json:"version,omitempty"`
+	Db      *Db      `json:"db,omitempty"`
+	Result  []Result `json:"result"`
+}
+
+type Db struct {
+	Uri        string `json:"uri,omitempty"`
+	Version    string `json:"version,omitempty"`
+	LastUpdate string `json:"lastUpdate,omitempty"`
+}
+
+// Result is a vulnerability found in a package.
+type Result struct {
+	Id          string       `json:"id"`
+	Severity    []Severity   `json:"severity"`
+	Annotations []Annotation `json:"annotations,omitempty"`
+}
+
+type Severity struct {
+	Method string `json:"method"`
+	Score  string `json:"score"`
+}
+
+// Annotation carries the affected package of a result.
+type Annotation struct {
+	Package          string `json:"package"`
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	FixedVersion     string `json:"fixedVersion,omitempty"`
+	Purl             string `json:"purl,omitempty"`
+}
+
+type Metadata struct {
+	ScanFinishedOn string `json:"scanFinishedOn,omitempty"`
+}
+
+// Report is a parsed scan report.
+type Report struct {
+	Predicate *Predicate
+	// Target is the scanned artifact as given to the scanner, for example an image reference.
+	Target string
+	// Digest is the sha256 of the scanned image manifest, without the algorithm prefix. Empty when unknown.
+	Digest string
+}
+
+type trivyReport struct {
+	SchemaVersion *int   `json:"SchemaVersion"`
+	CreatedAt     string `json:"CreatedAt"`
+	ArtifactName  string `json:"ArtifactName"`
+	Trivy         struct {
+		Version string `json:"Version"`
+	} `json:"Trivy"`
+	Metadata struct {
+		RepoDigests []string `json:"RepoDigests"`
+	} `json:"Metadata"`
+	Results []struct {
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			PkgIdentifier    struct {
+				PURL string `json:"PURL"`
+			} `json:"PkgIdentifier"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+type grypeReport struct {
+	Matches []struct {
+		Vulnerability struct {
+			Id       string `json:"id"`
+			Severity string `json:"severity"`
+			Fix      struct {
+				Versions []string `json:"versions"`
+			} `json:"fix"`
+		} `json:"vulnerability"`
+		Artifact struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+			Purl    string `json:"purl"`
+		} `json:"artifact"`
+	} `json:"matches"`
+	Source struct {
+		Target json.RawMessage `json:"target"`
+	} `json:"source"`
+	Descriptor struct {
+		Name      string  `json:"name"`
+		Version   string  `json:"version"`
+		Timestamp string  `json:"timestamp"`
+		Db        grypeDb `json:"db"`
+	} `json:"descriptor"`
+}
+
+// grypeDb covers both the legacy layout and the status block of newer Grype versions.
+type grypeDb struct {
+	Built         string          `json:"built"`
+	SchemaVersion json.RawMessage `json:"schemaVersion"`
+	Location      string          `json:"location"`
+	Status        *grypeDb        `json:"status"`
+	From          string          `json:"from"`
+}
+
+type grypeImageTarget struct {
+	UserInput      string   `json:"userInput"`
+	ManifestDigest string   `json:"manifestDigest"`
+	RepoDigests    []string `json:"repoDigests"`
+}
+
+// ParseFile parses a Trivy or Grype JSON report.
+func ParseFile(path string) (*Report, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scan report '%s': %w", path, err)
+	}
+	report, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scan report '%s': %w", path, err)
+	}
+	return report, nil
+}
+
+// Parse detects whether content is a Trivy or a Grype JSON report and normalises it.
+func Parse(content []byte) (*Report, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(content, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	switch {
+	case fields["SchemaVersion"] != nil || fields["Results"] != nil:
+		return parseTrivy(content)
+	case fields["matches"] != nil || fields["descriptor"] != nil:
+		return parseGrype(content)
+	default:
+		return nil, fmt.Errorf("unrecognized report format, expected Trivy or Grype JSON output")
+	}
+}
+
+func parseTrivy(content []byte) (*Report, error) {
+	var trivy trivyReport
+	if err := json.Unmarshal(content, &trivy); err != nil {
+		return nil, fmt.Errorf("failed to parse Trivy report: %w", err)
+	}
+	predicate := newPredicate(ScannerTrivy, trivy.Trivy.Version)
+	for _, result := range trivy.Results {
+		for _, vulnerability := range result.Vulnerabilities {
+			predicate.Scanner.Result = append(predicate.Scanner.Result, newResult(ScannerTrivy, vulnerability.VulnerabilityID, vulnerability.Severity, Annotation{
+				Package:          vulnerability.PkgName,
+				InstalledVersion: vulnerability.InstalledVersion,
+				FixedVersion:     vulnerability.FixedVersion,
+				Purl:             vulnerability.PkgIdentifier.PURL,
+			}))
+		}
+	}
+	if trivy.CreatedAt != "" {
+		predicate.Metadata = &Metadata{ScanFinishedOn: trivy.CreatedAt}
+	}
+	return &Report{Predicate: predicate, Target: trivy.ArtifactName, Digest: repoDigest(trivy.Metadata.RepoDigests)}, nil
+}
+
+func parseGrype(content []byte) (*Report, error) {
+	var grype grypeReport
+	if err := json.Unmarshal(content, &grype); err != nil {
+		return nil, fmt.Errorf("failed to parse Grype report: %w", err)
+	}
+	predicate := newPredicate(ScannerGrype, grype.Descriptor.Version)
+	predicate.Scanner.Db = grype.Descriptor.Db.toDb()
+	for _, match := range grype.Matches {
+		predicate.Scanner.Result = append(predicate.Scanner.Result, newResult(ScannerGrype, match.Vulnerability.Id, match.Vulnerability.Severity, Annotation{
+			Package:          match.Artifact.Name,
+			InstalledVersion: match.Artifact.Version,
+			FixedVersion:     strings.Join(match.Vulnerability.Fix.Versions, ", "),
+			Purl:             match.Artifact.Purl,
+		}))
+	}
+	if grype.Descriptor.Timestamp != "" {
+		predicate.Metadata = &Metadata{ScanFinishedOn: grype.Descriptor.Timestamp}
+	}
+	report := &Report{Predicate: predicate}
+	// The target is an object for image scans and a plain string for directory and file scans.
+	var image grypeImageTarget
+	if err := json.Unmarshal(grype.Source.Target, &image); err == nil {
+		report.Target = image.UserInput
+		report.Digest = repoDigest(image.RepoDigests)
+		if report.Digest == "" {
+			report.Digest = strings.TrimPrefix(image.ManifestDigest, "sha256:")
+		}
+	} else {
+		_ = json.Unmarshal(grype.Source.Target, &report.Target)
+	}
+	return report, nil
+}
+
+func (d grypeDb) toDb() *Db {
+	if d.Status != nil {
+		return d.Status.toDb()
+	}
+	version := string(bytes.Trim(d.SchemaVersion, `"`))
+	uri := d.From
+	if uri == "" {
+		uri = d.Location
+	}
+	if version == "" && d.Built == "" && uri == "" {
+		return nil
+	}
+	return &Db{Uri: uri, Version: version, LastUpdate: d.Built}
+}
+
+func newPredicate(scanner, version string) *Predicate {
+	uri := scannerUris[scanner]
+	if version != "" {
+		uri += "@" + version
+	}
+	return &Predicate{Scanner: Scanner{Name: scanner, Uri: uri, Version: version, Result: []Result{}}}
+}
+
+func newResult(scanner, id, severity string, annotation Annotation) Result {
+	return Result{
+		Id:          id,
+		Severity:    []Severity{{Method: scanner, Score: normalizeSeverity(severity)}},
+		Annotations: []Annotation{annotation},
+	}
+}
+
+func normalizeSeverity(severity string) string {
+	severity = strings.ToUpper(strings.TrimSpace(severity))
+	for _, known := range Severities {
+		if severity == known {
+			return severity
+		}
+	}
+	return severityUnknown
+}
+
+// repoDigest returns the sha256 of the first "<repository>@sha256:<digest>" entry.
+func repoDigest(repoDigests []string) string {
+	for _, repoDigest := range repoDigests {
+		if _, digest, found := strings.Cut(repoDigest, "@sha256:"); found && digest != "" {
+			return digest
+		}
+	}
+	return ""
+}
+
+// SeverityCounts returns the number of results per normalised severity.
+func (p *Predicate) SeverityCounts() map[string]int {
+	counts := make(map[string]int, len(Severities))
+	for _, severity := range Severities {
+		counts[severity] = 0
+	}
+	for _, result := range p.Scanner.Result {
+		for _, severity := range result.Severity {
+			counts[severity.Score]++
+		}
+	}
+	return counts
+}
+
+// Markdown renders the severity counts and the vulnerabilities found, most severe first.
+func (p *Predicate) Markdown(target string) []byte {
+	var sb strings.Builder
+	sb.WriteString("# Vulnerability scan\n\n")
+	if target != "" {
+		sb.WriteString(fmt.Sprintf("**Target:** `%s`  \n", target))
+	}
+	sb.WriteString(fmt.Sprintf("**Scanner:** %s %s  \n", p.Scanner.Name, p.Scanner.Version))
+	if p.Scanner.Db != nil && p.Scanner.Db.Version != "" {
+		sb.WriteString(fmt.Sprintf("**Database:** %s %s  \n", p.Scanner.Db.Version, p.Scanner.Db.LastUpdate))
+	}
+	counts := p.SeverityCounts()
+	sb.WriteString("\n| " + strings.Join(Severities, " | ") + " | Total |\n")
+	sb.WriteString(strings.Repeat("|---:", len(Severities)+1) + "|\n")
+	for _, severity := range Severities {
+		sb.WriteString(fmt.Sprintf("| %d ", counts[severity]))
+	}
+	sb.WriteString(fmt.Sprintf("| %d |\n", len(p.Scanner.Result)))
+	if len(p.Scanner.Result) == 0 {
+		return []byte(sb.String())
+	}
+
+	results := append([]Result(nil), p.Scanner.Result...)
+	sort.SliceStable(results, func(i, j int) bool {
+		return severityRank(results[i]) < severityRank(results[j])
+	})
+	sb.WriteString("\n| Vulnerability | Severity | Package | Installed | Fixed |\n")
+	sb.WriteString("|---|---|---|---|---|\n")
+	for _, result := range results {
+		var annotation Annotation
+		if len(result.Annotations) > 0 {
+			annotation = result.Annotations[0]
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n", result.Id, result.Severity[0].Score,
+			utils.MarkdownCell(annotation.Package), utils.MarkdownCell(annotation.InstalledVersion), utils.MarkdownCell(annotation.FixedVersion)))
+	}
+	return []byte(sb.String())
+}
+
+func severityRank(result Result) int {
+	for i, severity := range Severities {
+		if len(result.Severity) > 0 && result.Severity[0].Score == severity {
+			return i
+		}
+	}
+	return len(Severities)
+}
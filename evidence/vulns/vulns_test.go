@@ -0,0 +1,110 @@
+package vulns
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const trivyReportJson = `{
+  "SchemaVersion": 2,
+  "CreatedAt": "2024-05-01T10:00:00Z",
+  "ArtifactName": "myrepo.jfrog.io/docker-local/app:1.0",
+  "ArtifactType": "container_image",
+  "Trivy": {"Version": "0.51.1"},
+  "Metadata": {"RepoDigests": ["myrepo.jfrog.io/docker-local/app@sha256:aaaa"]},
+  "Results": [
+    {"Target": "alpine", "Vulnerabilities": [
+      {"VulnerabilityID": "CVE-2024-0001", "PkgName": "openssl", "InstalledVersion": "3.1.0", "FixedVersion": "3.1.5", "Severity": "CRITICAL", "PkgIdentifier": {"PURL": "pkg:apk/alpine/openssl@3.1.0"}},
+      {"VulnerabilityID": "CVE-2024-0002", "PkgName": "zlib", "InstalledVersion": "1.2.13", "Severity": "LOW"}
+    ]},
+    {"Target": "app", "Vulnerabilities": [
+      {"VulnerabilityID": "GHSA-xxxx", "PkgName": "lodash", "InstalledVersion": "4.17.0", "Severity": "HIGH"}
+    ]}
+  ]
+}`
+
+const grypeReportJson = `{
+  "matches": [
+    {"vulnerability": {"id": "CVE-2024-0003", "severity": "Medium", "fix": {"versions": ["2.0.1"]}}, "artifact": {"name": "busybox", "version": "1.36", "purl": "pkg:apk/alpine/busybox@1.36"}},
+    {"vulnerability": {"id": "CVE-2024-0004", "severity": "Negligible", "fix": {"versions": []}}, "artifact": {"name": "musl", "version": "1.2"}}
+  ],
+  "source": {"type": "image", "target": {"userInput": "app:1.0", "manifestDigest": "sha256:bbbb", "repoDigests": []}},
+  "descriptor": {"name": "grype", "version": "0.74.0", "timestamp": "2024-05-02T10:00:00Z",
+    "db": {"built": "2024-05-01T01:30:00Z", "schemaVersion": 5, "location": "/root/.cache/grype/db/5"}}
+}`
+
+func TestParse_Trivy(t *testing.T) {
+	report, err := Parse([]byte(trivyReportJson))
+	require.NoError(t, err)
+	assert.Equal(t, "myrepo.jfrog.io/docker-local/app:1.0", report.Target)
+	assert.Equal(t, "aaaa", report.Digest)
+
+	scanner := report.Predicate.Scanner
+	assert.Equal(t, ScannerTrivy, scanner.Name)
+	assert.Equal(t, "pkg:github/aquasecurity/trivy@0.51.1", scanner.Uri)
+	assert.Nil(t, scanner.Db)
+	require.Len(t, scanner.Result, 3)
+	assert.Equal(t, Result{
+		Id:          "CVE-2024-0001",
+		Severity:    []Severity{{Method: ScannerTrivy, Score: "CRITICAL"}},
+		Annotations: []Annotation{{Package: "openssl", InstalledVersion: "3.1.0", FixedVersion: "3.1.5", Purl: "pkg:apk/alpine/openssl@3.1.0"}},
+	}, scanner.Result[0])
+	assert.Equal(t, "2024-05-01T10:00:00Z", report.Predicate.Metadata.ScanFinishedOn)
+}
+
+func TestParse_Grype(t *testing.T) {
+	report, err := Parse([]byte(grypeReportJson))
+	require.NoError(t, err)
+	assert.Equal(t, "app:1.0", report.Target)
+	assert.Equal(t, "bbbb", report.Digest)
+
+	scanner := report.Predicate.Scanner
+	assert.Equal(t, ScannerGrype, scanner.Name)
+	assert.Equal(t, "0.74.0", scanner.Version)
+	assert.Equal(t, &Db{Uri: "/root/.cache/grype/db/5", Version: "5", LastUpdate: "2024-05-01T01:30:00Z"}, scanner.Db)
+	require.Len(t, scanner.Result, 2)
+	assert.Equal(t, "MEDIUM", scanner.Result[0].Severity[0].Score)
+	assert.Equal(t, "2.0.1", scanner.Result[0].Annotations[0].FixedVersion)
+	assert.Equal(t, "NEGLIGIBLE", scanner.Result[1].Severity[0].Score)
+}
+
+func TestParse_GrypeDbStatusAndDirectoryTarget(t *testing.T) {
+	report, err := Parse([]byte(`{"matches": [], "source": {"type": "directory", "target": "./src"},
+  "descriptor": {"name": "grype", "version": "0.88.0", "db": {"status": {"schemaVersion": "v6.0.2", "from": "https://grype.anchore.io/databases", "built": "2025-03-01T00:00:00Z"}}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "./src", report.Target)
+	assert.Empty(t, report.Digest)
+	assert.Equal(t, &Db{Uri: "https://grype.anchore.io/databases", Version: "v6.0.2", LastUpdate: "2025-03-01T00:00:00Z"}, report.Predicate.Scanner.Db)
+	assert.Empty(t, report.Predicate.Scanner.Result)
+}
+
+func TestParse_Unrecognized(t *testing.T) {
+	_, err := Parse([]byte(`{"bomFormat": "CycloneDX"}`))
+	assert.ErrorContains(t, err, "unrecognized report format")
+	_, err = Parse([]byte(`[`))
+	assert.ErrorContains(t, err, "failed to parse JSON")
+}
+
+func TestParseFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, os.WriteFile(path, []byte(trivyReportJson), 0o644))
+	report, err := ParseFile(path)
+	require.NoError(t, err)
+	assert.Len(t, report.Predicate.Scanner.Result, 3)
+
+	_, err = ParseFile(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorContains(t, err, "failed to read scan report")
+}
+
+func TestMarkdown(t *testing.T) {
+	report, err := Parse([]byte(trivyReportJson))
+	require.NoError(t, err)
+	markdown := string(report.Predicate.Markdown(report.Target))
+	assert.Contains(t, markdown, "| CRITICAL | HIGH | MEDIUM | LOW | NEGLIGIBLE | UNKNOWN | Total |")
+	assert.Contains(t, markdown, "| 1 | 1 | 0 | 1 | 0 | 0 | 3 |")
+	assert.Contains(t, markdown, "| CVE-2024-0001 | CRITICAL | openssl | 3.1.0 | 3.1.5 |\n| GHSA-xxxx | HIGH |")
+}